@@ -11,6 +11,10 @@ import (
 const (
 	ReconcileRequestAnnotation = "reconcile.apinet.ironcore.dev/requestedAt"
 
+	// IPAddressQuarantinedUntilAnnotation marks a released IPAddress as tombstone holding the address
+	// until the given RFC 3339 timestamp.
+	IPAddressQuarantinedUntilAnnotation = "apinet.ironcore.dev/quarantined-until"
+
 	// APINetletsGroup is the system rbac group all apinetlets are in.
 	APINetletsGroup = "apinet.ironcore.dev:system:apinetlets"
 
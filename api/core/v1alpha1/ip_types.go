@@ -21,6 +21,14 @@ type IPSpec struct {
 	IPFamily corev1.IPFamily `json:"ipFamily,omitempty"`
 	IP       net.IP          `json:"ip,omitempty"`
 	ClaimRef *IPClaimRef     `json:"claimRef,omitempty"`
+
+	// PoolRef references the IP pool to allocate the IP from.
+	// Mutually exclusive with PoolSelector.
+	PoolRef *corev1.LocalObjectReference `json:"poolRef,omitempty"`
+	// PoolSelector selects the IP pools to allocate the IP from.
+	// Mutually exclusive with PoolRef. If neither is set, the IP is allocated
+	// from any pool of matching type and IP family.
+	PoolSelector *metav1.LabelSelector `json:"poolSelector,omitempty"`
 }
 
 type IPClaimRef struct {
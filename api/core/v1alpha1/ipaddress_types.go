@@ -20,6 +20,9 @@ type IPAddressClaimRef struct {
 	Namespace string    `json:"namespace,omitempty"`
 	Name      string    `json:"name,omitempty"`
 	UID       types.UID `json:"uid,omitempty"`
+	// Pool is the name of the IP pool the address was allocated from.
+	// Empty for addresses of statically configured prefixes.
+	Pool string `json:"pool,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
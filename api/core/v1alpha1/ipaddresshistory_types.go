@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type IPAddressHistorySpec struct {
+	// IP is the address the history is about.
+	IP net.IP `json:"ip"`
+
+	// Leases are the leases of the address, oldest first.
+	// Leases are only ever appended and released. Leases released longer than the
+	// retention period ago are pruned.
+	Leases []IPAddressLease `json:"leases,omitempty"`
+}
+
+// IPAddressLease is a period an address was allocated to a claimer.
+type IPAddressLease struct {
+	// ClaimRef references the claimer the address was allocated to.
+	ClaimRef IPAddressClaimRef `json:"claimRef"`
+	// AllocatedTime is the time the address was allocated.
+	AllocatedTime metav1.Time `json:"allocatedTime"`
+	// ReleasedTime is the time the address was released. Unset while the address is allocated.
+	ReleasedTime *metav1.Time `json:"releasedTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+
+// IPAddressHistory is the schema for the ipaddresshistories API.
+type IPAddressHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IPAddressHistorySpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPAddressHistoryList contains a list of IPAddressHistory.
+type IPAddressHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAddressHistory `json:"items"`
+}
@@ -4,6 +4,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +16,8 @@ import (
 // +genclient
 
 // LoadBalancerRouting is the schema for the loadbalancerroutings API.
+// The destinations of a LoadBalancer may be split across multiple LoadBalancerRouting slices,
+// each labeled with LoadBalancerNameLabel.
 type LoadBalancerRouting struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -27,8 +31,28 @@ type LoadBalancerDestination struct {
 	IP net.IP `json:"ip"`
 	// TargetRef is the target providing the destination.
 	TargetRef *LoadBalancerTargetRef `json:"targetRef,omitempty"`
+	// State is the state of the destination. If unset, the destination is Active.
+	State LoadBalancerDestinationState `json:"state,omitempty"`
+	// DrainingTimestamp is the time the destination started draining.
+	// Has to be set if State is Draining.
+	DrainingTimestamp *metav1.Time `json:"drainingTimestamp,omitempty"`
+	// DrainTimeout is the duration a draining destination is kept after DrainingTimestamp.
+	// If unset, a draining destination is removed immediately.
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
 }
 
+// LoadBalancerDestinationState is the state of a load balancer destination.
+type LoadBalancerDestinationState string
+
+const (
+	// LoadBalancerDestinationStateActive is the state of a destination that receives new connections.
+	LoadBalancerDestinationStateActive LoadBalancerDestinationState = "Active"
+	// LoadBalancerDestinationStateDraining is the state of a destination that is about to be removed.
+	// It is kept as load balancer target, so its established connections are kept, until the drain
+	// timeout passed.
+	LoadBalancerDestinationStateDraining LoadBalancerDestinationState = "Draining"
+)
+
 // LoadBalancerTargetRef is a load balancer target.
 type LoadBalancerTargetRef struct {
 	// UID is the UID of the target.
@@ -47,3 +71,31 @@ type LoadBalancerRoutingList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []LoadBalancerRouting `json:"items"`
 }
+
+// GetLoadBalancerRoutingLoadBalancerName returns the name of the LoadBalancer the given routing belongs to.
+// Routings without LoadBalancerNameLabel belong to the LoadBalancer of the same name.
+func GetLoadBalancerRoutingLoadBalancerName(loadBalancerRouting *LoadBalancerRouting) string {
+	if name, ok := loadBalancerRouting.Labels[LoadBalancerNameLabel]; ok {
+		return name
+	}
+	return loadBalancerRouting.Name
+}
+
+// IsLoadBalancerDestinationDraining reports whether the destination is draining.
+func IsLoadBalancerDestinationDraining(dst *LoadBalancerDestination) bool {
+	return dst.State == LoadBalancerDestinationStateDraining
+}
+
+// LoadBalancerDestinationDrainDeadline returns the time after which a draining destination should be removed.
+func LoadBalancerDestinationDrainDeadline(dst *LoadBalancerDestination) time.Time {
+	var drainingTimestamp time.Time
+	if dst.DrainingTimestamp != nil {
+		drainingTimestamp = dst.DrainingTimestamp.Time
+	}
+
+	var drainTimeout time.Duration
+	if dst.DrainTimeout != nil {
+		drainTimeout = dst.DrainTimeout.Duration
+	}
+	return drainingTimestamp.Add(drainTimeout)
+}
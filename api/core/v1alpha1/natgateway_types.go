@@ -10,12 +10,31 @@ import (
 )
 
 type NATGatewaySpec struct {
-	// IPFamily is the IP family of the NAT gateway.
-	IPFamily corev1.IPFamily `json:"ipFamily"`
+	// IPFamily is the primary IP family of the NAT gateway.
+	// If unspecified, defaults to the first of IPFamilies.
+	// +optional
+	IPFamily corev1.IPFamily `json:"ipFamily,omitempty"`
+
+	// IPFamilies are the IP families the NAT gateway serves. Every IP family is NATed
+	// using the IPs of that family. If unspecified, defaults to IPFamily.
+	// +optional
+	// +listType=atomic
+	IPFamilies []corev1.IPFamily `json:"ipFamilies,omitempty"`
 
 	// NetworkRef references the network the NAT gateway is part of.
 	NetworkRef corev1.LocalObjectReference `json:"networkRef"`
 
+	// NetworkInterfaceSelector selects the network interfaces of the network the NAT gateway NATs.
+	// If unset, all network interfaces of the network are selected.
+	// +optional
+	NetworkInterfaceSelector *metav1.LabelSelector `json:"networkInterfaceSelector,omitempty"`
+
+	// Priority resolves conflicts between NAT gateways of the same network selecting the same network
+	// interface. The network interface is NATed by the NAT gateway with the highest priority. On equal
+	// priority, the oldest NAT gateway, then the NAT gateway with the lowest name wins.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
 	// IPs specifies the IPs of the NAT gateway.
 	// +optional
 	// +patchMergeKey=name
@@ -24,13 +43,78 @@ type NATGatewaySpec struct {
 
 	// PortsPerNetworkInterface specifies how many ports to allocate per network interface.
 	PortsPerNetworkInterface int32 `json:"portsPerNetworkInterface"`
+
+	// PortsPerNetworkInterfaceOverrides override PortsPerNetworkInterface for the network interfaces
+	// selected by them. The first matching override applies.
+	// +optional
+	// +listType=atomic
+	PortsPerNetworkInterfaceOverrides []NATGatewayPortsOverride `json:"portsPerNetworkInterfaceOverrides,omitempty"`
+
+	// PortBlockExpansion configures granting additional port blocks to network interfaces
+	// reporting NAT port pressure. If unset, no additional port blocks are granted.
+	// +optional
+	PortBlockExpansion *NATGatewayPortBlockExpansion `json:"portBlockExpansion,omitempty"`
+
+	// IPDrainPeriod is the period a draining IP is retained after all network interfaces
+	// have been migrated off it, letting existing flows finish. Defaults to 2 minutes.
+	// +optional
+	IPDrainPeriod *metav1.Duration `json:"ipDrainPeriod,omitempty"`
+
+	// NAT64 makes the NAT gateway translate IPv6-only network interfaces to its IPv4 IPs.
+	// A NAT64 NAT gateway has to serve IPv4 only.
+	// +optional
+	NAT64 *NATGatewayNAT64 `json:"nat64,omitempty"`
+
+	// StickyReservations makes the NAT gateway remember the port range of a network interface and restore it
+	// when the network interface is recreated. A network interface is identified by the value of its
+	// NATReservationKeyLabel or, if unset, by its UID. If unset, port ranges are not remembered.
+	// +optional
+	StickyReservations *NATGatewayStickyReservations `json:"stickyReservations,omitempty"`
+}
+
+type NATGatewayPortBlockExpansion struct {
+	// MaxExtraPortBlocks is the maximum number of additional port blocks of PortsPerNetworkInterface
+	// ports granted to a network interface.
+	MaxExtraPortBlocks int32 `json:"maxExtraPortBlocks"`
+	// CoolDownPeriod is the period a network interface has to be free of NAT port pressure
+	// before its additional port blocks are reclaimed. Defaults to 5 minutes.
+	// +optional
+	CoolDownPeriod *metav1.Duration `json:"coolDownPeriod,omitempty"`
+}
+
+type NATGatewayPortsOverride struct {
+	// NetworkInterfaceSelector selects the network interfaces the override applies to.
+	NetworkInterfaceSelector *metav1.LabelSelector `json:"networkInterfaceSelector"`
+	// PortsPerNetworkInterface specifies how many ports to allocate per selected network interface.
+	// Has to be a multiple of the PortsPerNetworkInterface of the NAT gateway.
+	PortsPerNetworkInterface int32 `json:"portsPerNetworkInterface"`
+}
+
+type NATGatewayNAT64 struct {
+	// Prefix is the IPv6 prefix IPv4 destinations are embedded in. Only the well-known prefix
+	// 64:ff9b::/96 the data plane translates is supported. Defaults to 64:ff9b::/96.
+	// +optional
+	Prefix net.IPPrefix `json:"prefix,omitempty"`
+}
+
+type NATGatewayStickyReservations struct {
+	// RetentionPeriod is the period the port range of a network interface stays reserved after
+	// the network interface is gone. Defaults to 24 hours.
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
 }
 
 type NATGatewayIP struct {
 	// Name is the semantic name of the NAT gateway IP.
 	Name string `json:"name"`
+	// IPFamily is the IP family of the IP. Has to match IP if specified. If unspecified,
+	// defaults to the IP family of IP or to the primary IP family of the NAT gateway.
+	IPFamily corev1.IPFamily `json:"ipFamily,omitempty"`
 	// IP specifies a specific IP to allocate. If empty, a random IP will be allocated if possible.
 	IP net.IP `json:"ip,omitempty"`
+	// Draining marks the IP for removal. Network interfaces are migrated off a draining IP
+	// and no new port ranges are allocated on it.
+	Draining bool `json:"draining,omitempty"`
 }
 
 type NATGatewayStatus struct {
@@ -38,6 +122,75 @@ type NATGatewayStatus struct {
 	UsedNATIPs int64 `json:"usedNATIPs,omitempty"`
 	// RequestedNATIPs is the number of requested NAT IPs.
 	RequestedNATIPs int64 `json:"requestedNATIPs,omitempty"`
+	// IPFamilies are the used and requested NAT IPs per IP family.
+	IPFamilies []NATGatewayIPFamilyStatus `json:"ipFamilies,omitempty"`
+	// DrainingIPs report the draining state of the draining IPs.
+	DrainingIPs []NATGatewayDrainingIPStatus `json:"drainingIPs,omitempty"`
+	// IPs report the port range usage per NAT gateway IP.
+	IPs []NATGatewayIPStatus `json:"ips,omitempty"`
+	// Reservations are the port ranges reserved for network interfaces if StickyReservations is set.
+	Reservations []NATGatewayReservation `json:"reservations,omitempty"`
+	// Conditions are the conditions of the NAT gateway.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// NATGatewayNAT64WellKnownPrefix is the well-known NAT64 prefix (RFC 6052).
+const NATGatewayNAT64WellKnownPrefix = "64:ff9b::/96"
+
+const (
+	// NATGatewayFull reports whether a network interface could not be assigned a port range
+	// because all port ranges of the IPs of an IP family are in use.
+	NATGatewayFull = "Full"
+)
+
+type NATGatewayDrainingIPStatus struct {
+	// Name is the name of the draining NAT gateway IP.
+	Name string `json:"name"`
+	// MigratedTime is the time all network interfaces had been migrated off the IP.
+	MigratedTime *metav1.Time `json:"migratedTime,omitempty"`
+	// Drained reports whether the drain period of the IP elapsed and the IP can be removed.
+	Drained bool `json:"drained,omitempty"`
+}
+
+type NATGatewayIPFamilyStatus struct {
+	// IPFamily is the IP family of the status.
+	IPFamily corev1.IPFamily `json:"ipFamily"`
+	// UsedNATIPs is the number of NAT IPs of the IP family in-use.
+	UsedNATIPs int64 `json:"usedNATIPs,omitempty"`
+	// RequestedNATIPs is the number of requested NAT IPs of the IP family.
+	RequestedNATIPs int64 `json:"requestedNATIPs,omitempty"`
+}
+
+type NATGatewayReservation struct {
+	// Key identifies the network interface the port range is reserved for.
+	Key string `json:"key"`
+	// IP is the NAT gateway IP of the port range.
+	IP net.IP `json:"ip"`
+	// Port is the first port of the port range.
+	Port int32 `json:"port"`
+	// EndPort is the last port of the port range.
+	EndPort int32 `json:"endPort"`
+	// ReleasedTime is the time the network interface of the reservation has been gone since.
+	// Unset while the port range is allocated for the network interface.
+	ReleasedTime *metav1.Time `json:"releasedTime,omitempty"`
+}
+
+type NATGatewayIPStatus struct {
+	// Name is the name of the NAT gateway IP.
+	Name string `json:"name"`
+	// IP is the IP of the NAT gateway IP.
+	IP net.IP `json:"ip,omitempty"`
+	// TotalSlots is the total number of port ranges of PortsPerNetworkInterface ports of the IP.
+	TotalSlots int32 `json:"totalSlots,omitempty"`
+	// UsedSlots is the number of used port ranges of the IP.
+	UsedSlots int32 `json:"usedSlots,omitempty"`
+	// NetworkInterfaces is the number of network interfaces NATed using the IP.
+	NetworkInterfaces int32 `json:"networkInterfaces,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -68,3 +221,60 @@ func GetNATGatewayIPs(natGateway *NATGateway) []net.IP {
 	}
 	return res
 }
+
+// GetNATGatewayIPFamilies returns the IP families of the NAT gateway.
+// If the IP families are not set, the IP family of the NAT gateway is returned.
+func GetNATGatewayIPFamilies(natGateway *NATGateway) []corev1.IPFamily {
+	if len(natGateway.Spec.IPFamilies) > 0 {
+		return natGateway.Spec.IPFamilies
+	}
+	return []corev1.IPFamily{natGateway.Spec.IPFamily}
+}
+
+// IsNATGatewayNAT64 returns whether the NAT gateway translates IPv6-only network interfaces to IPv4.
+func IsNATGatewayNAT64(natGateway *NATGateway) bool {
+	return natGateway.Spec.NAT64 != nil
+}
+
+// GetNATGatewayNetworkInterfaceIPFamily returns the IP family of the network interface IPs NATed
+// by the NAT gateway IPs of the given IP family.
+func GetNATGatewayNetworkInterfaceIPFamily(natGateway *NATGateway, ipFamily corev1.IPFamily) corev1.IPFamily {
+	if IsNATGatewayNAT64(natGateway) && ipFamily == corev1.IPv4Protocol {
+		return corev1.IPv6Protocol
+	}
+	return ipFamily
+}
+
+// GetNATGatewayIPFamily returns the IP family of the given NAT gateway IP.
+// If the IP family of the IP is not set, it is inferred from the IP or the NAT gateway.
+func GetNATGatewayIPFamily(natGateway *NATGateway, ip *NATGatewayIP) corev1.IPFamily {
+	switch {
+	case ip.IPFamily != "":
+		return ip.IPFamily
+	case ip.IP.IsValid():
+		return ip.IP.Family()
+	default:
+		return natGateway.Spec.IPFamily
+	}
+}
+
+// GetNATGatewayIPsOfFamily returns the IPs of the given IP family of the NAT gateway.
+func GetNATGatewayIPsOfFamily(natGateway *NATGateway, ipFamily corev1.IPFamily) []net.IP {
+	var res []net.IP
+	for _, ip := range natGateway.Spec.IPs {
+		if GetNATGatewayIPFamily(natGateway, &ip) == ipFamily {
+			res = append(res, ip.IP)
+		}
+	}
+	return res
+}
+
+// GetNATGatewayDrainingIPStatus returns the draining status of the NAT gateway IP with the given name.
+func GetNATGatewayDrainingIPStatus(natGateway *NATGateway, name string) *NATGatewayDrainingIPStatus {
+	for i := range natGateway.Status.DrainingIPs {
+		if natGateway.Status.DrainingIPs[i].Name == name {
+			return &natGateway.Status.DrainingIPs[i]
+		}
+	}
+	return nil
+}
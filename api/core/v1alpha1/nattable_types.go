@@ -14,6 +14,8 @@ import (
 // +genclient
 
 // NATTable is the schema for the nattables API.
+// The sections of a NATGateway are split into one NATTable slice per node, each labeled with
+// NATGatewayNameLabel and the TopologyPartitionLabel of its node.
 type NATTable struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -57,3 +59,12 @@ type NATTableList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []NATTable `json:"items"`
 }
+
+// GetNATTableNATGatewayName returns the name of the NATGateway the given NAT table belongs to.
+// NAT tables without NATGatewayNameLabel belong to the NATGateway of the same name.
+func GetNATTableNATGatewayName(natTable *NATTable) string {
+	if name, ok := natTable.Labels[NATGatewayNameLabel]; ok {
+		return name
+	}
+	return natTable.Name
+}
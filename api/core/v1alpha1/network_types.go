@@ -11,6 +11,10 @@ import (
 type NetworkSpec struct {
 	// ID is the ID of the network.
 	ID string `json:"id,omitempty"`
+	// Prefixes are the internal address space of the network.
+	// IPs of internal load balancers that don't specify an IP are allocated from them.
+	// +listType=atomic
+	Prefixes []net.IPPrefix `json:"prefixes,omitempty"`
 	// Peerings are the network peerings with this network
 	Peerings []NetworkPeering `json:"peerings,omitempty"`
 }
@@ -102,6 +102,20 @@ type NetworkInterfaceStatus struct {
 	PublicIPs []net.IP `json:"publicIPs,omitempty"`
 	// NATIPs are the NAT IPs of the network interface.
 	NATIPs []net.IP `json:"natIPs,omitempty"`
+	// NATs are the NAT statuses of the network interface per IP family.
+	NATs []NetworkInterfaceNATStatus `json:"nats,omitempty"`
+}
+
+// NetworkInterfaceNATStatus is the NAT status of a network interface for an IP family.
+type NetworkInterfaceNATStatus struct {
+	// IPFamily is the IP family of the NAT.
+	IPFamily corev1.IPFamily `json:"ipFamily"`
+	// Ports is the number of NAT ports programmed for the network interface.
+	Ports int32 `json:"ports,omitempty"`
+	// PortPressure reports whether the network interface exhausts its NAT ports.
+	PortPressure bool `json:"portPressure,omitempty"`
+	// LastTransitionTime is the last time Ports or PortPressure changed.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -135,6 +149,15 @@ func GetNetworkInterfaceNATClaimer(nic *NetworkInterface, ipFamily corev1.IPFami
 	return nil
 }
 
+func GetNetworkInterfaceNATStatus(nic *NetworkInterface, ipFamily corev1.IPFamily) *NetworkInterfaceNATStatus {
+	for i := range nic.Status.NATs {
+		if nic.Status.NATs[i].IPFamily == ipFamily {
+			return &nic.Status.NATs[i]
+		}
+	}
+	return nil
+}
+
 func IsNetworkInterfaceNATClaimedBy(nic *NetworkInterface, claimer *NATGateway) bool {
 	for _, nat := range nic.Spec.NATs {
 		if nat.ClaimRef.UID == claimer.UID {
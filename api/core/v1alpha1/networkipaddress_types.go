@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"net/netip"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NetworkIPAddressSpec struct {
+	// NetworkRef references the network the address belongs to.
+	NetworkRef corev1.LocalObjectReference `json:"networkRef"`
+	// IP is the claimed address.
+	IP net.IP `json:"ip"`
+	// ClaimRef references the network interface or internal load balancer claiming the address.
+	ClaimRef IPAddressClaimRef `json:"claimRef"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+
+// NetworkIPAddress is the schema for the networkipaddresses API.
+// A NetworkIPAddress records that an address of a network is in use by a network interface or an
+// internal load balancer of the network. Its name is derived from the network name and the address,
+// so at most one object can claim an address of a network.
+type NetworkIPAddress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NetworkIPAddressSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkIPAddressList contains a list of NetworkIPAddress.
+type NetworkIPAddressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkIPAddress `json:"items"`
+}
+
+// NetworkIPAddressName returns the name of the NetworkIPAddress of the address in the network.
+func NetworkIPAddressName(networkName string, addr netip.Addr) string {
+	return networkName + "." + addr.String()
+}
+
+func IsNetworkIPAddressClaimedBy(networkIPAddress *NetworkIPAddress, claimer metav1.Object) bool {
+	return networkIPAddress.Spec.ClaimRef.UID == claimer.GetUID()
+}
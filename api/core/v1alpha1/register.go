@@ -39,6 +39,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&IPList{},
 		&IPAddress{},
 		&IPAddressList{},
+		&IPAddressHistory{},
+		&IPAddressHistoryList{},
+		&IPPool{},
+		&IPPoolList{},
 		&LoadBalancer{},
 		&LoadBalancerList{},
 		&LoadBalancerRouting{},
@@ -53,14 +57,20 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkList{},
 		&NetworkID{},
 		&NetworkIDList{},
+		&NetworkIPAddress{},
+		&NetworkIPAddressList{},
 		&NetworkInterface{},
 		&NetworkInterfaceList{},
 		&NetworkPolicy{},
 		&NetworkPolicyList{},
 		&NetworkPolicyRule{},
 		&NetworkPolicyRuleList{},
+		&NetworkQuota{},
+		&NetworkQuotaList{},
 		&Node{},
 		&NodeList{},
+		&PortForwarding{},
+		&PortForwardingList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
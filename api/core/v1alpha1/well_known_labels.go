@@ -9,6 +9,22 @@ const (
 	IPFamilyLabel = "apinet.ironcore.dev/ip-family"
 	IPIPLabel     = "apinet.ironcore.dev/ip"
 
+	// LoadBalancerNameLabel is the label on a LoadBalancerRouting slice specifying the name of the
+	// LoadBalancer the slice belongs to.
+	LoadBalancerNameLabel = "apinet.ironcore.dev/load-balancer-name"
+
+	// NATGatewayNameLabel is the label on a NATTable slice specifying the name of the
+	// NATGateway the slice belongs to.
+	NATGatewayNameLabel = "apinet.ironcore.dev/nat-gateway-name"
+
+	// NATReservationKeyLabel is the label on a NetworkInterface specifying the key the sticky port range
+	// reservations of NATGateways are remembered by.
+	NATReservationKeyLabel = "apinet.ironcore.dev/nat-reservation-key"
+
+	// NetworkNameLabel is the label on a NetworkIPAddress specifying the name of the
+	// Network the address belongs to.
+	NetworkNameLabel = "apinet.ironcore.dev/network-name"
+
 	TopologyLabelPrefix    = "topology.core.apinet.ironcore.dev/"
 	TopologyPartitionLabel = TopologyLabelPrefix + "partition"
 	TopologyZoneLabel      = TopologyLabelPrefix + "zone"
@@ -216,6 +216,112 @@ func (in *IPAddressClaimRef) DeepCopy() *IPAddressClaimRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressHistory) DeepCopyInto(out *IPAddressHistory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressHistory.
+func (in *IPAddressHistory) DeepCopy() *IPAddressHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAddressHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressHistoryList) DeepCopyInto(out *IPAddressHistoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAddressHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressHistoryList.
+func (in *IPAddressHistoryList) DeepCopy() *IPAddressHistoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressHistoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAddressHistoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressHistorySpec) DeepCopyInto(out *IPAddressHistorySpec) {
+	*out = *in
+	in.IP.DeepCopyInto(&out.IP)
+	if in.Leases != nil {
+		in, out := &in.Leases, &out.Leases
+		*out = make([]IPAddressLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressHistorySpec.
+func (in *IPAddressHistorySpec) DeepCopy() *IPAddressHistorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressHistorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressLease) DeepCopyInto(out *IPAddressLease) {
+	*out = *in
+	out.ClaimRef = in.ClaimRef
+	in.AllocatedTime.DeepCopyInto(&out.AllocatedTime)
+	if in.ReleasedTime != nil {
+		in, out := &in.ReleasedTime, &out.ReleasedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressLease.
+func (in *IPAddressLease) DeepCopy() *IPAddressLease {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAddressList) DeepCopyInto(out *IPAddressList) {
 	*out = *in
@@ -340,6 +446,144 @@ func (in *IPList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (in *IPPool) DeepCopy() *IPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolList.
+func (in *IPPoolList) DeepCopy() *IPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolPrefixStatus) DeepCopyInto(out *IPPoolPrefixStatus) {
+	*out = *in
+	in.Prefix.DeepCopyInto(&out.Prefix)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolPrefixStatus.
+func (in *IPPoolPrefixStatus) DeepCopy() *IPPoolPrefixStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolPrefixStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]net.IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Excluded != nil {
+		in, out := &in.Excluded, &out.Excluded
+		*out = make([]net.IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reserved != nil {
+		in, out := &in.Reserved, &out.Reserved
+		*out = make([]net.IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
+func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]IPPoolPrefixStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
+func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPSpec) DeepCopyInto(out *IPSpec) {
 	*out = *in
@@ -349,6 +593,16 @@ func (in *IPSpec) DeepCopyInto(out *IPSpec) {
 		*out = new(IPClaimRef)
 		**out = **in
 	}
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.PoolSelector != nil {
+		in, out := &in.PoolSelector, &out.PoolSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -614,6 +868,15 @@ func (in *LoadBalancerDestination) DeepCopyInto(out *LoadBalancerDestination) {
 		*out = new(LoadBalancerTargetRef)
 		**out = **in
 	}
+	if in.DrainingTimestamp != nil {
+		in, out := &in.DrainingTimestamp, &out.DrainingTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -773,6 +1036,16 @@ func (in *LoadBalancerRoutingList) DeepCopyObject() runtime.Object {
 func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 	*out = *in
 	out.NetworkRef = in.NetworkRef
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(corev1.IPFamilyPolicy)
+		**out = **in
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
 	if in.IPs != nil {
 		in, out := &in.IPs, &out.IPs
 		*out = make([]LoadBalancerIP, len(*in))
@@ -866,7 +1139,7 @@ func (in *NATGateway) DeepCopyInto(out *NATGateway) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -894,7 +1167,7 @@ func (in *NATGatewayAutoscaler) DeepCopyInto(out *NATGatewayAutoscaler) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -916,6 +1189,59 @@ func (in *NATGatewayAutoscaler) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayAutoscalerBehavior) DeepCopyInto(out *NATGatewayAutoscalerBehavior) {
+	*out = *in
+	if in.ScaleUp != nil {
+		in, out := &in.ScaleUp, &out.ScaleUp
+		*out = new(NATGatewayAutoscalerScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(NATGatewayAutoscalerScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayAutoscalerBehavior.
+func (in *NATGatewayAutoscalerBehavior) DeepCopy() *NATGatewayAutoscalerBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayAutoscalerBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayAutoscalerIPFamilyStatus) DeepCopyInto(out *NATGatewayAutoscalerIPFamilyStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make([]NATGatewayAutoscalerRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayAutoscalerIPFamilyStatus.
+func (in *NATGatewayAutoscalerIPFamilyStatus) DeepCopy() *NATGatewayAutoscalerIPFamilyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayAutoscalerIPFamilyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGatewayAutoscalerList) DeepCopyInto(out *NATGatewayAutoscalerList) {
 	*out = *in
@@ -949,6 +1275,54 @@ func (in *NATGatewayAutoscalerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayAutoscalerRecommendation) DeepCopyInto(out *NATGatewayAutoscalerRecommendation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayAutoscalerRecommendation.
+func (in *NATGatewayAutoscalerRecommendation) DeepCopy() *NATGatewayAutoscalerRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayAutoscalerRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayAutoscalerScalingRules) DeepCopyInto(out *NATGatewayAutoscalerScalingRules) {
+	*out = *in
+	if in.StabilizationWindow != nil {
+		in, out := &in.StabilizationWindow, &out.StabilizationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxIPsPerStep != nil {
+		in, out := &in.MaxIPsPerStep, &out.MaxIPsPerStep
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StepPeriod != nil {
+		in, out := &in.StepPeriod, &out.StepPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayAutoscalerScalingRules.
+func (in *NATGatewayAutoscalerScalingRules) DeepCopy() *NATGatewayAutoscalerScalingRules {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayAutoscalerScalingRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGatewayAutoscalerSpec) DeepCopyInto(out *NATGatewayAutoscalerSpec) {
 	*out = *in
@@ -963,6 +1337,21 @@ func (in *NATGatewayAutoscalerSpec) DeepCopyInto(out *NATGatewayAutoscalerSpec)
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TargetUtilizationPercentage != nil {
+		in, out := &in.TargetUtilizationPercentage, &out.TargetUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpareSlots != nil {
+		in, out := &in.SpareSlots, &out.SpareSlots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(NATGatewayAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -979,6 +1368,24 @@ func (in *NATGatewayAutoscalerSpec) DeepCopy() *NATGatewayAutoscalerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGatewayAutoscalerStatus) DeepCopyInto(out *NATGatewayAutoscalerStatus) {
 	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]NATGatewayAutoscalerIPFamilyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -992,6 +1399,26 @@ func (in *NATGatewayAutoscalerStatus) DeepCopy() *NATGatewayAutoscalerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayDrainingIPStatus) DeepCopyInto(out *NATGatewayDrainingIPStatus) {
+	*out = *in
+	if in.MigratedTime != nil {
+		in, out := &in.MigratedTime, &out.MigratedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayDrainingIPStatus.
+func (in *NATGatewayDrainingIPStatus) DeepCopy() *NATGatewayDrainingIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayDrainingIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGatewayIP) DeepCopyInto(out *NATGatewayIP) {
 	*out = *in
@@ -1009,6 +1436,39 @@ func (in *NATGatewayIP) DeepCopy() *NATGatewayIP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayIPFamilyStatus) DeepCopyInto(out *NATGatewayIPFamilyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayIPFamilyStatus.
+func (in *NATGatewayIPFamilyStatus) DeepCopy() *NATGatewayIPFamilyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayIPFamilyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayIPStatus) DeepCopyInto(out *NATGatewayIPStatus) {
+	*out = *in
+	in.IP.DeepCopyInto(&out.IP)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayIPStatus.
+func (in *NATGatewayIPStatus) DeepCopy() *NATGatewayIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGatewayList) DeepCopyInto(out *NATGatewayList) {
 	*out = *in
@@ -1042,10 +1502,100 @@ func (in *NATGatewayList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayNAT64) DeepCopyInto(out *NATGatewayNAT64) {
+	*out = *in
+	in.Prefix.DeepCopyInto(&out.Prefix)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayNAT64.
+func (in *NATGatewayNAT64) DeepCopy() *NATGatewayNAT64 {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayNAT64)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayPortBlockExpansion) DeepCopyInto(out *NATGatewayPortBlockExpansion) {
+	*out = *in
+	if in.CoolDownPeriod != nil {
+		in, out := &in.CoolDownPeriod, &out.CoolDownPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayPortBlockExpansion.
+func (in *NATGatewayPortBlockExpansion) DeepCopy() *NATGatewayPortBlockExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayPortBlockExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayPortsOverride) DeepCopyInto(out *NATGatewayPortsOverride) {
+	*out = *in
+	if in.NetworkInterfaceSelector != nil {
+		in, out := &in.NetworkInterfaceSelector, &out.NetworkInterfaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayPortsOverride.
+func (in *NATGatewayPortsOverride) DeepCopy() *NATGatewayPortsOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayPortsOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayReservation) DeepCopyInto(out *NATGatewayReservation) {
+	*out = *in
+	in.IP.DeepCopyInto(&out.IP)
+	if in.ReleasedTime != nil {
+		in, out := &in.ReleasedTime, &out.ReleasedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayReservation.
+func (in *NATGatewayReservation) DeepCopy() *NATGatewayReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGatewaySpec) DeepCopyInto(out *NATGatewaySpec) {
 	*out = *in
 	out.NetworkRef = in.NetworkRef
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaceSelector != nil {
+		in, out := &in.NetworkInterfaceSelector, &out.NetworkInterfaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPs != nil {
 		in, out := &in.IPs, &out.IPs
 		*out = make([]NATGatewayIP, len(*in))
@@ -1053,6 +1603,33 @@ func (in *NATGatewaySpec) DeepCopyInto(out *NATGatewaySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PortsPerNetworkInterfaceOverrides != nil {
+		in, out := &in.PortsPerNetworkInterfaceOverrides, &out.PortsPerNetworkInterfaceOverrides
+		*out = make([]NATGatewayPortsOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PortBlockExpansion != nil {
+		in, out := &in.PortBlockExpansion, &out.PortBlockExpansion
+		*out = new(NATGatewayPortBlockExpansion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPDrainPeriod != nil {
+		in, out := &in.IPDrainPeriod, &out.IPDrainPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NAT64 != nil {
+		in, out := &in.NAT64, &out.NAT64
+		*out = new(NATGatewayNAT64)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StickyReservations != nil {
+		in, out := &in.StickyReservations, &out.StickyReservations
+		*out = new(NATGatewayStickyReservations)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1061,23 +1638,77 @@ func (in *NATGatewaySpec) DeepCopy() *NATGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGatewaySpec)
+	out := new(NATGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
+	*out = *in
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]NATGatewayIPFamilyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DrainingIPs != nil {
+		in, out := &in.DrainingIPs, &out.DrainingIPs
+		*out = make([]NATGatewayDrainingIPStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]NATGatewayIPStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]NATGatewayReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatus.
+func (in *NATGatewayStatus) DeepCopy() *NATGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
+func (in *NATGatewayStickyReservations) DeepCopyInto(out *NATGatewayStickyReservations) {
 	*out = *in
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatus.
-func (in *NATGatewayStatus) DeepCopy() *NATGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStickyReservations.
+func (in *NATGatewayStickyReservations) DeepCopy() *NATGatewayStickyReservations {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGatewayStatus)
+	out := new(NATGatewayStickyReservations)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1332,6 +1963,85 @@ func (in *NetworkIDSpec) DeepCopy() *NetworkIDSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkIPAddress) DeepCopyInto(out *NetworkIPAddress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkIPAddress.
+func (in *NetworkIPAddress) DeepCopy() *NetworkIPAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIPAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkIPAddress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkIPAddressList) DeepCopyInto(out *NetworkIPAddressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkIPAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkIPAddressList.
+func (in *NetworkIPAddressList) DeepCopy() *NetworkIPAddressList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIPAddressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkIPAddressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkIPAddressSpec) DeepCopyInto(out *NetworkIPAddressSpec) {
+	*out = *in
+	out.NetworkRef = in.NetworkRef
+	in.IP.DeepCopyInto(&out.IP)
+	out.ClaimRef = in.ClaimRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkIPAddressSpec.
+func (in *NetworkIPAddressSpec) DeepCopy() *NetworkIPAddressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIPAddressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 	*out = *in
@@ -1426,6 +2136,26 @@ func (in *NetworkInterfaceNATClaimRef) DeepCopy() *NetworkInterfaceNATClaimRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceNATStatus) DeepCopyInto(out *NetworkInterfaceNATStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceNATStatus.
+func (in *NetworkInterfaceNATStatus) DeepCopy() *NetworkInterfaceNATStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceNATStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfacePublicIP) DeepCopyInto(out *NetworkInterfacePublicIP) {
 	*out = *in
@@ -1521,6 +2251,13 @@ func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NATs != nil {
+		in, out := &in.NATs, &out.NATs
+		*out = make([]NetworkInterfaceNATStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1906,9 +2643,123 @@ func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkQuota) DeepCopyInto(out *NetworkQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQuota.
+func (in *NetworkQuota) DeepCopy() *NetworkQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkQuotaList) DeepCopyInto(out *NetworkQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQuotaList.
+func (in *NetworkQuotaList) DeepCopy() *NetworkQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkQuotaSpec) DeepCopyInto(out *NetworkQuotaSpec) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(map[NetworkQuotaResourceName]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQuotaSpec.
+func (in *NetworkQuotaSpec) DeepCopy() *NetworkQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkQuotaStatus) DeepCopyInto(out *NetworkQuotaStatus) {
+	*out = *in
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(map[NetworkQuotaResourceName]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQuotaStatus.
+func (in *NetworkQuotaStatus) DeepCopy() *NetworkQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]net.IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Peerings != nil {
 		in, out := &in.Peerings, &out.Peerings
 		*out = make([]NetworkPeering, len(*in))
@@ -2218,6 +3069,100 @@ func (in *PeeringPrefix) DeepCopy() *PeeringPrefix {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwarding) DeepCopyInto(out *PortForwarding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwarding.
+func (in *PortForwarding) DeepCopy() *PortForwarding {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwarding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortForwarding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardingList) DeepCopyInto(out *PortForwardingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PortForwarding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardingList.
+func (in *PortForwardingList) DeepCopy() *PortForwardingList {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortForwardingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardingSpec) DeepCopyInto(out *PortForwardingSpec) {
+	*out = *in
+	in.IP.DeepCopyInto(&out.IP)
+	out.NetworkInterfaceRef = in.NetworkInterfaceRef
+	in.TargetIP.DeepCopyInto(&out.TargetIP)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(corev1.Protocol)
+		**out = **in
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardingSpec.
+func (in *PortForwardingSpec) DeepCopy() *PortForwardingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	*out = *in
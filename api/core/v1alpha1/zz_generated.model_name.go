@@ -48,6 +48,26 @@ func (in IPAddressClaimRef) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPAddressClaimRef"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressHistory) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPAddressHistory"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressHistoryList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPAddressHistoryList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressHistorySpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPAddressHistorySpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressLease) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPAddressLease"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in IPAddressList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPAddressList"
@@ -73,6 +93,31 @@ func (in IPList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPool) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPool"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolPrefixStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolPrefixStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in IPSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPSpec"
@@ -183,11 +228,31 @@ func (in NATGatewayAutoscaler) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscaler"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayAutoscalerBehavior) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerBehavior"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayAutoscalerIPFamilyStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerIPFamilyStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NATGatewayAutoscalerList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayAutoscalerRecommendation) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerRecommendation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayAutoscalerScalingRules) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerScalingRules"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NATGatewayAutoscalerSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerSpec"
@@ -198,16 +263,51 @@ func (in NATGatewayAutoscalerStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayAutoscalerStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayDrainingIPStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayDrainingIPStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NATGatewayIP) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayIP"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayIPFamilyStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayIPFamilyStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayIPStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayIPStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NATGatewayList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayNAT64) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayNAT64"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayPortBlockExpansion) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayPortBlockExpansion"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayPortsOverride) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayPortsOverride"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayReservation) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayReservation"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NATGatewaySpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewaySpec"
@@ -218,6 +318,11 @@ func (in NATGatewayStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NATGatewayStickyReservations) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATGatewayStickyReservations"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NATIP) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NATIP"
@@ -268,6 +373,21 @@ func (in NetworkIDSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkIDSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkIPAddress) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkIPAddress"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkIPAddressList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkIPAddressList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkIPAddressSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkIPAddressSpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NetworkInterface) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkInterface"
@@ -288,6 +408,11 @@ func (in NetworkInterfaceNATClaimRef) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkInterfaceNATClaimRef"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkInterfaceNATStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkInterfaceNATStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NetworkInterfacePublicIP) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkInterfacePublicIP"
@@ -363,6 +488,26 @@ func (in NetworkPolicySpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkPolicySpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuota) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkQuota"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuotaList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkQuotaList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuotaSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkQuotaSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuotaStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkQuotaStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NetworkSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.NetworkSpec"
@@ -433,6 +578,21 @@ func (in PeeringPrefix) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.PeeringPrefix"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PortForwarding) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.PortForwarding"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PortForwardingList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.PortForwardingList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PortForwardingSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.PortForwardingSpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Rule) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.Rule"
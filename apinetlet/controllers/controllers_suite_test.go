@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +49,8 @@ var (
 	k8sClient  client.Client
 	testEnv    *envtest.Environment
 	testEnvExt *envtestutils.EnvironmentExtensions
+
+	natGatewayEventRecorder *events.FakeRecorder
 )
 
 const (
@@ -120,7 +123,7 @@ var _ = BeforeSuite(func() {
 		Port:         testEnvExt.APIServiceInstallOptions.LocalServingPort,
 		CertDir:      testEnvExt.APIServiceInstallOptions.LocalServingCertDir,
 		Args: apiserver.ProcessArgs{
-			"public-prefix": []string{"10.0.0.0/24"},
+			"public-prefix": []string{"10.0.0.0/24", "2001:db8::/120"},
 		},
 	})
 	Expect(err).NotTo(HaveOccurred())
@@ -185,8 +188,10 @@ func SetupTestWithNetworkPeeringFlag(apiNetNamespace *corev1.Namespace, isNetwor
 			APINetNamespace: apiNetNamespace.Name,
 		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
 
+		natGatewayEventRecorder = events.NewFakeRecorder(100)
 		Expect((&NATGatewayReconciler{
 			Client:          k8sManager.GetClient(),
+			EventRecorder:   natGatewayEventRecorder,
 			APINetClient:    k8sManager.GetClient(),
 			APINetInterface: apiNetInterface,
 			APINetNamespace: apiNetNamespace.Name,
@@ -4,9 +4,12 @@
 package controllers
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	netclientutils "github.com/ironcore-dev/ironcore-net/utils/client"
@@ -24,10 +27,12 @@ import (
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	"github.com/ironcore-dev/ironcore/utils/predicates"
+	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -40,6 +45,10 @@ import (
 
 const (
 	loadBalancerFinalizer = "apinet.ironcore.dev/loadbalancer"
+
+	// DefaultLoadBalancerRoutingMaxDestinations is the default maximum number of destinations
+	// of a single APINet load balancer routing slice.
+	DefaultLoadBalancerRoutingMaxDestinations = 100
 )
 
 var (
@@ -59,6 +68,15 @@ type LoadBalancerReconciler struct {
 	WatchFilterValue string
 
 	IsNodeAffinityAware bool
+
+	// DestinationDrainTimeout is the duration a removed destination is kept draining
+	// in the APINet load balancer routing before it is dropped.
+	// If zero, removed destinations are dropped immediately.
+	DestinationDrainTimeout time.Duration
+
+	// RoutingMaxDestinations is the maximum number of destinations of a single APINet load balancer
+	// routing slice. If zero, all destinations are kept in a single slice.
+	RoutingMaxDestinations int
 }
 
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -70,6 +88,7 @@ type LoadBalancerReconciler struct {
 
 //+cluster=apinet:kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancers,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+cluster=apinet:kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancerroutings,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+cluster=apinet:kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networks,verbs=get;list;watch
 
 func (r *LoadBalancerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -175,7 +194,8 @@ func (r *LoadBalancerReconciler) reconcile(ctx context.Context, log logr.Logger,
 	}
 
 	log.V(1).Info("Manage APINet load balancer routing")
-	if err := r.manageAPINetLoadBalancerRouting(ctx, loadBalancer, apiNetLoadBalancer, apiNetDestinations); err != nil {
+	requeueAfter, err := r.manageAPINetLoadBalancerRouting(ctx, loadBalancer, apiNetLoadBalancer, apiNetDestinations)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -188,7 +208,7 @@ func (r *LoadBalancerReconciler) reconcile(ctx context.Context, log logr.Logger,
 	}
 
 	log.V(1).Info("Patched load balancer status")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *LoadBalancerReconciler) prepareApiNetDestinations(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer) ([]apinetv1alpha1.LoadBalancerDestination, error) {
@@ -222,7 +242,258 @@ func (r *LoadBalancerReconciler) prepareApiNetDestinations(ctx context.Context,
 	return apiNetDsts, nil
 }
 
-func (r *LoadBalancerReconciler) manageAPINetLoadBalancerRouting(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer, apiNetLoadBalancer *apinetv1alpha1.LoadBalancer, apiNetDsts []apinetv1alpha1.LoadBalancerDestination) error {
+// apiNetLoadBalancerRoutingName returns the name of the APINet load balancer routing slice with the given index.
+// The first slice is named like the APINet load balancer.
+func apiNetLoadBalancerRoutingName(apiNetLoadBalancerName string, index int) string {
+	if index == 0 {
+		return apiNetLoadBalancerName
+	}
+	return fmt.Sprintf("%s-%d", apiNetLoadBalancerName, index)
+}
+
+// apiNetLoadBalancerRoutingIndex returns the index of the APINet load balancer routing slice with the given name.
+// It returns false if the name is no slice name of the APINet load balancer.
+func apiNetLoadBalancerRoutingIndex(apiNetLoadBalancerName, name string) (int, bool) {
+	if name == apiNetLoadBalancerName {
+		return 0, true
+	}
+	suffix, ok := strings.CutPrefix(name, apiNetLoadBalancerName+"-")
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index <= 0 || strconv.Itoa(index) != suffix {
+		return 0, false
+	}
+	return index, true
+}
+
+// compareAPINetLoadBalancerRoutingNames orders APINet load balancer routing slice names by their index.
+// Names that are no slice names of the APINet load balancer are ordered last.
+func compareAPINetLoadBalancerRoutingNames(apiNetLoadBalancerName string) func(name1, name2 string) int {
+	return func(name1, name2 string) int {
+		index1, ok1 := apiNetLoadBalancerRoutingIndex(apiNetLoadBalancerName, name1)
+		index2, ok2 := apiNetLoadBalancerRoutingIndex(apiNetLoadBalancerName, name2)
+		switch {
+		case ok1 && ok2:
+			return cmp.Compare(index1, index2)
+		case ok1:
+			return -1
+		case ok2:
+			return 1
+		default:
+			return strings.Compare(name1, name2)
+		}
+	}
+}
+
+// listAPINetLoadBalancerRoutings lists all APINet load balancer routing slices of the given load balancer.
+func (r *LoadBalancerReconciler) listAPINetLoadBalancerRoutings(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer) ([]apinetv1alpha1.LoadBalancerRouting, error) {
+	apiNetLBRoutingList := &apinetv1alpha1.LoadBalancerRoutingList{}
+	if err := r.APINetClient.List(ctx, apiNetLBRoutingList,
+		client.InNamespace(r.APINetNamespace),
+		client.MatchingLabels{apinetv1alpha1.LoadBalancerNameLabel: string(loadBalancer.UID)},
+	); err != nil {
+		return nil, fmt.Errorf("error listing APINet load balancer routings: %w", err)
+	}
+
+	apiNetLBRoutings := apiNetLBRoutingList.Items
+	if slices.ContainsFunc(apiNetLBRoutings, func(apiNetLBRouting apinetv1alpha1.LoadBalancerRouting) bool {
+		return apiNetLBRouting.Name == string(loadBalancer.UID)
+	}) {
+		return apiNetLBRoutings, nil
+	}
+
+	// Routings created before slicing are not labeled, get the first slice by its name.
+	apiNetLBRouting := &apinetv1alpha1.LoadBalancerRouting{}
+	apiNetLBRoutingKey := client.ObjectKey{Namespace: r.APINetNamespace, Name: string(loadBalancer.UID)}
+	if err := r.APINetClient.Get(ctx, apiNetLBRoutingKey, apiNetLBRouting); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting APINet load balancer routing: %w", err)
+		}
+		return apiNetLBRoutings, nil
+	}
+	return append(apiNetLBRoutings, *apiNetLBRouting), nil
+}
+
+// addDrainingAPINetDestinations adds all destinations of the current APINet load balancer routings that are
+// no longer desired as draining destinations. Draining destinations whose drain deadline has passed are dropped.
+// It returns the duration until the next draining destination has to be dropped, zero if there is none.
+func (r *LoadBalancerReconciler) addDrainingAPINetDestinations(
+	apiNetLBRoutings []apinetv1alpha1.LoadBalancerRouting,
+	apiNetDsts []apinetv1alpha1.LoadBalancerDestination,
+) ([]apinetv1alpha1.LoadBalancerDestination, time.Duration) {
+	if r.DestinationDrainTimeout <= 0 {
+		return apiNetDsts, 0
+	}
+
+	var (
+		now          = time.Now()
+		requeueAfter time.Duration
+	)
+	for _, apiNetLBRouting := range apiNetLBRoutings {
+		for _, dst := range apiNetLBRouting.Destinations {
+			if slices.ContainsFunc(apiNetDsts, func(desired apinetv1alpha1.LoadBalancerDestination) bool {
+				return desired.IP == dst.IP
+			}) {
+				continue
+			}
+
+			if !apinetv1alpha1.IsLoadBalancerDestinationDraining(&dst) {
+				dst.State = apinetv1alpha1.LoadBalancerDestinationStateDraining
+				dst.DrainingTimestamp = &metav1.Time{Time: now}
+				dst.DrainTimeout = &metav1.Duration{Duration: r.DestinationDrainTimeout}
+			}
+
+			remaining := apinetv1alpha1.LoadBalancerDestinationDrainDeadline(&dst).Sub(now)
+			if remaining <= 0 {
+				continue
+			}
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			apiNetDsts = append(apiNetDsts, dst)
+		}
+	}
+	return apiNetDsts, requeueAfter
+}
+
+// sliceAPINetDestinations distributes the destinations across APINet load balancer routing slices holding
+// at most maxDestinations destinations each. A destination stays in the slice it currently is in, if possible,
+// so that a change only touches as few slices as possible. The result maps slice names to their destinations.
+// The first slice is always part of the result, other slices only if they hold any destination.
+func sliceAPINetDestinations(
+	apiNetLoadBalancerName string,
+	apiNetLBRoutings []apinetv1alpha1.LoadBalancerRouting,
+	apiNetDsts []apinetv1alpha1.LoadBalancerDestination,
+	maxDestinations int,
+) map[string][]apinetv1alpha1.LoadBalancerDestination {
+	if maxDestinations <= 0 {
+		maxDestinations = len(apiNetDsts)
+	}
+
+	sliceNameByIP := make(map[net.IP]string)
+	sliceNames := []string{apiNetLoadBalancerRoutingName(apiNetLoadBalancerName, 0)}
+	for _, apiNetLBRouting := range apiNetLBRoutings {
+		if !slices.Contains(sliceNames, apiNetLBRouting.Name) {
+			sliceNames = append(sliceNames, apiNetLBRouting.Name)
+		}
+		for _, dst := range apiNetLBRouting.Destinations {
+			sliceNameByIP[dst.IP] = apiNetLBRouting.Name
+		}
+	}
+	slices.SortFunc(sliceNames[1:], compareAPINetLoadBalancerRoutingNames(apiNetLoadBalancerName))
+
+	var (
+		res     = map[string][]apinetv1alpha1.LoadBalancerDestination{sliceNames[0]: nil}
+		pending []apinetv1alpha1.LoadBalancerDestination
+	)
+	for _, dst := range apiNetDsts {
+		sliceName, ok := sliceNameByIP[dst.IP]
+		if !ok || len(res[sliceName]) >= maxDestinations {
+			pending = append(pending, dst)
+			continue
+		}
+		res[sliceName] = append(res[sliceName], dst)
+	}
+
+	nextIndex := 1
+	for len(pending) > 0 {
+		var sliceName string
+		for _, name := range sliceNames {
+			if len(res[name]) < maxDestinations {
+				sliceName = name
+				break
+			}
+		}
+		for sliceName == "" {
+			if name := apiNetLoadBalancerRoutingName(apiNetLoadBalancerName, nextIndex); !slices.Contains(sliceNames, name) {
+				sliceName = name
+				sliceNames = append(sliceNames, sliceName)
+			}
+			nextIndex++
+		}
+
+		n := min(maxDestinations-len(res[sliceName]), len(pending))
+		res[sliceName] = append(res[sliceName], pending[:n]...)
+		pending = pending[n:]
+	}
+	return res
+}
+
+func (r *LoadBalancerReconciler) manageAPINetLoadBalancerRouting(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer, apiNetLoadBalancer *apinetv1alpha1.LoadBalancer, apiNetDsts []apinetv1alpha1.LoadBalancerDestination) (time.Duration, error) {
+	apiNetLBRoutings, err := r.listAPINetLoadBalancerRoutings(ctx, loadBalancer)
+	if err != nil {
+		return 0, err
+	}
+
+	apiNetDsts, requeueAfter := r.addDrainingAPINetDestinations(apiNetLBRoutings, apiNetDsts)
+	apiNetDstsBySliceName := sliceAPINetDestinations(apiNetLoadBalancer.Name, apiNetLBRoutings, apiNetDsts, r.RoutingMaxDestinations)
+
+	for _, sliceName := range orderAPINetLoadBalancerRoutingSlices(apiNetLoadBalancer.Name, apiNetLBRoutings, apiNetDstsBySliceName) {
+		if err := r.applyAPINetLoadBalancerRouting(ctx, loadBalancer, apiNetLoadBalancer, sliceName, apiNetDstsBySliceName[sliceName]); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, apiNetLBRouting := range apiNetLBRoutings {
+		if _, ok := apiNetDstsBySliceName[apiNetLBRouting.Name]; ok {
+			continue
+		}
+
+		if err := r.APINetClient.Delete(ctx, &apiNetLBRouting); client.IgnoreNotFound(err) != nil {
+			return 0, fmt.Errorf("error deleting APINet load balancer routing %s: %w", apiNetLBRouting.Name, err)
+		}
+	}
+	return requeueAfter, nil
+}
+
+// orderAPINetLoadBalancerRoutingSlices returns the names of the given slices in the order they have to be applied.
+// Slices that add a destination are applied before all other slices, so that a destination moving between
+// slices is added to its new slice before it is removed from its old one.
+func orderAPINetLoadBalancerRoutingSlices(
+	apiNetLoadBalancerName string,
+	apiNetLBRoutings []apinetv1alpha1.LoadBalancerRouting,
+	apiNetDstsBySliceName map[string][]apinetv1alpha1.LoadBalancerDestination,
+) []string {
+	currentIPsBySliceName := make(map[string]sets.Set[net.IP], len(apiNetLBRoutings))
+	for _, apiNetLBRouting := range apiNetLBRoutings {
+		currentIPsBySliceName[apiNetLBRouting.Name] = utilslices.ToSetFunc(apiNetLBRouting.Destinations,
+			func(dst apinetv1alpha1.LoadBalancerDestination) net.IP { return dst.IP },
+		)
+	}
+
+	addsDestination := func(sliceName string) bool {
+		return slices.ContainsFunc(apiNetDstsBySliceName[sliceName], func(dst apinetv1alpha1.LoadBalancerDestination) bool {
+			return !currentIPsBySliceName[sliceName].Has(dst.IP)
+		})
+	}
+
+	sliceNames := make([]string, 0, len(apiNetDstsBySliceName))
+	for sliceName := range apiNetDstsBySliceName {
+		sliceNames = append(sliceNames, sliceName)
+	}
+	slices.SortFunc(sliceNames, compareAPINetLoadBalancerRoutingNames(apiNetLoadBalancerName))
+	slices.SortStableFunc(sliceNames, func(name1, name2 string) int {
+		switch adds1, adds2 := addsDestination(name1), addsDestination(name2); {
+		case adds1 == adds2:
+			return 0
+		case adds1:
+			return -1
+		default:
+			return 1
+		}
+	})
+	return sliceNames
+}
+
+func (r *LoadBalancerReconciler) applyAPINetLoadBalancerRouting(
+	ctx context.Context,
+	loadBalancer *networkingv1alpha1.LoadBalancer,
+	apiNetLoadBalancer *apinetv1alpha1.LoadBalancer,
+	name string,
+	apiNetDsts []apinetv1alpha1.LoadBalancerDestination,
+) error {
 	ownerRef := metav1apply.OwnerReference().
 		WithAPIVersion(apinetv1alpha1.SchemeGroupVersion.String()).
 		WithKind("LoadBalancer").
@@ -242,17 +513,26 @@ func (r *LoadBalancerReconciler) manageAPINetLoadBalancerRouting(ctx context.Con
 					WithName(dst.TargetRef.Name).
 					WithNodeRef(corev1.LocalObjectReference{Name: dst.TargetRef.NodeRef.Name}))
 		}
+		if apinetv1alpha1.IsLoadBalancerDestinationDraining(&dst) {
+			dstCfg = dstCfg.
+				WithState(apinetv1alpha1.LoadBalancerDestinationStateDraining).
+				WithDrainingTimestamp(*dst.DrainingTimestamp)
+			if dst.DrainTimeout != nil {
+				dstCfg = dstCfg.WithDrainTimeout(*dst.DrainTimeout)
+			}
+		}
 		dstConfigs[i] = dstCfg
 	}
 
-	apiNetLBRoutingApplycfg := apinetv1alpha1ac.LoadBalancerRouting(string(loadBalancer.UID), r.APINetNamespace).
+	apiNetLBRoutingApplycfg := apinetv1alpha1ac.LoadBalancerRouting(name, r.APINetNamespace).
 		WithAnnotations(LoadBalancerOrigin.Annotations(loadBalancer)).
 		WithLabels(LoadBalancerOrigin.Labels(loadBalancer)).
+		WithLabels(map[string]string{apinetv1alpha1.LoadBalancerNameLabel: apiNetLoadBalancer.Name}).
 		WithDestinations(dstConfigs...).
 		WithOwnerReferences(ownerRef)
 
 	if err := r.APINetClient.Apply(ctx, apiNetLBRoutingApplycfg, fieldOwner, client.ForceOwnership); err != nil {
-		return fmt.Errorf("error applying APINet load balancer routing: %w", err)
+		return fmt.Errorf("error applying APINet load balancer routing %s: %w", name, err)
 	}
 	return nil
 }
@@ -267,8 +547,34 @@ func (r *LoadBalancerReconciler) getPublicLoadBalancerAPINetIPs(loadBalancer *ne
 	return res
 }
 
-func (r *LoadBalancerReconciler) getInternalLoadBalancerAPINetIPs(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer) ([]*apinetv1alpha1ac.LoadBalancerIPApplyConfiguration, error) {
+func (r *LoadBalancerReconciler) getInternalLoadBalancerAPINetIPs(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer, apiNetNetworkName string) ([]*apinetv1alpha1ac.LoadBalancerIPApplyConfiguration, error) {
 	var ips []*apinetv1alpha1ac.LoadBalancerIPApplyConfiguration
+	if len(loadBalancer.Spec.IPs) == 0 {
+		apiNetNetworkKey := client.ObjectKey{Namespace: r.APINetNamespace, Name: apiNetNetworkName}
+		apiNetNetwork, err := getApiNetNetwork(ctx, r.APINetClient, apiNetNetworkKey)
+		if err != nil {
+			return nil, err
+		}
+		if apiNetNetwork == nil {
+			return nil, nil
+		}
+
+		// Request the IPs to be allocated from the address space of the APINet network.
+		// IP families the APINet network has no address space of are left out.
+		for _, ipFamily := range loadBalancer.Spec.IPFamilies {
+			if !apiNetNetworkHasPrefixOfIPFamily(apiNetNetwork, ipFamily) {
+				continue
+			}
+
+			ips = append(ips,
+				apinetv1alpha1ac.LoadBalancerIP().
+					WithName(strings.ToLower(string(ipFamily))).
+					WithIPFamily(ipFamily),
+			)
+		}
+		return ips, nil
+	}
+
 	for i, ip := range loadBalancer.Spec.IPs {
 		switch {
 		case ip.Value != nil:
@@ -310,6 +616,36 @@ func (r *LoadBalancerReconciler) getInternalLoadBalancerAPINetIPs(ctx context.Co
 	return ips, nil
 }
 
+func apiNetNetworkHasPrefixOfIPFamily(apiNetNetwork *apinetv1alpha1.Network, ipFamily corev1.IPFamily) bool {
+	return slices.ContainsFunc(apiNetNetwork.Spec.Prefixes, func(prefix net.IPPrefix) bool {
+		return prefix.Addr().Is4() == (ipFamily == corev1.IPv4Protocol)
+	})
+}
+
+// apiNetLoadBalancerIPFamilies returns the IP families of the APINet load balancer for the given load balancer.
+// Internal APINet load balancers only get the IP families of their IPs, as they require an IP of every IP family
+// and IPs can only be allocated for IP families the APINet network has an address space of.
+func apiNetLoadBalancerIPFamilies(loadBalancer *networkingv1alpha1.LoadBalancer, ips []*apinetv1alpha1ac.LoadBalancerIPApplyConfiguration) []corev1.IPFamily {
+	if loadBalancer.Spec.Type != networkingv1alpha1.LoadBalancerTypeInternal {
+		return loadBalancer.Spec.IPFamilies
+	}
+
+	return slices.DeleteFunc(slices.Clone(loadBalancer.Spec.IPFamilies), func(ipFamily corev1.IPFamily) bool {
+		return !slices.ContainsFunc(ips, func(ip *apinetv1alpha1ac.LoadBalancerIPApplyConfiguration) bool {
+			return ip.IPFamily != nil && *ip.IPFamily == ipFamily
+		})
+	})
+}
+
+// loadBalancerIPFamilyPolicy returns the IP family policy of an APINet load balancer with the given IP families.
+// A load balancer specifying both IP families requires dual-stack.
+func loadBalancerIPFamilyPolicy(ipFamilies []corev1.IPFamily) corev1.IPFamilyPolicy {
+	if len(ipFamilies) > 1 {
+		return corev1.IPFamilyPolicyRequireDualStack
+	}
+	return corev1.IPFamilyPolicySingleStack
+}
+
 func (r *LoadBalancerReconciler) applyAPINetLoadBalancer(ctx context.Context, loadBalancer *networkingv1alpha1.LoadBalancer, apiNetDestinations []apinetv1alpha1.LoadBalancerDestination, apiNetNetworkName string) (*apinetv1alpha1.LoadBalancer, error) {
 	apiNetLoadBalancerType, err := loadBalancerTypeToAPINetLoadBalancerType(loadBalancer.Spec.Type)
 	if err != nil {
@@ -319,7 +655,7 @@ func (r *LoadBalancerReconciler) applyAPINetLoadBalancer(ctx context.Context, lo
 	var ips []*apinetv1alpha1ac.LoadBalancerIPApplyConfiguration
 	switch loadBalancer.Spec.Type {
 	case networkingv1alpha1.LoadBalancerTypeInternal:
-		ips, err = r.getInternalLoadBalancerAPINetIPs(ctx, loadBalancer)
+		ips, err = r.getInternalLoadBalancerAPINetIPs(ctx, loadBalancer, apiNetNetworkName)
 		if err != nil {
 			return nil, err
 		}
@@ -352,6 +688,12 @@ func (r *LoadBalancerReconciler) applyAPINetLoadBalancer(ctx context.Context, lo
 			),
 		)
 
+	if ipFamilies := apiNetLoadBalancerIPFamilies(loadBalancer, ips); len(ipFamilies) > 0 {
+		apiNetLoadBalancerApplyCfg.Spec.
+			WithIPFamilyPolicy(loadBalancerIPFamilyPolicy(ipFamilies)).
+			WithIPFamilies(ipFamilies...)
+	}
+
 	if r.IsNodeAffinityAware {
 		if len(apiNetDestinations) > 0 {
 			uniqueDsts := make(map[string]apinetv1alpha1.LoadBalancerDestination)
@@ -4,7 +4,10 @@
 package controllers
 
 import (
+	"fmt"
 	"net/netip"
+	"slices"
+	"time"
 
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
@@ -89,6 +92,47 @@ var _ = Describe("LoadBalancerController", func() {
 		)
 	})
 
+	It("should manage the dual-stack APINet load balancer and its IPs", func(ctx SpecContext) {
+		By("creating a dual-stack load balancer")
+		loadBalancer := &networkingv1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "load-balancer-",
+			},
+			Spec: networkingv1alpha1.LoadBalancerSpec{
+				Type:       networkingv1alpha1.LoadBalancerTypePublic,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+		By("waiting for the APINet load balancer to be dual-stack")
+		apiNetLoadBalancer := &v1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: apiNetNs.Name,
+				Name:      string(loadBalancer.UID),
+			},
+		}
+		Eventually(Object(apiNetLoadBalancer)).Should(HaveField("Spec", MatchFields(IgnoreExtras, Fields{
+			"IPFamilyPolicy": HaveValue(Equal(corev1.IPFamilyPolicyRequireDualStack)),
+			"IPFamilies":     Equal([]corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}),
+			"IPs": ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"IPFamily": Equal(corev1.IPv4Protocol),
+					"Name":     Equal("ipv4"),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"IPFamily": Equal(corev1.IPv6Protocol),
+					"Name":     Equal("ipv6"),
+				}),
+			),
+		})))
+
+		By("waiting for the load balancer to report both IPs")
+		Eventually(Object(loadBalancer)).Should(HaveField("Status.IPs", HaveLen(2)))
+	})
+
 	It("should manage the internal APINet load balancer and its discrete IPs", func(ctx SpecContext) {
 		By("creating an internal load balancer")
 		loadBalancer := &networkingv1alpha1.LoadBalancer{
@@ -152,6 +196,74 @@ var _ = Describe("LoadBalancerController", func() {
 		)
 	})
 
+	It("should manage the internal APINet load balancer allocating its IPs from the APINet network", func(ctx SpecContext) {
+		By("setting the prefixes of the APINet network")
+		Eventually(Update(apiNetNetwork, func() {
+			apiNetNetwork.Spec.Prefixes = []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")}
+		})).Should(Succeed())
+
+		By("creating an internal load balancer without IPs")
+		loadBalancer := &networkingv1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "load-balancer-",
+			},
+			Spec: networkingv1alpha1.LoadBalancerSpec{
+				Type:       networkingv1alpha1.LoadBalancerTypeInternal,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+		By("waiting for the internal APINet load balancer to have an IP of the APINet network")
+		apiNetLoadBalancer := &v1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: apiNetNs.Name,
+				Name:      string(loadBalancer.UID),
+			},
+		}
+		Eventually(Object(apiNetLoadBalancer)).Should(HaveField("Spec.IPs", ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Name":     Equal("ipv4"),
+			"IPFamily": Equal(corev1.IPv4Protocol),
+			"IP":       Equal(net.MustParseIP("10.0.0.1")),
+		}))))
+
+		By("waiting for the load balancer to report the IP")
+		Eventually(Object(loadBalancer)).Should(HaveField("Status.IPs", ConsistOf(
+			commonv1alpha1.MustParseIP("10.0.0.1"),
+		)))
+	})
+
+	It("should manage the internal APINet load balancer without IPs if the APINet network has no address space", func(ctx SpecContext) {
+		By("creating an internal load balancer without IPs")
+		loadBalancer := &networkingv1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "load-balancer-",
+			},
+			Spec: networkingv1alpha1.LoadBalancerSpec{
+				Type:       networkingv1alpha1.LoadBalancerTypeInternal,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+		By("waiting for the internal APINet load balancer to be created without IPs")
+		apiNetLoadBalancer := &v1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: apiNetNs.Name,
+				Name:      string(loadBalancer.UID),
+			},
+		}
+		Eventually(Object(apiNetLoadBalancer)).Should(SatisfyAll(
+			HaveField("Spec.Type", v1alpha1.LoadBalancerTypeInternal),
+			HaveField("Spec.IPs", BeEmpty()),
+			HaveField("Spec.IPFamilies", BeEmpty()),
+		))
+	})
+
 	It("should manage the internal APINet load balancer and its ephemeral IPs", func(ctx SpecContext) {
 		By("creating a new parent prefix")
 		parentPrefix := &ipamv1alpha1.Prefix{
@@ -394,7 +506,8 @@ var _ = Describe("LoadBalancerController", func() {
 				}),
 				HaveField("Destinations", ConsistOf(
 					v1alpha1.LoadBalancerDestination{
-						IP: net.MustParseIP("192.168.0.1"),
+						IP:    net.MustParseIP("192.168.0.1"),
+						State: v1alpha1.LoadBalancerDestinationStateActive,
 						TargetRef: &v1alpha1.LoadBalancerTargetRef{
 							UID:  "first-metalnet-nic-uid",
 							Name: "first-apinet-nic-name",
@@ -404,7 +517,8 @@ var _ = Describe("LoadBalancerController", func() {
 						},
 					},
 					v1alpha1.LoadBalancerDestination{
-						IP: net.MustParseIP("192.168.0.2"),
+						IP:    net.MustParseIP("192.168.0.2"),
+						State: v1alpha1.LoadBalancerDestinationStateActive,
 						TargetRef: &v1alpha1.LoadBalancerTargetRef{
 							UID:  "second-metalnet-nic-uid",
 							Name: "second-apinet-nic-name",
@@ -416,3 +530,203 @@ var _ = Describe("LoadBalancerController", func() {
 				))))
 	})
 })
+
+var _ = Describe("sliceAPINetDestinations", func() {
+	dst := func(ip string) v1alpha1.LoadBalancerDestination {
+		return v1alpha1.LoadBalancerDestination{IP: net.MustParseIP(ip)}
+	}
+
+	It("should split the destinations into slices of the maximum size", func() {
+		Expect(sliceAPINetDestinations("lb", nil, []v1alpha1.LoadBalancerDestination{
+			dst("10.0.0.1"),
+			dst("10.0.0.2"),
+			dst("10.0.0.3"),
+		}, 2)).To(Equal(map[string][]v1alpha1.LoadBalancerDestination{
+			"lb":   {dst("10.0.0.1"), dst("10.0.0.2")},
+			"lb-1": {dst("10.0.0.3")},
+		}))
+	})
+
+	It("should keep destinations in their current slice and drop empty slices", func() {
+		Expect(sliceAPINetDestinations("lb", []v1alpha1.LoadBalancerRouting{
+			{
+				ObjectMeta:   metav1.ObjectMeta{Name: "lb"},
+				Destinations: []v1alpha1.LoadBalancerDestination{dst("10.0.0.1"), dst("10.0.0.2")},
+			},
+			{
+				ObjectMeta:   metav1.ObjectMeta{Name: "lb-1"},
+				Destinations: []v1alpha1.LoadBalancerDestination{dst("10.0.0.3")},
+			},
+			{
+				ObjectMeta:   metav1.ObjectMeta{Name: "lb-2"},
+				Destinations: []v1alpha1.LoadBalancerDestination{dst("10.0.0.4")},
+			},
+		}, []v1alpha1.LoadBalancerDestination{
+			dst("10.0.0.2"),
+			dst("10.0.0.3"),
+			dst("10.0.0.5"),
+		}, 2)).To(Equal(map[string][]v1alpha1.LoadBalancerDestination{
+			"lb":   {dst("10.0.0.2"), dst("10.0.0.5")},
+			"lb-1": {dst("10.0.0.3")},
+		}))
+	})
+
+	It("should fill slices in the order of their index", func() {
+		routings := make([]v1alpha1.LoadBalancerRouting, 0, 11)
+		for i := 10; i >= 1; i-- {
+			routings = append(routings, v1alpha1.LoadBalancerRouting{
+				ObjectMeta:   metav1.ObjectMeta{Name: apiNetLoadBalancerRoutingName("lb", i)},
+				Destinations: []v1alpha1.LoadBalancerDestination{dst(fmt.Sprintf("10.0.1.%d", i))},
+			})
+		}
+		routings = append(routings, v1alpha1.LoadBalancerRouting{
+			ObjectMeta: metav1.ObjectMeta{Name: "lb"},
+		})
+
+		By("removing the destinations of the slices 2 and 10 and adding two new ones")
+		dsts := []v1alpha1.LoadBalancerDestination{dst("10.0.0.1"), dst("10.0.0.2")}
+		for i := 1; i <= 10; i++ {
+			if i != 2 && i != 10 {
+				dsts = append(dsts, dst(fmt.Sprintf("10.0.1.%d", i)))
+			}
+		}
+
+		res := sliceAPINetDestinations("lb", routings, dsts, 1)
+		Expect(res).To(HaveKeyWithValue("lb", ConsistOf(dst("10.0.0.1"))))
+		Expect(res).To(HaveKeyWithValue("lb-2", ConsistOf(dst("10.0.0.2"))))
+		Expect(res).NotTo(HaveKey("lb-10"))
+	})
+
+	It("should keep all destinations in a single slice if there is no maximum size", func() {
+		Expect(sliceAPINetDestinations("lb", nil, []v1alpha1.LoadBalancerDestination{
+			dst("10.0.0.1"),
+			dst("10.0.0.2"),
+		}, 0)).To(Equal(map[string][]v1alpha1.LoadBalancerDestination{
+			"lb": {dst("10.0.0.1"), dst("10.0.0.2")},
+		}))
+	})
+})
+
+var _ = Describe("compareAPINetLoadBalancerRoutingNames", func() {
+	It("should order slice names by their index", func() {
+		names := []string{"lb-10", "other", "lb-2", "lb", "lb-1", "lb-01"}
+		slices.SortFunc(names, compareAPINetLoadBalancerRoutingNames("lb"))
+		Expect(names).To(Equal([]string{"lb", "lb-1", "lb-2", "lb-10", "lb-01", "other"}))
+	})
+})
+
+var _ = Describe("orderAPINetLoadBalancerRoutingSlices", func() {
+	dst := func(ip string) v1alpha1.LoadBalancerDestination {
+		return v1alpha1.LoadBalancerDestination{IP: net.MustParseIP(ip)}
+	}
+
+	It("should order slices adding destinations before slices removing destinations", func() {
+		Expect(orderAPINetLoadBalancerRoutingSlices("lb",
+			[]v1alpha1.LoadBalancerRouting{
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: "lb"},
+					Destinations: []v1alpha1.LoadBalancerDestination{dst("10.0.0.1"), dst("10.0.0.2")},
+				},
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: "lb-1"},
+					Destinations: []v1alpha1.LoadBalancerDestination{dst("10.0.0.3")},
+				},
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: "lb-2"},
+					Destinations: []v1alpha1.LoadBalancerDestination{dst("10.0.0.4")},
+				},
+			},
+			map[string][]v1alpha1.LoadBalancerDestination{
+				"lb":   {dst("10.0.0.1")},
+				"lb-1": {dst("10.0.0.3")},
+				"lb-2": {dst("10.0.0.4"), dst("10.0.0.2")},
+				"lb-3": {dst("10.0.0.5")},
+			},
+		)).To(Equal([]string{"lb-2", "lb-3", "lb", "lb-1"}))
+	})
+})
+
+var _ = Describe("addDrainingAPINetDestinations", func() {
+	dst := func(ip string) v1alpha1.LoadBalancerDestination {
+		return v1alpha1.LoadBalancerDestination{IP: net.MustParseIP(ip)}
+	}
+	drainingDst := func(ip string, drainingTimestamp time.Time, drainTimeout time.Duration) v1alpha1.LoadBalancerDestination {
+		return v1alpha1.LoadBalancerDestination{
+			IP:                net.MustParseIP(ip),
+			State:             v1alpha1.LoadBalancerDestinationStateDraining,
+			DrainingTimestamp: &metav1.Time{Time: drainingTimestamp},
+			DrainTimeout:      &metav1.Duration{Duration: drainTimeout},
+		}
+	}
+	routings := func(dsts ...v1alpha1.LoadBalancerDestination) []v1alpha1.LoadBalancerRouting {
+		return []v1alpha1.LoadBalancerRouting{
+			{
+				ObjectMeta:   metav1.ObjectMeta{Name: "lb"},
+				Destinations: dsts,
+			},
+		}
+	}
+
+	It("should add a removed destination as draining", func() {
+		r := &LoadBalancerReconciler{DestinationDrainTimeout: time.Minute}
+
+		before := time.Now()
+		dsts, requeueAfter := r.addDrainingAPINetDestinations(
+			routings(dst("10.0.0.1"), dst("10.0.0.2")),
+			[]v1alpha1.LoadBalancerDestination{dst("10.0.0.1")},
+		)
+		Expect(dsts).To(ConsistOf(
+			dst("10.0.0.1"),
+			MatchFields(IgnoreExtras, Fields{
+				"IP":                Equal(net.MustParseIP("10.0.0.2")),
+				"State":             Equal(v1alpha1.LoadBalancerDestinationStateDraining),
+				"DrainingTimestamp": PointTo(HaveField("Time", BeTemporally(">=", before))),
+				"DrainTimeout":      Equal(&metav1.Duration{Duration: time.Minute}),
+			}),
+		))
+		Expect(requeueAfter).To(BeNumerically("~", time.Minute, time.Second))
+	})
+
+	It("should keep a draining destination until its drain timeout passed", func() {
+		r := &LoadBalancerReconciler{DestinationDrainTimeout: time.Minute}
+
+		draining := drainingDst("10.0.0.2", time.Now().Add(-50*time.Second), time.Minute)
+		dsts, requeueAfter := r.addDrainingAPINetDestinations(routings(draining), nil)
+		Expect(dsts).To(ConsistOf(draining))
+		Expect(requeueAfter).To(BeNumerically("~", 10*time.Second, time.Second))
+	})
+
+	It("should requeue for the draining destination that expires first", func() {
+		r := &LoadBalancerReconciler{DestinationDrainTimeout: time.Minute}
+
+		now := time.Now()
+		dsts, requeueAfter := r.addDrainingAPINetDestinations(routings(
+			drainingDst("10.0.0.2", now.Add(-10*time.Second), time.Minute),
+			drainingDst("10.0.0.3", now.Add(-40*time.Second), time.Minute),
+		), nil)
+		Expect(dsts).To(HaveLen(2))
+		Expect(requeueAfter).To(BeNumerically("~", 20*time.Second, time.Second))
+	})
+
+	It("should drop a draining destination after its drain timeout passed", func() {
+		r := &LoadBalancerReconciler{DestinationDrainTimeout: time.Minute}
+
+		dsts, requeueAfter := r.addDrainingAPINetDestinations(
+			routings(drainingDst("10.0.0.2", time.Now().Add(-2*time.Minute), time.Minute)),
+			[]v1alpha1.LoadBalancerDestination{dst("10.0.0.1")},
+		)
+		Expect(dsts).To(ConsistOf(dst("10.0.0.1")))
+		Expect(requeueAfter).To(BeZero())
+	})
+
+	It("should not drain destinations if there is no drain timeout", func() {
+		r := &LoadBalancerReconciler{}
+
+		dsts, requeueAfter := r.addDrainingAPINetDestinations(
+			routings(dst("10.0.0.1"), dst("10.0.0.2")),
+			[]v1alpha1.LoadBalancerDestination{dst("10.0.0.1")},
+		)
+		Expect(dsts).To(ConsistOf(dst("10.0.0.1")))
+		Expect(requeueAfter).To(BeZero())
+	})
+})
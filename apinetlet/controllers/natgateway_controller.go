@@ -5,8 +5,11 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	apinetv1alpha1 "github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
@@ -19,14 +22,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/ironcore-dev/controller-utils/clientutils"
-	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	"github.com/ironcore-dev/ironcore/utils/generic"
 	"github.com/ironcore-dev/ironcore/utils/predicates"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -37,6 +41,16 @@ import (
 
 const (
 	natGatewayFinalizer = "apinet.ironcore.dev/natgateway"
+
+	// NATGatewayUtilizationAnnotation reports the port range usage of the NAT gateway IPs as JSON list.
+	NATGatewayUtilizationAnnotation = "apinet.ironcore.dev/nat-gateway-utilization"
+	// NATGatewayFullSinceAnnotation is the last transition time of the Full condition of the APINet NAT gateway
+	// the Full event was recorded for. It is only set while the APINet NAT gateway is full.
+	NATGatewayFullSinceAnnotation = "apinet.ironcore.dev/nat-gateway-full-since"
+
+	// natGatewayFullReason is the reason of the event reporting that network interfaces of the NAT gateway
+	// could not be assigned a port range because all port ranges of its IPs are in use.
+	natGatewayFullReason = "Full"
 )
 
 var (
@@ -48,6 +62,7 @@ var (
 
 type NATGatewayReconciler struct {
 	client.Client
+	events.EventRecorder
 	APINetClient    client.Client
 	APINetInterface ironcorenet.Interface
 	APINetNamespace string
@@ -58,6 +73,7 @@ type NATGatewayReconciler struct {
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=natgateways,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=natgateways/finalizers,verbs=update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=natgateways/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 //+cluster=apinet:kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgateways,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+cluster=apinet:kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgatewayautoscalers,verbs=get;list;watch;create;update;patch;delete;deletecollection
@@ -196,26 +212,108 @@ func (r *NATGatewayReconciler) reconcile(ctx context.Context, log logr.Logger, n
 		return ctrl.Result{}, fmt.Errorf("error applying APINet NAT gateway autoscaler: %w", err)
 	}
 
-	natGatewayIPs := apiNetIPsToIPs(apinetv1alpha1.GetNATGatewayIPs(apiNetNATGateway))
-	if !slices.Equal(natGateway.Status.IPs, natGatewayIPs) {
-		if err := r.updateNATGatewayStatus(ctx, natGateway, natGatewayIPs); err != nil {
-			return ctrl.Result{}, fmt.Errorf("error updating NAT gateway status IPs: %w", err)
-		}
-		log.V(1).Info("Updated NAT gateway status IPs", "ips", natGatewayIPs)
+	if err := r.updateNATGatewayUtilization(ctx, natGateway, apiNetNATGateway); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating NAT gateway utilization: %w", err)
+	}
+
+	if err := r.updateNATGatewayStatus(ctx, natGateway, apiNetNATGateway); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating NAT gateway status: %w", err)
 	}
 
 	log.V(1).Info("Reconciled")
 	return ctrl.Result{}, nil
 }
 
+// updateNATGatewayStatus updates the status IPs of the NAT gateway from the APINet NAT gateway.
 func (r *NATGatewayReconciler) updateNATGatewayStatus(
 	ctx context.Context,
 	natGateway *networkingv1alpha1.NATGateway,
-	ips []commonv1alpha1.IP,
+	apiNetNATGateway *apinetv1alpha1.NATGateway,
 ) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	natGatewayIPs := apiNetIPsToIPs(apinetv1alpha1.GetNATGatewayIPs(apiNetNATGateway))
+	if slices.Equal(natGateway.Status.IPs, natGatewayIPs) {
+		return nil
+	}
+
 	base := natGateway.DeepCopy()
-	natGateway.Status.IPs = ips
-	return r.Status().Patch(ctx, natGateway, client.StrategicMergeFrom(base))
+	natGateway.Status.IPs = natGatewayIPs
+	if err := r.Status().Patch(ctx, natGateway, client.StrategicMergeFrom(base)); err != nil {
+		return err
+	}
+	log.V(1).Info("Updated NAT gateway status IPs", "ips", natGatewayIPs)
+	return nil
+}
+
+// natGatewayIPUtilization is the port range usage of a NAT gateway IP.
+type natGatewayIPUtilization struct {
+	IP                string `json:"ip"`
+	UsedSlots         int32  `json:"usedSlots"`
+	TotalSlots        int32  `json:"totalSlots"`
+	NetworkInterfaces int32  `json:"networkInterfaces"`
+}
+
+// updateNATGatewayUtilization surfaces the port range usage of the APINet NAT gateway IPs as annotation
+// of the NAT gateway, as the networking NAT gateway status has no fields for it.
+// Once the Full condition of the APINet NAT gateway transitions to true, a warning event is recorded.
+// The last transition time of the reported condition is remembered as annotation, so that the event
+// is recorded only once per transition, also across restarts.
+func (r *NATGatewayReconciler) updateNATGatewayUtilization(
+	ctx context.Context,
+	natGateway *networkingv1alpha1.NATGateway,
+	apiNetNATGateway *apinetv1alpha1.NATGateway,
+) error {
+	base := natGateway.DeepCopy()
+
+	if len(apiNetNATGateway.Status.IPs) > 0 {
+		utilization := make([]natGatewayIPUtilization, 0, len(apiNetNATGateway.Status.IPs))
+		for _, ipStatus := range apiNetNATGateway.Status.IPs {
+			utilization = append(utilization, natGatewayIPUtilization{
+				IP:                ipStatus.IP.String(),
+				UsedSlots:         ipStatus.UsedSlots,
+				TotalSlots:        ipStatus.TotalSlots,
+				NetworkInterfaces: ipStatus.NetworkInterfaces,
+			})
+		}
+		data, err := json.Marshal(utilization)
+		if err != nil {
+			return fmt.Errorf("error marshalling utilization: %w", err)
+		}
+		metav1.SetMetaDataAnnotation(&natGateway.ObjectMeta, NATGatewayUtilizationAnnotation, string(data))
+	} else {
+		delete(natGateway.Annotations, NATGatewayUtilizationAnnotation)
+	}
+
+	var recordFullEvent bool
+	if fullCond := meta.FindStatusCondition(apiNetNATGateway.Status.Conditions, apinetv1alpha1.NATGatewayFull); fullCond != nil &&
+		fullCond.Status == metav1.ConditionTrue {
+		fullSince := fullCond.LastTransitionTime.UTC().Format(time.RFC3339)
+		recordFullEvent = natGateway.Annotations[NATGatewayFullSinceAnnotation] != fullSince
+		metav1.SetMetaDataAnnotation(&natGateway.ObjectMeta, NATGatewayFullSinceAnnotation, fullSince)
+	} else {
+		delete(natGateway.Annotations, NATGatewayFullSinceAnnotation)
+	}
+
+	if !maps.Equal(base.Annotations, natGateway.Annotations) {
+		if err := r.Patch(ctx, natGateway, client.MergeFrom(base)); err != nil {
+			return err
+		}
+	}
+
+	if recordFullEvent {
+		var usedSlots, totalSlots, nics int32
+		for _, ipStatus := range apiNetNATGateway.Status.IPs {
+			usedSlots += ipStatus.UsedSlots
+			totalSlots += ipStatus.TotalSlots
+			nics += ipStatus.NetworkInterfaces
+		}
+		r.Eventf(natGateway, nil, corev1.EventTypeWarning, natGatewayFullReason, "AllocatingPortRanges",
+			"Network interfaces could not be assigned a port range, %d/%d port ranges are used by %d network interfaces",
+			usedSlots, totalSlots, nics,
+		)
+	}
+	return nil
 }
 
 func (r *NATGatewayReconciler) enqueueNATGatewayByNetwork() handler.TypedEventHandler[client.Object, reconcile.Request] {
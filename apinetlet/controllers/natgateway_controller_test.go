@@ -4,7 +4,10 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	. "github.com/ironcore-dev/ironcore-net/utils/testing"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	. "github.com/ironcore-dev/ironcore/utils/testing"
@@ -107,4 +110,95 @@ var _ = Describe("NATGatewayController", func() {
 			HaveField("Status.IPs", Not(BeEmpty())),
 		)
 	})
+
+	It("should report a full APINet NAT gateway", func(ctx SpecContext) {
+		By("creating a nat gateway")
+		natGateway := &networkingv1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: networkingv1alpha1.NATGatewaySpec{
+				Type:       networkingv1alpha1.NATGatewayTypePublic,
+				IPFamily:   corev1.IPv4Protocol,
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("waiting for the APINet NAT gateway to be present")
+		apiNetNATGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: apiNetNs.Name,
+				Name:      string(natGateway.UID),
+			},
+		}
+		Eventually(Object(apiNetNATGateway)).Should(StemFrom(NATGatewayOrigin, natGateway))
+
+		By("reporting a full APINet NAT gateway")
+		Eventually(UpdateStatus(apiNetNATGateway, func() {
+			apiNetNATGateway.Status.IPs = []v1alpha1.NATGatewayIPStatus{
+				{
+					Name:              "ip-1",
+					IP:                net.MustParseIP("10.0.0.1"),
+					TotalSlots:        8,
+					UsedSlots:         8,
+					NetworkInterfaces: 8,
+				},
+			}
+			apiNetNATGateway.Status.Conditions = []metav1.Condition{
+				{
+					Type:               v1alpha1.NATGatewayFull,
+					Status:             metav1.ConditionTrue,
+					Reason:             "NoFreePortRange",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+		})).Should(Succeed())
+
+		By("waiting for the NAT gateway full event")
+		Eventually(natGatewayEventRecorder.Events).Should(Receive(Equal(
+			"Warning Full Network interfaces could not be assigned a port range, 8/8 port ranges are used by 8 network interfaces",
+		)))
+
+		By("waiting for the NAT gateway to report the utilization")
+		Eventually(Object(natGateway)).Should(SatisfyAll(
+			HaveField("Annotations", HaveKeyWithValue(NATGatewayUtilizationAnnotation,
+				`[{"ip":"10.0.0.1","usedSlots":8,"totalSlots":8,"networkInterfaces":8}]`)),
+			HaveField("Annotations", HaveKey(NATGatewayFullSinceAnnotation)),
+		))
+
+		By("updating the utilization of the still full APINet NAT gateway")
+		Eventually(UpdateStatus(apiNetNATGateway, func() {
+			apiNetNATGateway.Status.IPs[0].NetworkInterfaces = 7
+		})).Should(Succeed())
+
+		By("waiting for the NAT gateway to report the updated utilization")
+		Eventually(Object(natGateway)).Should(HaveField("Annotations", HaveKeyWithValue(NATGatewayUtilizationAnnotation,
+			`[{"ip":"10.0.0.1","usedSlots":8,"totalSlots":8,"networkInterfaces":7}]`)))
+
+		By("asserting the full event is not recorded again")
+		Consistently(natGatewayEventRecorder.Events).ShouldNot(Receive())
+
+		By("reporting free port ranges on the APINet NAT gateway")
+		Eventually(UpdateStatus(apiNetNATGateway, func() {
+			apiNetNATGateway.Status.Conditions[0].Status = metav1.ConditionFalse
+			apiNetNATGateway.Status.Conditions[0].Reason = "PortRangesAvailable"
+		})).Should(Succeed())
+
+		By("waiting for the NAT gateway full since annotation to be removed")
+		Eventually(Object(natGateway)).Should(HaveField("Annotations", Not(HaveKey(NATGatewayFullSinceAnnotation))))
+
+		By("reporting a full APINet NAT gateway again")
+		Eventually(UpdateStatus(apiNetNATGateway, func() {
+			apiNetNATGateway.Status.Conditions[0].Status = metav1.ConditionTrue
+			apiNetNATGateway.Status.Conditions[0].Reason = "NoFreePortRange"
+			apiNetNATGateway.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(time.Minute))
+		})).Should(Succeed())
+
+		By("waiting for the NAT gateway full event to be recorded again")
+		Eventually(natGatewayEventRecorder.Events).Should(Receive(Equal(
+			"Warning Full Network interfaces could not be assigned a port range, 8/8 port ranges are used by 7 network interfaces",
+		)))
+	})
 })
@@ -17,6 +17,9 @@ type IPAddressClaimRefApplyConfiguration struct {
 	Namespace *string    `json:"namespace,omitempty"`
 	Name      *string    `json:"name,omitempty"`
 	UID       *types.UID `json:"uid,omitempty"`
+	// Pool is the name of the IP pool the address was allocated from.
+	// Empty for addresses of statically configured prefixes.
+	Pool *string `json:"pool,omitempty"`
 }
 
 // IPAddressClaimRefApplyConfiguration constructs a declarative configuration of the IPAddressClaimRef type for use with
@@ -64,3 +67,11 @@ func (b *IPAddressClaimRefApplyConfiguration) WithUID(value types.UID) *IPAddres
 	b.UID = &value
 	return b
 }
+
+// WithPool sets the Pool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pool field is set to the value of the last call.
+func (b *IPAddressClaimRefApplyConfiguration) WithPool(value string) *IPAddressClaimRefApplyConfiguration {
+	b.Pool = &value
+	return b
+}
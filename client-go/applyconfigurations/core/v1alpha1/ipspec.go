@@ -8,16 +8,24 @@ package v1alpha1
 import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	net "github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // IPSpecApplyConfiguration represents a declarative configuration of the IPSpec type for use
 // with apply.
 type IPSpecApplyConfiguration struct {
 	Type     *corev1alpha1.IPType          `json:"type,omitempty"`
-	IPFamily *v1.IPFamily                  `json:"ipFamily,omitempty"`
+	IPFamily *corev1.IPFamily              `json:"ipFamily,omitempty"`
 	IP       *net.IP                       `json:"ip,omitempty"`
 	ClaimRef *IPClaimRefApplyConfiguration `json:"claimRef,omitempty"`
+	// PoolRef references the IP pool to allocate the IP from.
+	// Mutually exclusive with PoolSelector.
+	PoolRef *corev1.LocalObjectReference `json:"poolRef,omitempty"`
+	// PoolSelector selects the IP pools to allocate the IP from.
+	// Mutually exclusive with PoolRef. If neither is set, the IP is allocated
+	// from any pool of matching type and IP family.
+	PoolSelector *v1.LabelSelectorApplyConfiguration `json:"poolSelector,omitempty"`
 }
 
 // IPSpecApplyConfiguration constructs a declarative configuration of the IPSpec type for use with
@@ -37,7 +45,7 @@ func (b *IPSpecApplyConfiguration) WithType(value corev1alpha1.IPType) *IPSpecAp
 // WithIPFamily sets the IPFamily field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the IPFamily field is set to the value of the last call.
-func (b *IPSpecApplyConfiguration) WithIPFamily(value v1.IPFamily) *IPSpecApplyConfiguration {
+func (b *IPSpecApplyConfiguration) WithIPFamily(value corev1.IPFamily) *IPSpecApplyConfiguration {
 	b.IPFamily = &value
 	return b
 }
@@ -57,3 +65,19 @@ func (b *IPSpecApplyConfiguration) WithClaimRef(value *IPClaimRefApplyConfigurat
 	b.ClaimRef = value
 	return b
 }
+
+// WithPoolRef sets the PoolRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolRef field is set to the value of the last call.
+func (b *IPSpecApplyConfiguration) WithPoolRef(value corev1.LocalObjectReference) *IPSpecApplyConfiguration {
+	b.PoolRef = &value
+	return b
+}
+
+// WithPoolSelector sets the PoolSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolSelector field is set to the value of the last call.
+func (b *IPSpecApplyConfiguration) WithPoolSelector(value *v1.LabelSelectorApplyConfiguration) *IPSpecApplyConfiguration {
+	b.PoolSelector = value
+	return b
+}
@@ -6,7 +6,9 @@
 package v1alpha1
 
 import (
+	corev1alpha1 "github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	net "github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // LoadBalancerDestinationApplyConfiguration represents a declarative configuration of the LoadBalancerDestination type for use
@@ -18,6 +20,14 @@ type LoadBalancerDestinationApplyConfiguration struct {
 	IP *net.IP `json:"ip,omitempty"`
 	// TargetRef is the target providing the destination.
 	TargetRef *LoadBalancerTargetRefApplyConfiguration `json:"targetRef,omitempty"`
+	// State is the state of the destination. If unset, the destination is Active.
+	State *corev1alpha1.LoadBalancerDestinationState `json:"state,omitempty"`
+	// DrainingTimestamp is the time the destination started draining.
+	// Has to be set if State is Draining.
+	DrainingTimestamp *v1.Time `json:"drainingTimestamp,omitempty"`
+	// DrainTimeout is the duration a draining destination is kept after DrainingTimestamp.
+	// If unset, a draining destination is removed immediately.
+	DrainTimeout *v1.Duration `json:"drainTimeout,omitempty"`
 }
 
 // LoadBalancerDestinationApplyConfiguration constructs a declarative configuration of the LoadBalancerDestination type for use with
@@ -41,3 +51,27 @@ func (b *LoadBalancerDestinationApplyConfiguration) WithTargetRef(value *LoadBal
 	b.TargetRef = value
 	return b
 }
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *LoadBalancerDestinationApplyConfiguration) WithState(value corev1alpha1.LoadBalancerDestinationState) *LoadBalancerDestinationApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithDrainingTimestamp sets the DrainingTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainingTimestamp field is set to the value of the last call.
+func (b *LoadBalancerDestinationApplyConfiguration) WithDrainingTimestamp(value v1.Time) *LoadBalancerDestinationApplyConfiguration {
+	b.DrainingTimestamp = &value
+	return b
+}
+
+// WithDrainTimeout sets the DrainTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainTimeout field is set to the value of the last call.
+func (b *LoadBalancerDestinationApplyConfiguration) WithDrainTimeout(value v1.Duration) *LoadBalancerDestinationApplyConfiguration {
+	b.DrainTimeout = &value
+	return b
+}
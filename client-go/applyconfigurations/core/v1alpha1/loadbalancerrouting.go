@@ -18,6 +18,8 @@ import (
 // with apply.
 //
 // LoadBalancerRouting is the schema for the loadbalancerroutings API.
+// The destinations of a LoadBalancer may be split across multiple LoadBalancerRouting slices,
+// each labeled with LoadBalancerNameLabel.
 type LoadBalancerRoutingApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
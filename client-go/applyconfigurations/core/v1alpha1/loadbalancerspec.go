@@ -18,6 +18,13 @@ type LoadBalancerSpecApplyConfiguration struct {
 	Type *corev1alpha1.LoadBalancerType `json:"type,omitempty"`
 	// NetworkRef references the network the load balancer is part of.
 	NetworkRef *v1.LocalObjectReference `json:"networkRef,omitempty"`
+	// IPFamilyPolicy specifies whether the load balancer is single- or dual-stack.
+	// If unspecified, defaults to SingleStack for a single IP family and to RequireDualStack otherwise.
+	IPFamilyPolicy *v1.IPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+	// IPFamilies are the IP families of the load balancer. The first family is the primary family.
+	// If unspecified, defaults to the IP families of IPs. An IP is allocated for every family
+	// that is not covered by IPs.
+	IPFamilies []v1.IPFamily `json:"ipFamilies,omitempty"`
 	// IPs specifies the IPs of the load balancer.
 	IPs []LoadBalancerIPApplyConfiguration `json:"ips,omitempty"`
 	// Ports are the ports the load balancer should allow.
@@ -51,6 +58,24 @@ func (b *LoadBalancerSpecApplyConfiguration) WithNetworkRef(value v1.LocalObject
 	return b
 }
 
+// WithIPFamilyPolicy sets the IPFamilyPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPFamilyPolicy field is set to the value of the last call.
+func (b *LoadBalancerSpecApplyConfiguration) WithIPFamilyPolicy(value v1.IPFamilyPolicy) *LoadBalancerSpecApplyConfiguration {
+	b.IPFamilyPolicy = &value
+	return b
+}
+
+// WithIPFamilies adds the given value to the IPFamilies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPFamilies field.
+func (b *LoadBalancerSpecApplyConfiguration) WithIPFamilies(values ...v1.IPFamily) *LoadBalancerSpecApplyConfiguration {
+	for i := range values {
+		b.IPFamilies = append(b.IPFamilies, values[i])
+	}
+	return b
+}
+
 // WithIPs adds the given value to the IPs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the IPs field.
@@ -21,8 +21,8 @@ import (
 type NATGatewayAutoscalerApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *NATGatewayAutoscalerSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *corev1alpha1.NATGatewayAutoscalerStatus    `json:"status,omitempty"`
+	Spec                             *NATGatewayAutoscalerSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *NATGatewayAutoscalerStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // NATGatewayAutoscaler constructs a declarative configuration of the NATGatewayAutoscaler type for use with
@@ -248,8 +248,8 @@ func (b *NATGatewayAutoscalerApplyConfiguration) WithSpec(value *NATGatewayAutos
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *NATGatewayAutoscalerApplyConfiguration) WithStatus(value corev1alpha1.NATGatewayAutoscalerStatus) *NATGatewayAutoscalerApplyConfiguration {
-	b.Status = &value
+func (b *NATGatewayAutoscalerApplyConfiguration) WithStatus(value *NATGatewayAutoscalerStatusApplyConfiguration) *NATGatewayAutoscalerApplyConfiguration {
+	b.Status = value
 	return b
 }
 
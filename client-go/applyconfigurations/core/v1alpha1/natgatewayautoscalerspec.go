@@ -14,10 +14,19 @@ import (
 type NATGatewayAutoscalerSpecApplyConfiguration struct {
 	// NATGatewayRef points to the target NATGateway to scale.
 	NATGatewayRef *v1.LocalObjectReference `json:"natGatewayRef,omitempty"`
-	// MinPublicIPs is the minimum number of public IPs to allocate for a NAT Gateway.
+	// MinPublicIPs is the minimum number of public IPs to allocate per IP family of a NAT Gateway.
 	MinPublicIPs *int32 `json:"minPublicIPs,omitempty"`
-	// MaxPublicIPs is the maximum number of public IPs to allocate for a NAT Gateway.
+	// MaxPublicIPs is the maximum number of public IPs to allocate per IP family of a NAT Gateway.
 	MaxPublicIPs *int32 `json:"maxPublicIPs,omitempty"`
+	// TargetUtilizationPercentage is the targeted percentage of used port ranges of the IPs
+	// of an IP family. Defaults to 100.
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty"`
+	// SpareSlots is the number of port ranges of PortsPerNetworkInterface ports kept available
+	// per IP family in addition to the requested ones.
+	SpareSlots *int32 `json:"spareSlots,omitempty"`
+	// Behavior configures the scaling behavior of the autoscaler. If unset, the number of IPs
+	// is changed without stabilization and rate limits.
+	Behavior *NATGatewayAutoscalerBehaviorApplyConfiguration `json:"behavior,omitempty"`
 }
 
 // NATGatewayAutoscalerSpecApplyConfiguration constructs a declarative configuration of the NATGatewayAutoscalerSpec type for use with
@@ -49,3 +58,27 @@ func (b *NATGatewayAutoscalerSpecApplyConfiguration) WithMaxPublicIPs(value int3
 	b.MaxPublicIPs = &value
 	return b
 }
+
+// WithTargetUtilizationPercentage sets the TargetUtilizationPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetUtilizationPercentage field is set to the value of the last call.
+func (b *NATGatewayAutoscalerSpecApplyConfiguration) WithTargetUtilizationPercentage(value int32) *NATGatewayAutoscalerSpecApplyConfiguration {
+	b.TargetUtilizationPercentage = &value
+	return b
+}
+
+// WithSpareSlots sets the SpareSlots field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpareSlots field is set to the value of the last call.
+func (b *NATGatewayAutoscalerSpecApplyConfiguration) WithSpareSlots(value int32) *NATGatewayAutoscalerSpecApplyConfiguration {
+	b.SpareSlots = &value
+	return b
+}
+
+// WithBehavior sets the Behavior field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Behavior field is set to the value of the last call.
+func (b *NATGatewayAutoscalerSpecApplyConfiguration) WithBehavior(value *NATGatewayAutoscalerBehaviorApplyConfiguration) *NATGatewayAutoscalerSpecApplyConfiguration {
+	b.Behavior = value
+	return b
+}
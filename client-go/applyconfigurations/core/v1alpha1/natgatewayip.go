@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	net "github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	v1 "k8s.io/api/core/v1"
 )
 
 // NATGatewayIPApplyConfiguration represents a declarative configuration of the NATGatewayIP type for use
@@ -14,8 +15,14 @@ import (
 type NATGatewayIPApplyConfiguration struct {
 	// Name is the semantic name of the NAT gateway IP.
 	Name *string `json:"name,omitempty"`
+	// IPFamily is the IP family of the IP. Has to match IP if specified. If unspecified,
+	// defaults to the IP family of IP or to the primary IP family of the NAT gateway.
+	IPFamily *v1.IPFamily `json:"ipFamily,omitempty"`
 	// IP specifies a specific IP to allocate. If empty, a random IP will be allocated if possible.
 	IP *net.IP `json:"ip,omitempty"`
+	// Draining marks the IP for removal. Network interfaces are migrated off a draining IP
+	// and no new port ranges are allocated on it.
+	Draining *bool `json:"draining,omitempty"`
 }
 
 // NATGatewayIPApplyConfiguration constructs a declarative configuration of the NATGatewayIP type for use with
@@ -32,6 +39,14 @@ func (b *NATGatewayIPApplyConfiguration) WithName(value string) *NATGatewayIPApp
 	return b
 }
 
+// WithIPFamily sets the IPFamily field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPFamily field is set to the value of the last call.
+func (b *NATGatewayIPApplyConfiguration) WithIPFamily(value v1.IPFamily) *NATGatewayIPApplyConfiguration {
+	b.IPFamily = &value
+	return b
+}
+
 // WithIP sets the IP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the IP field is set to the value of the last call.
@@ -39,3 +54,11 @@ func (b *NATGatewayIPApplyConfiguration) WithIP(value net.IP) *NATGatewayIPApply
 	b.IP = &value
 	return b
 }
+
+// WithDraining sets the Draining field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Draining field is set to the value of the last call.
+func (b *NATGatewayIPApplyConfiguration) WithDraining(value bool) *NATGatewayIPApplyConfiguration {
+	b.Draining = &value
+	return b
+}
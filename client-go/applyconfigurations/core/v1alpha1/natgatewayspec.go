@@ -6,20 +6,49 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // NATGatewaySpecApplyConfiguration represents a declarative configuration of the NATGatewaySpec type for use
 // with apply.
 type NATGatewaySpecApplyConfiguration struct {
-	// IPFamily is the IP family of the NAT gateway.
-	IPFamily *v1.IPFamily `json:"ipFamily,omitempty"`
+	// IPFamily is the primary IP family of the NAT gateway.
+	// If unspecified, defaults to the first of IPFamilies.
+	IPFamily *corev1.IPFamily `json:"ipFamily,omitempty"`
+	// IPFamilies are the IP families the NAT gateway serves. Every IP family is NATed
+	// using the IPs of that family. If unspecified, defaults to IPFamily.
+	IPFamilies []corev1.IPFamily `json:"ipFamilies,omitempty"`
 	// NetworkRef references the network the NAT gateway is part of.
-	NetworkRef *v1.LocalObjectReference `json:"networkRef,omitempty"`
+	NetworkRef *corev1.LocalObjectReference `json:"networkRef,omitempty"`
+	// NetworkInterfaceSelector selects the network interfaces of the network the NAT gateway NATs.
+	// If unset, all network interfaces of the network are selected.
+	NetworkInterfaceSelector *v1.LabelSelectorApplyConfiguration `json:"networkInterfaceSelector,omitempty"`
+	// Priority resolves conflicts between NAT gateways of the same network selecting the same network
+	// interface. The network interface is NATed by the NAT gateway with the highest priority. On equal
+	// priority, the oldest NAT gateway, then the NAT gateway with the lowest name wins.
+	Priority *int32 `json:"priority,omitempty"`
 	// IPs specifies the IPs of the NAT gateway.
 	IPs []NATGatewayIPApplyConfiguration `json:"ips,omitempty"`
 	// PortsPerNetworkInterface specifies how many ports to allocate per network interface.
 	PortsPerNetworkInterface *int32 `json:"portsPerNetworkInterface,omitempty"`
+	// PortsPerNetworkInterfaceOverrides override PortsPerNetworkInterface for the network interfaces
+	// selected by them. The first matching override applies.
+	PortsPerNetworkInterfaceOverrides []NATGatewayPortsOverrideApplyConfiguration `json:"portsPerNetworkInterfaceOverrides,omitempty"`
+	// PortBlockExpansion configures granting additional port blocks to network interfaces
+	// reporting NAT port pressure. If unset, no additional port blocks are granted.
+	PortBlockExpansion *NATGatewayPortBlockExpansionApplyConfiguration `json:"portBlockExpansion,omitempty"`
+	// IPDrainPeriod is the period a draining IP is retained after all network interfaces
+	// have been migrated off it, letting existing flows finish. Defaults to 2 minutes.
+	IPDrainPeriod *metav1.Duration `json:"ipDrainPeriod,omitempty"`
+	// NAT64 makes the NAT gateway translate IPv6-only network interfaces to its IPv4 IPs.
+	// A NAT64 NAT gateway has to serve IPv4 only.
+	NAT64 *NATGatewayNAT64ApplyConfiguration `json:"nat64,omitempty"`
+	// StickyReservations makes the NAT gateway remember the port range of a network interface and restore it
+	// when the network interface is recreated. A network interface is identified by the value of its
+	// NATReservationKeyLabel or, if unset, by its UID. If unset, port ranges are not remembered.
+	StickyReservations *NATGatewayStickyReservationsApplyConfiguration `json:"stickyReservations,omitempty"`
 }
 
 // NATGatewaySpecApplyConfiguration constructs a declarative configuration of the NATGatewaySpec type for use with
@@ -31,19 +60,45 @@ func NATGatewaySpec() *NATGatewaySpecApplyConfiguration {
 // WithIPFamily sets the IPFamily field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the IPFamily field is set to the value of the last call.
-func (b *NATGatewaySpecApplyConfiguration) WithIPFamily(value v1.IPFamily) *NATGatewaySpecApplyConfiguration {
+func (b *NATGatewaySpecApplyConfiguration) WithIPFamily(value corev1.IPFamily) *NATGatewaySpecApplyConfiguration {
 	b.IPFamily = &value
 	return b
 }
 
+// WithIPFamilies adds the given value to the IPFamilies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPFamilies field.
+func (b *NATGatewaySpecApplyConfiguration) WithIPFamilies(values ...corev1.IPFamily) *NATGatewaySpecApplyConfiguration {
+	for i := range values {
+		b.IPFamilies = append(b.IPFamilies, values[i])
+	}
+	return b
+}
+
 // WithNetworkRef sets the NetworkRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NetworkRef field is set to the value of the last call.
-func (b *NATGatewaySpecApplyConfiguration) WithNetworkRef(value v1.LocalObjectReference) *NATGatewaySpecApplyConfiguration {
+func (b *NATGatewaySpecApplyConfiguration) WithNetworkRef(value corev1.LocalObjectReference) *NATGatewaySpecApplyConfiguration {
 	b.NetworkRef = &value
 	return b
 }
 
+// WithNetworkInterfaceSelector sets the NetworkInterfaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkInterfaceSelector field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithNetworkInterfaceSelector(value *v1.LabelSelectorApplyConfiguration) *NATGatewaySpecApplyConfiguration {
+	b.NetworkInterfaceSelector = value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithPriority(value int32) *NATGatewaySpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
 // WithIPs adds the given value to the IPs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the IPs field.
@@ -64,3 +119,48 @@ func (b *NATGatewaySpecApplyConfiguration) WithPortsPerNetworkInterface(value in
 	b.PortsPerNetworkInterface = &value
 	return b
 }
+
+// WithPortsPerNetworkInterfaceOverrides adds the given value to the PortsPerNetworkInterfaceOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PortsPerNetworkInterfaceOverrides field.
+func (b *NATGatewaySpecApplyConfiguration) WithPortsPerNetworkInterfaceOverrides(values ...*NATGatewayPortsOverrideApplyConfiguration) *NATGatewaySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPortsPerNetworkInterfaceOverrides")
+		}
+		b.PortsPerNetworkInterfaceOverrides = append(b.PortsPerNetworkInterfaceOverrides, *values[i])
+	}
+	return b
+}
+
+// WithPortBlockExpansion sets the PortBlockExpansion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortBlockExpansion field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithPortBlockExpansion(value *NATGatewayPortBlockExpansionApplyConfiguration) *NATGatewaySpecApplyConfiguration {
+	b.PortBlockExpansion = value
+	return b
+}
+
+// WithIPDrainPeriod sets the IPDrainPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPDrainPeriod field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithIPDrainPeriod(value metav1.Duration) *NATGatewaySpecApplyConfiguration {
+	b.IPDrainPeriod = &value
+	return b
+}
+
+// WithNAT64 sets the NAT64 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NAT64 field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithNAT64(value *NATGatewayNAT64ApplyConfiguration) *NATGatewaySpecApplyConfiguration {
+	b.NAT64 = value
+	return b
+}
+
+// WithStickyReservations sets the StickyReservations field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StickyReservations field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithStickyReservations(value *NATGatewayStickyReservationsApplyConfiguration) *NATGatewaySpecApplyConfiguration {
+	b.StickyReservations = value
+	return b
+}
@@ -5,6 +5,10 @@
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // NATGatewayStatusApplyConfiguration represents a declarative configuration of the NATGatewayStatus type for use
 // with apply.
 type NATGatewayStatusApplyConfiguration struct {
@@ -12,6 +16,16 @@ type NATGatewayStatusApplyConfiguration struct {
 	UsedNATIPs *int64 `json:"usedNATIPs,omitempty"`
 	// RequestedNATIPs is the number of requested NAT IPs.
 	RequestedNATIPs *int64 `json:"requestedNATIPs,omitempty"`
+	// IPFamilies are the used and requested NAT IPs per IP family.
+	IPFamilies []NATGatewayIPFamilyStatusApplyConfiguration `json:"ipFamilies,omitempty"`
+	// DrainingIPs report the draining state of the draining IPs.
+	DrainingIPs []NATGatewayDrainingIPStatusApplyConfiguration `json:"drainingIPs,omitempty"`
+	// IPs report the port range usage per NAT gateway IP.
+	IPs []NATGatewayIPStatusApplyConfiguration `json:"ips,omitempty"`
+	// Reservations are the port ranges reserved for network interfaces if StickyReservations is set.
+	Reservations []NATGatewayReservationApplyConfiguration `json:"reservations,omitempty"`
+	// Conditions are the conditions of the NAT gateway.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // NATGatewayStatusApplyConfiguration constructs a declarative configuration of the NATGatewayStatus type for use with
@@ -35,3 +49,68 @@ func (b *NATGatewayStatusApplyConfiguration) WithRequestedNATIPs(value int64) *N
 	b.RequestedNATIPs = &value
 	return b
 }
+
+// WithIPFamilies adds the given value to the IPFamilies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPFamilies field.
+func (b *NATGatewayStatusApplyConfiguration) WithIPFamilies(values ...*NATGatewayIPFamilyStatusApplyConfiguration) *NATGatewayStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIPFamilies")
+		}
+		b.IPFamilies = append(b.IPFamilies, *values[i])
+	}
+	return b
+}
+
+// WithDrainingIPs adds the given value to the DrainingIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DrainingIPs field.
+func (b *NATGatewayStatusApplyConfiguration) WithDrainingIPs(values ...*NATGatewayDrainingIPStatusApplyConfiguration) *NATGatewayStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDrainingIPs")
+		}
+		b.DrainingIPs = append(b.DrainingIPs, *values[i])
+	}
+	return b
+}
+
+// WithIPs adds the given value to the IPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPs field.
+func (b *NATGatewayStatusApplyConfiguration) WithIPs(values ...*NATGatewayIPStatusApplyConfiguration) *NATGatewayStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIPs")
+		}
+		b.IPs = append(b.IPs, *values[i])
+	}
+	return b
+}
+
+// WithReservations adds the given value to the Reservations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Reservations field.
+func (b *NATGatewayStatusApplyConfiguration) WithReservations(values ...*NATGatewayReservationApplyConfiguration) *NATGatewayStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReservations")
+		}
+		b.Reservations = append(b.Reservations, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *NATGatewayStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *NATGatewayStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
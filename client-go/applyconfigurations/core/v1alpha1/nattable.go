@@ -18,6 +18,8 @@ import (
 // with apply.
 //
 // NATTable is the schema for the nattables API.
+// The sections of a NATGateway are split into one NATTable slice per node, each labeled with
+// NATGatewayNameLabel and the TopologyPartitionLabel of its node.
 type NATTableApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
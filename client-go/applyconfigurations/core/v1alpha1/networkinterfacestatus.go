@@ -27,6 +27,8 @@ type NetworkInterfaceStatusApplyConfiguration struct {
 	PublicIPs []net.IP `json:"publicIPs,omitempty"`
 	// NATIPs are the NAT IPs of the network interface.
 	NATIPs []net.IP `json:"natIPs,omitempty"`
+	// NATs are the NAT statuses of the network interface per IP family.
+	NATs []NetworkInterfaceNATStatusApplyConfiguration `json:"nats,omitempty"`
 }
 
 // NetworkInterfaceStatusApplyConfiguration constructs a declarative configuration of the NetworkInterfaceStatus type for use with
@@ -88,3 +90,16 @@ func (b *NetworkInterfaceStatusApplyConfiguration) WithNATIPs(values ...net.IP)
 	}
 	return b
 }
+
+// WithNATs adds the given value to the NATs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NATs field.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithNATs(values ...*NetworkInterfaceNATStatusApplyConfiguration) *NetworkInterfaceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNATs")
+		}
+		b.NATs = append(b.NATs, *values[i])
+	}
+	return b
+}
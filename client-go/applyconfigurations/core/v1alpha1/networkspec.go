@@ -5,11 +5,18 @@
 
 package v1alpha1
 
+import (
+	net "github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+)
+
 // NetworkSpecApplyConfiguration represents a declarative configuration of the NetworkSpec type for use
 // with apply.
 type NetworkSpecApplyConfiguration struct {
 	// ID is the ID of the network.
 	ID *string `json:"id,omitempty"`
+	// Prefixes are the internal address space of the network.
+	// IPs of internal load balancers that don't specify an IP are allocated from them.
+	Prefixes []net.IPPrefix `json:"prefixes,omitempty"`
 	// Peerings are the network peerings with this network
 	Peerings []NetworkPeeringApplyConfiguration `json:"peerings,omitempty"`
 }
@@ -28,6 +35,16 @@ func (b *NetworkSpecApplyConfiguration) WithID(value string) *NetworkSpecApplyCo
 	return b
 }
 
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *NetworkSpecApplyConfiguration) WithPrefixes(values ...net.IPPrefix) *NetworkSpecApplyConfiguration {
+	for i := range values {
+		b.Prefixes = append(b.Prefixes, values[i])
+	}
+	return b
+}
+
 // WithPeerings adds the given value to the Peerings field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Peerings field.
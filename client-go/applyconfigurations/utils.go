@@ -45,8 +45,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.IPAddressApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressClaimRef"):
 		return &corev1alpha1.IPAddressClaimRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressHistory"):
+		return &corev1alpha1.IPAddressHistoryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressHistorySpec"):
+		return &corev1alpha1.IPAddressHistorySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressLease"):
+		return &corev1alpha1.IPAddressLeaseApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressSpec"):
 		return &corev1alpha1.IPAddressSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPool"):
+		return &corev1alpha1.IPPoolApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolPrefixStatus"):
+		return &corev1alpha1.IPPoolPrefixStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolSpec"):
+		return &corev1alpha1.IPPoolSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolStatus"):
+		return &corev1alpha1.IPPoolStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IPBlock"):
 		return &corev1alpha1.IPBlockApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IPClaimRef"):
@@ -75,14 +89,40 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.NATGatewayApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscaler"):
 		return &corev1alpha1.NATGatewayAutoscalerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscalerBehavior"):
+		return &corev1alpha1.NATGatewayAutoscalerBehaviorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscalerIPFamilyStatus"):
+		return &corev1alpha1.NATGatewayAutoscalerIPFamilyStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscalerRecommendation"):
+		return &corev1alpha1.NATGatewayAutoscalerRecommendationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscalerScalingRules"):
+		return &corev1alpha1.NATGatewayAutoscalerScalingRulesApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscalerSpec"):
 		return &corev1alpha1.NATGatewayAutoscalerSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayAutoscalerStatus"):
+		return &corev1alpha1.NATGatewayAutoscalerStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayDrainingIPStatus"):
+		return &corev1alpha1.NATGatewayDrainingIPStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayIP"):
 		return &corev1alpha1.NATGatewayIPApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayIPFamilyStatus"):
+		return &corev1alpha1.NATGatewayIPFamilyStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayIPStatus"):
+		return &corev1alpha1.NATGatewayIPStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayNAT64"):
+		return &corev1alpha1.NATGatewayNAT64ApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayPortBlockExpansion"):
+		return &corev1alpha1.NATGatewayPortBlockExpansionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayPortsOverride"):
+		return &corev1alpha1.NATGatewayPortsOverrideApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayReservation"):
+		return &corev1alpha1.NATGatewayReservationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewaySpec"):
 		return &corev1alpha1.NATGatewaySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayStatus"):
 		return &corev1alpha1.NATGatewayStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NATGatewayStickyReservations"):
+		return &corev1alpha1.NATGatewayStickyReservationsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATIP"):
 		return &corev1alpha1.NATIPApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NATIPSection"):
@@ -99,12 +139,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.NetworkIDClaimRefApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkIDSpec"):
 		return &corev1alpha1.NetworkIDSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkIPAddress"):
+		return &corev1alpha1.NetworkIPAddressApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkIPAddressSpec"):
+		return &corev1alpha1.NetworkIPAddressSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterface"):
 		return &corev1alpha1.NetworkInterfaceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceNAT"):
 		return &corev1alpha1.NetworkInterfaceNATApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceNATClaimRef"):
 		return &corev1alpha1.NetworkInterfaceNATClaimRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceNATStatus"):
+		return &corev1alpha1.NetworkInterfaceNATStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfacePublicIP"):
 		return &corev1alpha1.NetworkInterfacePublicIPApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceSpec"):
@@ -129,6 +175,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.NetworkPolicyRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkPolicySpec"):
 		return &corev1alpha1.NetworkPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkQuota"):
+		return &corev1alpha1.NetworkQuotaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkQuotaSpec"):
+		return &corev1alpha1.NetworkQuotaSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkQuotaStatus"):
+		return &corev1alpha1.NetworkQuotaStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkSpec"):
 		return &corev1alpha1.NetworkSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkStatus"):
@@ -151,6 +203,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.PCIAddressApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PeeringPrefix"):
 		return &corev1alpha1.PeeringPrefixApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PortForwarding"):
+		return &corev1alpha1.PortForwardingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PortForwardingSpec"):
+		return &corev1alpha1.PortForwardingSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Rule"):
 		return &corev1alpha1.RuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TAPDevice"):
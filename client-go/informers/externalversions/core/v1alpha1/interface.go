@@ -17,6 +17,10 @@ type Interface interface {
 	IPs() IPInformer
 	// IPAddresses returns a IPAddressInformer.
 	IPAddresses() IPAddressInformer
+	// IPAddressHistories returns a IPAddressHistoryInformer.
+	IPAddressHistories() IPAddressHistoryInformer
+	// IPPools returns a IPPoolInformer.
+	IPPools() IPPoolInformer
 	// Instances returns a InstanceInformer.
 	Instances() InstanceInformer
 	// LoadBalancers returns a LoadBalancerInformer.
@@ -33,14 +37,20 @@ type Interface interface {
 	Networks() NetworkInformer
 	// NetworkIDs returns a NetworkIDInformer.
 	NetworkIDs() NetworkIDInformer
+	// NetworkIPAddresses returns a NetworkIPAddressInformer.
+	NetworkIPAddresses() NetworkIPAddressInformer
 	// NetworkInterfaces returns a NetworkInterfaceInformer.
 	NetworkInterfaces() NetworkInterfaceInformer
 	// NetworkPolicies returns a NetworkPolicyInformer.
 	NetworkPolicies() NetworkPolicyInformer
 	// NetworkPolicyRules returns a NetworkPolicyRuleInformer.
 	NetworkPolicyRules() NetworkPolicyRuleInformer
+	// NetworkQuotas returns a NetworkQuotaInformer.
+	NetworkQuotas() NetworkQuotaInformer
 	// Nodes returns a NodeInformer.
 	Nodes() NodeInformer
+	// PortForwardings returns a PortForwardingInformer.
+	PortForwardings() PortForwardingInformer
 }
 
 type version struct {
@@ -69,6 +79,16 @@ func (v *version) IPAddresses() IPAddressInformer {
 	return &iPAddressInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// IPAddressHistories returns a IPAddressHistoryInformer.
+func (v *version) IPAddressHistories() IPAddressHistoryInformer {
+	return &iPAddressHistoryInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// IPPools returns a IPPoolInformer.
+func (v *version) IPPools() IPPoolInformer {
+	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Instances returns a InstanceInformer.
 func (v *version) Instances() InstanceInformer {
 	return &instanceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -109,6 +129,11 @@ func (v *version) NetworkIDs() NetworkIDInformer {
 	return &networkIDInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// NetworkIPAddresses returns a NetworkIPAddressInformer.
+func (v *version) NetworkIPAddresses() NetworkIPAddressInformer {
+	return &networkIPAddressInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // NetworkInterfaces returns a NetworkInterfaceInformer.
 func (v *version) NetworkInterfaces() NetworkInterfaceInformer {
 	return &networkInterfaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -124,7 +149,17 @@ func (v *version) NetworkPolicyRules() NetworkPolicyRuleInformer {
 	return &networkPolicyRuleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NetworkQuotas returns a NetworkQuotaInformer.
+func (v *version) NetworkQuotas() NetworkQuotaInformer {
+	return &networkQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Nodes returns a NodeInformer.
 func (v *version) Nodes() NodeInformer {
 	return &nodeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// PortForwardings returns a PortForwardingInformer.
+func (v *version) PortForwardings() PortForwardingInformer {
+	return &portForwardingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -46,6 +46,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().IPs().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("ipaddresses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().IPAddresses().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("ipaddresshistories"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().IPAddressHistories().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("ippools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().IPPools().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("instances"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().Instances().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("loadbalancers"):
@@ -62,14 +66,20 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().Networks().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networkids"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().NetworkIDs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("networkipaddresses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().NetworkIPAddresses().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networkinterfaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().NetworkInterfaces().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networkpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().NetworkPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networkpolicyrules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().NetworkPolicyRules().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("networkquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().NetworkQuotas().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("nodes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().Nodes().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("portforwardings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().PortForwardings().Informer()}, nil
 
 	}
 
@@ -18,6 +18,8 @@ type CoreV1alpha1Interface interface {
 	DaemonSetsGetter
 	IPsGetter
 	IPAddressesGetter
+	IPAddressHistoriesGetter
+	IPPoolsGetter
 	InstancesGetter
 	LoadBalancersGetter
 	LoadBalancerRoutingsGetter
@@ -26,10 +28,13 @@ type CoreV1alpha1Interface interface {
 	NATTablesGetter
 	NetworksGetter
 	NetworkIDsGetter
+	NetworkIPAddressesGetter
 	NetworkInterfacesGetter
 	NetworkPoliciesGetter
 	NetworkPolicyRulesGetter
+	NetworkQuotasGetter
 	NodesGetter
+	PortForwardingsGetter
 }
 
 // CoreV1alpha1Client is used to interact with features provided by the core.apinet.ironcore.dev group.
@@ -49,6 +54,14 @@ func (c *CoreV1alpha1Client) IPAddresses() IPAddressInterface {
 	return newIPAddresses(c)
 }
 
+func (c *CoreV1alpha1Client) IPAddressHistories() IPAddressHistoryInterface {
+	return newIPAddressHistories(c)
+}
+
+func (c *CoreV1alpha1Client) IPPools() IPPoolInterface {
+	return newIPPools(c)
+}
+
 func (c *CoreV1alpha1Client) Instances(namespace string) InstanceInterface {
 	return newInstances(c, namespace)
 }
@@ -81,6 +94,10 @@ func (c *CoreV1alpha1Client) NetworkIDs() NetworkIDInterface {
 	return newNetworkIDs(c)
 }
 
+func (c *CoreV1alpha1Client) NetworkIPAddresses(namespace string) NetworkIPAddressInterface {
+	return newNetworkIPAddresses(c, namespace)
+}
+
 func (c *CoreV1alpha1Client) NetworkInterfaces(namespace string) NetworkInterfaceInterface {
 	return newNetworkInterfaces(c, namespace)
 }
@@ -93,10 +110,18 @@ func (c *CoreV1alpha1Client) NetworkPolicyRules(namespace string) NetworkPolicyR
 	return newNetworkPolicyRules(c, namespace)
 }
 
+func (c *CoreV1alpha1Client) NetworkQuotas(namespace string) NetworkQuotaInterface {
+	return newNetworkQuotas(c, namespace)
+}
+
 func (c *CoreV1alpha1Client) Nodes() NodeInterface {
 	return newNodes(c)
 }
 
+func (c *CoreV1alpha1Client) PortForwardings(namespace string) PortForwardingInterface {
+	return newPortForwardings(c, namespace)
+}
+
 // NewForConfig creates a new CoreV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
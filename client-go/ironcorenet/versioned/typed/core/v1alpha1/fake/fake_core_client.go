@@ -27,6 +27,14 @@ func (c *FakeCoreV1alpha1) IPAddresses() v1alpha1.IPAddressInterface {
 	return newFakeIPAddresses(c)
 }
 
+func (c *FakeCoreV1alpha1) IPAddressHistories() v1alpha1.IPAddressHistoryInterface {
+	return newFakeIPAddressHistories(c)
+}
+
+func (c *FakeCoreV1alpha1) IPPools() v1alpha1.IPPoolInterface {
+	return newFakeIPPools(c)
+}
+
 func (c *FakeCoreV1alpha1) Instances(namespace string) v1alpha1.InstanceInterface {
 	return newFakeInstances(c, namespace)
 }
@@ -59,6 +67,10 @@ func (c *FakeCoreV1alpha1) NetworkIDs() v1alpha1.NetworkIDInterface {
 	return newFakeNetworkIDs(c)
 }
 
+func (c *FakeCoreV1alpha1) NetworkIPAddresses(namespace string) v1alpha1.NetworkIPAddressInterface {
+	return newFakeNetworkIPAddresses(c, namespace)
+}
+
 func (c *FakeCoreV1alpha1) NetworkInterfaces(namespace string) v1alpha1.NetworkInterfaceInterface {
 	return newFakeNetworkInterfaces(c, namespace)
 }
@@ -71,10 +83,18 @@ func (c *FakeCoreV1alpha1) NetworkPolicyRules(namespace string) v1alpha1.Network
 	return newFakeNetworkPolicyRules(c, namespace)
 }
 
+func (c *FakeCoreV1alpha1) NetworkQuotas(namespace string) v1alpha1.NetworkQuotaInterface {
+	return newFakeNetworkQuotas(c, namespace)
+}
+
 func (c *FakeCoreV1alpha1) Nodes() v1alpha1.NodeInterface {
 	return newFakeNodes(c)
 }
 
+func (c *FakeCoreV1alpha1) PortForwardings(namespace string) v1alpha1.PortForwardingInterface {
+	return newFakePortForwardings(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCoreV1alpha1) RESTClient() rest.Interface {
@@ -11,6 +11,10 @@ type IPExpansion interface{}
 
 type IPAddressExpansion interface{}
 
+type IPAddressHistoryExpansion interface{}
+
+type IPPoolExpansion interface{}
+
 type InstanceExpansion interface{}
 
 type LoadBalancerExpansion interface{}
@@ -27,10 +31,16 @@ type NetworkExpansion interface{}
 
 type NetworkIDExpansion interface{}
 
+type NetworkIPAddressExpansion interface{}
+
 type NetworkInterfaceExpansion interface{}
 
 type NetworkPolicyExpansion interface{}
 
 type NetworkPolicyRuleExpansion interface{}
 
+type NetworkQuotaExpansion interface{}
+
 type NodeExpansion interface{}
+
+type PortForwardingExpansion interface{}
@@ -25,6 +25,14 @@ type IPNamespaceListerExpansion interface{}
 // IPAddressLister.
 type IPAddressListerExpansion interface{}
 
+// IPAddressHistoryListerExpansion allows custom methods to be added to
+// IPAddressHistoryLister.
+type IPAddressHistoryListerExpansion interface{}
+
+// IPPoolListerExpansion allows custom methods to be added to
+// IPPoolLister.
+type IPPoolListerExpansion interface{}
+
 // InstanceListerExpansion allows custom methods to be added to
 // InstanceLister.
 type InstanceListerExpansion interface{}
@@ -85,6 +93,14 @@ type NetworkNamespaceListerExpansion interface{}
 // NetworkIDLister.
 type NetworkIDListerExpansion interface{}
 
+// NetworkIPAddressListerExpansion allows custom methods to be added to
+// NetworkIPAddressLister.
+type NetworkIPAddressListerExpansion interface{}
+
+// NetworkIPAddressNamespaceListerExpansion allows custom methods to be added to
+// NetworkIPAddressNamespaceLister.
+type NetworkIPAddressNamespaceListerExpansion interface{}
+
 // NetworkInterfaceListerExpansion allows custom methods to be added to
 // NetworkInterfaceLister.
 type NetworkInterfaceListerExpansion interface{}
@@ -109,6 +125,22 @@ type NetworkPolicyRuleListerExpansion interface{}
 // NetworkPolicyRuleNamespaceLister.
 type NetworkPolicyRuleNamespaceListerExpansion interface{}
 
+// NetworkQuotaListerExpansion allows custom methods to be added to
+// NetworkQuotaLister.
+type NetworkQuotaListerExpansion interface{}
+
+// NetworkQuotaNamespaceListerExpansion allows custom methods to be added to
+// NetworkQuotaNamespaceLister.
+type NetworkQuotaNamespaceListerExpansion interface{}
+
 // NodeListerExpansion allows custom methods to be added to
 // NodeLister.
 type NodeListerExpansion interface{}
+
+// PortForwardingListerExpansion allows custom methods to be added to
+// PortForwardingLister.
+type PortForwardingListerExpansion interface{}
+
+// PortForwardingNamespaceListerExpansion allows custom methods to be added to
+// PortForwardingNamespaceLister.
+type PortForwardingNamespaceListerExpansion interface{}
@@ -22,388 +22,420 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		v1alpha1.Affinity{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_Affinity(ref),
-		v1alpha1.DaemonSet{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_DaemonSet(ref),
-		v1alpha1.DaemonSetList{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_DaemonSetList(ref),
-		v1alpha1.DaemonSetSpec{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_DaemonSetSpec(ref),
-		v1alpha1.DaemonSetStatus{}.OpenAPIModelName():             schema_ironcore_net_api_core_v1alpha1_DaemonSetStatus(ref),
-		v1alpha1.IP{}.OpenAPIModelName():                          schema_ironcore_net_api_core_v1alpha1_IP(ref),
-		v1alpha1.IPAddress{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_IPAddress(ref),
-		v1alpha1.IPAddressClaimRef{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_IPAddressClaimRef(ref),
-		v1alpha1.IPAddressList{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_IPAddressList(ref),
-		v1alpha1.IPAddressSpec{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_IPAddressSpec(ref),
-		v1alpha1.IPBlock{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_IPBlock(ref),
-		v1alpha1.IPClaimRef{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_IPClaimRef(ref),
-		v1alpha1.IPList{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_IPList(ref),
-		v1alpha1.IPSpec{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_IPSpec(ref),
-		v1alpha1.IPStatus{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_IPStatus(ref),
-		v1alpha1.Instance{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_Instance(ref),
-		v1alpha1.InstanceAffinityTerm{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_InstanceAffinityTerm(ref),
-		v1alpha1.InstanceAntiAffinity{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_InstanceAntiAffinity(ref),
-		v1alpha1.InstanceList{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_InstanceList(ref),
-		v1alpha1.InstanceSpec{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_InstanceSpec(ref),
-		v1alpha1.InstanceStatus{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_InstanceStatus(ref),
-		v1alpha1.InstanceTemplate{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_InstanceTemplate(ref),
-		v1alpha1.LoadBalancer{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_LoadBalancer(ref),
-		v1alpha1.LoadBalancerDestination{}.OpenAPIModelName():     schema_ironcore_net_api_core_v1alpha1_LoadBalancerDestination(ref),
-		v1alpha1.LoadBalancerIP{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_LoadBalancerIP(ref),
-		v1alpha1.LoadBalancerList{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_LoadBalancerList(ref),
-		v1alpha1.LoadBalancerPort{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_LoadBalancerPort(ref),
-		v1alpha1.LoadBalancerRouting{}.OpenAPIModelName():         schema_ironcore_net_api_core_v1alpha1_LoadBalancerRouting(ref),
-		v1alpha1.LoadBalancerRoutingList{}.OpenAPIModelName():     schema_ironcore_net_api_core_v1alpha1_LoadBalancerRoutingList(ref),
-		v1alpha1.LoadBalancerSpec{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_LoadBalancerSpec(ref),
-		v1alpha1.LoadBalancerStatus{}.OpenAPIModelName():          schema_ironcore_net_api_core_v1alpha1_LoadBalancerStatus(ref),
-		v1alpha1.LoadBalancerTargetRef{}.OpenAPIModelName():       schema_ironcore_net_api_core_v1alpha1_LoadBalancerTargetRef(ref),
-		v1alpha1.LocalUIDReference{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_LocalUIDReference(ref),
-		v1alpha1.NATGateway{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NATGateway(ref),
-		v1alpha1.NATGatewayAutoscaler{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscaler(ref),
-		v1alpha1.NATGatewayAutoscalerList{}.OpenAPIModelName():    schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerList(ref),
-		v1alpha1.NATGatewayAutoscalerSpec{}.OpenAPIModelName():    schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerSpec(ref),
-		v1alpha1.NATGatewayAutoscalerStatus{}.OpenAPIModelName():  schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerStatus(ref),
-		v1alpha1.NATGatewayIP{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NATGatewayIP(ref),
-		v1alpha1.NATGatewayList{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_NATGatewayList(ref),
-		v1alpha1.NATGatewaySpec{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_NATGatewaySpec(ref),
-		v1alpha1.NATGatewayStatus{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_NATGatewayStatus(ref),
-		v1alpha1.NATIP{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NATIP(ref),
-		v1alpha1.NATIPSection{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NATIPSection(ref),
-		v1alpha1.NATTable{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_NATTable(ref),
-		v1alpha1.NATTableIPTargetRef{}.OpenAPIModelName():         schema_ironcore_net_api_core_v1alpha1_NATTableIPTargetRef(ref),
-		v1alpha1.NATTableList{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NATTableList(ref),
-		v1alpha1.Network{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_Network(ref),
-		v1alpha1.NetworkID{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NetworkID(ref),
-		v1alpha1.NetworkIDClaimRef{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkIDClaimRef(ref),
-		v1alpha1.NetworkIDList{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkIDList(ref),
-		v1alpha1.NetworkIDSpec{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkIDSpec(ref),
-		v1alpha1.NetworkInterface{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_NetworkInterface(ref),
-		v1alpha1.NetworkInterfaceList{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceList(ref),
-		v1alpha1.NetworkInterfaceNAT{}.OpenAPIModelName():         schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNAT(ref),
-		v1alpha1.NetworkInterfaceNATClaimRef{}.OpenAPIModelName(): schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNATClaimRef(ref),
-		v1alpha1.NetworkInterfacePublicIP{}.OpenAPIModelName():    schema_ironcore_net_api_core_v1alpha1_NetworkInterfacePublicIP(ref),
-		v1alpha1.NetworkInterfaceSpec{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceSpec(ref),
-		v1alpha1.NetworkInterfaceStatus{}.OpenAPIModelName():      schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceStatus(ref),
-		v1alpha1.NetworkList{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_NetworkList(ref),
-		v1alpha1.NetworkPeering{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_NetworkPeering(ref),
-		v1alpha1.NetworkPeeringStatus{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_NetworkPeeringStatus(ref),
-		v1alpha1.NetworkPolicy{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkPolicy(ref),
-		v1alpha1.NetworkPolicyEgressRule{}.OpenAPIModelName():     schema_ironcore_net_api_core_v1alpha1_NetworkPolicyEgressRule(ref),
-		v1alpha1.NetworkPolicyIngressRule{}.OpenAPIModelName():    schema_ironcore_net_api_core_v1alpha1_NetworkPolicyIngressRule(ref),
-		v1alpha1.NetworkPolicyList{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkPolicyList(ref),
-		v1alpha1.NetworkPolicyPeer{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkPolicyPeer(ref),
-		v1alpha1.NetworkPolicyPort{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkPolicyPort(ref),
-		v1alpha1.NetworkPolicyRule{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRule(ref),
-		v1alpha1.NetworkPolicyRuleList{}.OpenAPIModelName():       schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRuleList(ref),
-		v1alpha1.NetworkPolicySpec{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkPolicySpec(ref),
-		v1alpha1.NetworkSpec{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_NetworkSpec(ref),
-		v1alpha1.NetworkStatus{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkStatus(ref),
-		v1alpha1.Node{}.OpenAPIModelName():                        schema_ironcore_net_api_core_v1alpha1_Node(ref),
-		v1alpha1.NodeAffinity{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NodeAffinity(ref),
-		v1alpha1.NodeList{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_NodeList(ref),
-		v1alpha1.NodeSelector{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NodeSelector(ref),
-		v1alpha1.NodeSelectorRequirement{}.OpenAPIModelName():     schema_ironcore_net_api_core_v1alpha1_NodeSelectorRequirement(ref),
-		v1alpha1.NodeSelectorTerm{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_NodeSelectorTerm(ref),
-		v1alpha1.NodeSpec{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_NodeSpec(ref),
-		v1alpha1.NodeStatus{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NodeStatus(ref),
-		v1alpha1.ObjectIP{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_ObjectIP(ref),
-		v1alpha1.ObjectSelector{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_ObjectSelector(ref),
-		v1alpha1.PCIAddress{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_PCIAddress(ref),
-		v1alpha1.PeeringPrefix{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_PeeringPrefix(ref),
-		v1alpha1.Rule{}.OpenAPIModelName():                        schema_ironcore_net_api_core_v1alpha1_Rule(ref),
-		v1alpha1.TAPDevice{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_TAPDevice(ref),
-		v1alpha1.TargetNetworkInterface{}.OpenAPIModelName():      schema_ironcore_net_api_core_v1alpha1_TargetNetworkInterface(ref),
-		v1alpha1.TopologySpreadConstraint{}.OpenAPIModelName():    schema_ironcore_net_api_core_v1alpha1_TopologySpreadConstraint(ref),
-		net.IP{}.OpenAPIModelName():                               schema_ironcore_net_apimachinery_api_net_IP(ref),
-		net.IPPrefix{}.OpenAPIModelName():                         schema_ironcore_net_apimachinery_api_net_IPPrefix(ref),
-		v1.AWSElasticBlockStoreVolumeSource{}.OpenAPIModelName():  schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		v1.Affinity{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_Affinity(ref),
-		v1.AppArmorProfile{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_AppArmorProfile(ref),
-		v1.AttachedVolume{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_AttachedVolume(ref),
-		v1.AvoidPods{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_AvoidPods(ref),
-		v1.AzureDiskVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		v1.AzureFilePersistentVolumeSource{}.OpenAPIModelName():   schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		v1.AzureFileVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		v1.Binding{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_Binding(ref),
-		v1.CSIPersistentVolumeSource{}.OpenAPIModelName():         schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		v1.CSIVolumeSource{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		v1.Capabilities{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_Capabilities(ref),
-		v1.CephFSPersistentVolumeSource{}.OpenAPIModelName():      schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		v1.CephFSVolumeSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		v1.CinderPersistentVolumeSource{}.OpenAPIModelName():      schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		v1.CinderVolumeSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		v1.ClientIPConfig{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		v1.ClusterTrustBundleProjection{}.OpenAPIModelName():      schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
-		v1.ComponentCondition{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ComponentCondition(ref),
-		v1.ComponentStatus{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_ComponentStatus(ref),
-		v1.ComponentStatusList{}.OpenAPIModelName():               schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		v1.ConfigMap{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_ConfigMap(ref),
-		v1.ConfigMapEnvSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		v1.ConfigMapKeySelector{}.OpenAPIModelName():              schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		v1.ConfigMapList{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ConfigMapList(ref),
-		v1.ConfigMapNodeConfigSource{}.OpenAPIModelName():         schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		v1.ConfigMapProjection{}.OpenAPIModelName():               schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		v1.ConfigMapVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		v1.Container{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_Container(ref),
-		v1.ContainerExtendedResourceRequest{}.OpenAPIModelName():  schema_k8sio_api_core_v1_ContainerExtendedResourceRequest(ref),
-		v1.ContainerImage{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ContainerImage(ref),
-		v1.ContainerPort{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ContainerPort(ref),
-		v1.ContainerResizePolicy{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
-		v1.ContainerRestartRule{}.OpenAPIModelName():              schema_k8sio_api_core_v1_ContainerRestartRule(ref),
-		v1.ContainerRestartRuleOnExitCodes{}.OpenAPIModelName():   schema_k8sio_api_core_v1_ContainerRestartRuleOnExitCodes(ref),
-		v1.ContainerState{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ContainerState(ref),
-		v1.ContainerStateRunning{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		v1.ContainerStateTerminated{}.OpenAPIModelName():          schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		v1.ContainerStateWaiting{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		v1.ContainerStatus{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_ContainerStatus(ref),
-		v1.ContainerUser{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ContainerUser(ref),
-		v1.DaemonEndpoint{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		v1.DownwardAPIProjection{}.OpenAPIModelName():             schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		v1.DownwardAPIVolumeFile{}.OpenAPIModelName():             schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		v1.DownwardAPIVolumeSource{}.OpenAPIModelName():           schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		v1.EmptyDirVolumeSource{}.OpenAPIModelName():              schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		v1.EndpointAddress{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_EndpointAddress(ref),
-		v1.EndpointPort{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_EndpointPort(ref),
-		v1.EndpointSubset{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_EndpointSubset(ref),
-		v1.Endpoints{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_Endpoints(ref),
-		v1.EndpointsList{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_EndpointsList(ref),
-		v1.EnvFromSource{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_EnvFromSource(ref),
-		v1.EnvVar{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_EnvVar(ref),
-		v1.EnvVarSource{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_EnvVarSource(ref),
-		v1.EphemeralContainer{}.OpenAPIModelName():                schema_k8sio_api_core_v1_EphemeralContainer(ref),
-		v1.EphemeralContainerCommon{}.OpenAPIModelName():          schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
-		v1.EphemeralVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
-		v1.Event{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_Event(ref),
-		v1.EventList{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_EventList(ref),
-		v1.EventSeries{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_EventSeries(ref),
-		v1.EventSource{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_EventSource(ref),
-		v1.ExecAction{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_ExecAction(ref),
-		v1.FCVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		v1.FileKeySelector{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_FileKeySelector(ref),
-		v1.FlexPersistentVolumeSource{}.OpenAPIModelName():        schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		v1.FlexVolumeSource{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		v1.FlockerVolumeSource{}.OpenAPIModelName():               schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		v1.GCEPersistentDiskVolumeSource{}.OpenAPIModelName():     schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		v1.GRPCAction{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_GRPCAction(ref),
-		v1.GitRepoVolumeSource{}.OpenAPIModelName():               schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		v1.GlusterfsPersistentVolumeSource{}.OpenAPIModelName():   schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		v1.GlusterfsVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		v1.HTTPGetAction{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		v1.HTTPHeader{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_HTTPHeader(ref),
-		v1.HostAlias{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_HostAlias(ref),
-		v1.HostIP{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_HostIP(ref),
-		v1.HostPathVolumeSource{}.OpenAPIModelName():              schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		v1.ISCSIPersistentVolumeSource{}.OpenAPIModelName():       schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		v1.ISCSIVolumeSource{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		v1.ImageVolumeSource{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_ImageVolumeSource(ref),
-		v1.KeyToPath{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_KeyToPath(ref),
-		v1.Lifecycle{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_Lifecycle(ref),
-		v1.LifecycleHandler{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_LifecycleHandler(ref),
-		v1.LimitRange{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_LimitRange(ref),
-		v1.LimitRangeItem{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		v1.LimitRangeList{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_LimitRangeList(ref),
-		v1.LimitRangeSpec{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		v1.LinuxContainerUser{}.OpenAPIModelName():                schema_k8sio_api_core_v1_LinuxContainerUser(ref),
-		v1.List{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_List(ref),
-		v1.LoadBalancerIngress{}.OpenAPIModelName():               schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		v1.LoadBalancerStatus{}.OpenAPIModelName():                schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		v1.LocalObjectReference{}.OpenAPIModelName():              schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		v1.LocalVolumeSource{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		v1.ModifyVolumeStatus{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
-		v1.NFSVolumeSource{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		v1.Namespace{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_Namespace(ref),
-		v1.NamespaceCondition{}.OpenAPIModelName():                schema_k8sio_api_core_v1_NamespaceCondition(ref),
-		v1.NamespaceList{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_NamespaceList(ref),
-		v1.NamespaceSpec{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		v1.NamespaceStatus{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		v1.Node{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_Node(ref),
-		v1.NodeAddress{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_NodeAddress(ref),
-		v1.NodeAffinity{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_NodeAffinity(ref),
-		v1.NodeCondition{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_NodeCondition(ref),
-		v1.NodeConfigSource{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		v1.NodeConfigStatus{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		v1.NodeDaemonEndpoints{}.OpenAPIModelName():               schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		v1.NodeFeatures{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_NodeFeatures(ref),
-		v1.NodeList{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_NodeList(ref),
-		v1.NodeProxyOptions{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		v1.NodeRuntimeHandler{}.OpenAPIModelName():                schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
-		v1.NodeRuntimeHandlerFeatures{}.OpenAPIModelName():        schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
-		v1.NodeSelector{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_NodeSelector(ref),
-		v1.NodeSelectorRequirement{}.OpenAPIModelName():           schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		v1.NodeSelectorTerm{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		v1.NodeSpec{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_NodeSpec(ref),
-		v1.NodeStatus{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_NodeStatus(ref),
-		v1.NodeSwapStatus{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_NodeSwapStatus(ref),
-		v1.NodeSystemInfo{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		v1.ObjectFieldSelector{}.OpenAPIModelName():               schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		v1.ObjectReference{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_ObjectReference(ref),
-		v1.PersistentVolume{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_PersistentVolume(ref),
-		v1.PersistentVolumeClaim{}.OpenAPIModelName():             schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		v1.PersistentVolumeClaimCondition{}.OpenAPIModelName():    schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		v1.PersistentVolumeClaimList{}.OpenAPIModelName():         schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		v1.PersistentVolumeClaimSpec{}.OpenAPIModelName():         schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		v1.PersistentVolumeClaimStatus{}.OpenAPIModelName():       schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		v1.PersistentVolumeClaimTemplate{}.OpenAPIModelName():     schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
-		v1.PersistentVolumeClaimVolumeSource{}.OpenAPIModelName(): schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		v1.PersistentVolumeList{}.OpenAPIModelName():              schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		v1.PersistentVolumeSource{}.OpenAPIModelName():            schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		v1.PersistentVolumeSpec{}.OpenAPIModelName():              schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		v1.PersistentVolumeStatus{}.OpenAPIModelName():            schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		v1.PhotonPersistentDiskVolumeSource{}.OpenAPIModelName():  schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		v1.Pod{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_Pod(ref),
-		v1.PodAffinity{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_PodAffinity(ref),
-		v1.PodAffinityTerm{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		v1.PodAntiAffinity{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		v1.PodAttachOptions{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		v1.PodCertificateProjection{}.OpenAPIModelName():          schema_k8sio_api_core_v1_PodCertificateProjection(ref),
-		v1.PodCondition{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_PodCondition(ref),
-		v1.PodDNSConfig{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		v1.PodDNSConfigOption{}.OpenAPIModelName():                schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		v1.PodExecOptions{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_PodExecOptions(ref),
-		v1.PodExtendedResourceClaimStatus{}.OpenAPIModelName():    schema_k8sio_api_core_v1_PodExtendedResourceClaimStatus(ref),
-		v1.PodIP{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_PodIP(ref),
-		v1.PodList{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_PodList(ref),
-		v1.PodLogOptions{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_PodLogOptions(ref),
-		v1.PodOS{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_PodOS(ref),
-		v1.PodPortForwardOptions{}.OpenAPIModelName():             schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		v1.PodProxyOptions{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		v1.PodReadinessGate{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		v1.PodResourceClaim{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_PodResourceClaim(ref),
-		v1.PodResourceClaimStatus{}.OpenAPIModelName():            schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
-		v1.PodSchedulingGate{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_PodSchedulingGate(ref),
-		v1.PodSecurityContext{}.OpenAPIModelName():                schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		v1.PodSignature{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_PodSignature(ref),
-		v1.PodSpec{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_PodSpec(ref),
-		v1.PodStatus{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_PodStatus(ref),
-		v1.PodStatusResult{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodStatusResult(ref),
-		v1.PodTemplate{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_PodTemplate(ref),
-		v1.PodTemplateList{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodTemplateList(ref),
-		v1.PodTemplateSpec{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		v1.PortStatus{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_PortStatus(ref),
-		v1.PortworxVolumeSource{}.OpenAPIModelName():              schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		v1.PreferAvoidPodsEntry{}.OpenAPIModelName():              schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		v1.PreferredSchedulingTerm{}.OpenAPIModelName():           schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		v1.Probe{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_Probe(ref),
-		v1.ProbeHandler{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ProbeHandler(ref),
-		v1.ProjectedVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		v1.QuobyteVolumeSource{}.OpenAPIModelName():               schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		v1.RBDPersistentVolumeSource{}.OpenAPIModelName():         schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		v1.RBDVolumeSource{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		v1.RangeAllocation{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_RangeAllocation(ref),
-		v1.ReplicationController{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ReplicationController(ref),
-		v1.ReplicationControllerCondition{}.OpenAPIModelName():    schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		v1.ReplicationControllerList{}.OpenAPIModelName():         schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		v1.ReplicationControllerSpec{}.OpenAPIModelName():         schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		v1.ReplicationControllerStatus{}.OpenAPIModelName():       schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		v1.ResourceClaim{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ResourceClaim(ref),
-		v1.ResourceFieldSelector{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		v1.ResourceHealth{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ResourceHealth(ref),
-		v1.ResourceQuota{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ResourceQuota(ref),
-		v1.ResourceQuotaList{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		v1.ResourceQuotaSpec{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		v1.ResourceQuotaStatus{}.OpenAPIModelName():               schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		v1.ResourceRequirements{}.OpenAPIModelName():              schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		v1.ResourceStatus{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ResourceStatus(ref),
-		v1.SELinuxOptions{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		v1.ScaleIOPersistentVolumeSource{}.OpenAPIModelName():     schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		v1.ScaleIOVolumeSource{}.OpenAPIModelName():               schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		v1.ScopeSelector{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ScopeSelector(ref),
-		v1.ScopedResourceSelectorRequirement{}.OpenAPIModelName(): schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		v1.SeccompProfile{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_SeccompProfile(ref),
-		v1.Secret{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_Secret(ref),
-		v1.SecretEnvSource{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		v1.SecretKeySelector{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		v1.SecretList{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_SecretList(ref),
-		v1.SecretProjection{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_SecretProjection(ref),
-		v1.SecretReference{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_SecretReference(ref),
-		v1.SecretVolumeSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		v1.SecurityContext{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_SecurityContext(ref),
-		v1.SerializedReference{}.OpenAPIModelName():               schema_k8sio_api_core_v1_SerializedReference(ref),
-		v1.Service{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_Service(ref),
-		v1.ServiceAccount{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ServiceAccount(ref),
-		v1.ServiceAccountList{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		v1.ServiceAccountTokenProjection{}.OpenAPIModelName():     schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		v1.ServiceList{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ServiceList(ref),
-		v1.ServicePort{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ServicePort(ref),
-		v1.ServiceProxyOptions{}.OpenAPIModelName():               schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		v1.ServiceSpec{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ServiceSpec(ref),
-		v1.ServiceStatus{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ServiceStatus(ref),
-		v1.SessionAffinityConfig{}.OpenAPIModelName():             schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		v1.SleepAction{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_SleepAction(ref),
-		v1.StorageOSPersistentVolumeSource{}.OpenAPIModelName():   schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		v1.StorageOSVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		v1.Sysctl{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_Sysctl(ref),
-		v1.TCPSocketAction{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		v1.Taint{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_Taint(ref),
-		v1.Toleration{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_Toleration(ref),
-		v1.TopologySelectorLabelRequirement{}.OpenAPIModelName():  schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		v1.TopologySelectorTerm{}.OpenAPIModelName():              schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		v1.TopologySpreadConstraint{}.OpenAPIModelName():          schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
-		v1.TypedLocalObjectReference{}.OpenAPIModelName():         schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		v1.TypedObjectReference{}.OpenAPIModelName():              schema_k8sio_api_core_v1_TypedObjectReference(ref),
-		v1.Volume{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_Volume(ref),
-		v1.VolumeDevice{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_VolumeDevice(ref),
-		v1.VolumeMount{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_VolumeMount(ref),
-		v1.VolumeMountStatus{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_VolumeMountStatus(ref),
-		v1.VolumeNodeAffinity{}.OpenAPIModelName():                schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		v1.VolumeProjection{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_VolumeProjection(ref),
-		v1.VolumeResourceRequirements{}.OpenAPIModelName():        schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
-		v1.VolumeSource{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_VolumeSource(ref),
-		v1.VsphereVirtualDiskVolumeSource{}.OpenAPIModelName():    schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		v1.WeightedPodAffinityTerm{}.OpenAPIModelName():           schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		v1.WindowsSecurityContextOptions{}.OpenAPIModelName():     schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
-		v1.WorkloadReference{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_WorkloadReference(ref),
-		resource.Quantity{}.OpenAPIModelName():                    schema_apimachinery_pkg_api_resource_Quantity(ref),
-		metav1.APIGroup{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_APIGroup(ref),
-		metav1.APIGroupList{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_APIGroupList(ref),
-		metav1.APIResource{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_APIResource(ref),
-		metav1.APIResourceList{}.OpenAPIModelName():               schema_pkg_apis_meta_v1_APIResourceList(ref),
-		metav1.APIVersions{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_APIVersions(ref),
-		metav1.ApplyOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		metav1.Condition{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_Condition(ref),
-		metav1.CreateOptions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_CreateOptions(ref),
-		metav1.DeleteOptions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		metav1.Duration{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_Duration(ref),
-		metav1.FieldSelectorRequirement{}.OpenAPIModelName():      schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		metav1.FieldsV1{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_FieldsV1(ref),
-		metav1.GetOptions{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_GetOptions(ref),
-		metav1.GroupKind{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_GroupKind(ref),
-		metav1.GroupResource{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_GroupResource(ref),
-		metav1.GroupVersion{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_GroupVersion(ref),
-		metav1.GroupVersionForDiscovery{}.OpenAPIModelName():      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		metav1.GroupVersionKind{}.OpenAPIModelName():              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		metav1.GroupVersionResource{}.OpenAPIModelName():          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		metav1.InternalEvent{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_InternalEvent(ref),
-		metav1.LabelSelector{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_LabelSelector(ref),
-		metav1.LabelSelectorRequirement{}.OpenAPIModelName():      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		metav1.List{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_List(ref),
-		metav1.ListMeta{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_ListMeta(ref),
-		metav1.ListOptions{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_ListOptions(ref),
-		metav1.ManagedFieldsEntry{}.OpenAPIModelName():            schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		metav1.MicroTime{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_MicroTime(ref),
-		metav1.ObjectMeta{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		metav1.OwnerReference{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_OwnerReference(ref),
-		metav1.PartialObjectMetadata{}.OpenAPIModelName():         schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		metav1.PartialObjectMetadataList{}.OpenAPIModelName():     schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		metav1.Patch{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_Patch(ref),
-		metav1.PatchOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_PatchOptions(ref),
-		metav1.Preconditions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_Preconditions(ref),
-		metav1.RootPaths{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_RootPaths(ref),
-		metav1.ServerAddressByClientCIDR{}.OpenAPIModelName():     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		metav1.Status{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_Status(ref),
-		metav1.StatusCause{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_StatusCause(ref),
-		metav1.StatusDetails{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_StatusDetails(ref),
-		metav1.Table{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_Table(ref),
-		metav1.TableColumnDefinition{}.OpenAPIModelName():         schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		metav1.TableOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_TableOptions(ref),
-		metav1.TableRow{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_TableRow(ref),
-		metav1.TableRowCondition{}.OpenAPIModelName():             schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		metav1.Time{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_Time(ref),
-		metav1.Timestamp{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_Timestamp(ref),
-		metav1.TypeMeta{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_TypeMeta(ref),
-		metav1.UpdateOptions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		metav1.WatchEvent{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_WatchEvent(ref),
-		runtime.RawExtension{}.OpenAPIModelName():                 schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		runtime.TypeMeta{}.OpenAPIModelName():                     schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		runtime.Unknown{}.OpenAPIModelName():                      schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		version.Info{}.OpenAPIModelName():                         schema_k8sio_apimachinery_pkg_version_Info(ref),
+		v1alpha1.Affinity{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_Affinity(ref),
+		v1alpha1.DaemonSet{}.OpenAPIModelName():                          schema_ironcore_net_api_core_v1alpha1_DaemonSet(ref),
+		v1alpha1.DaemonSetList{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_DaemonSetList(ref),
+		v1alpha1.DaemonSetSpec{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_DaemonSetSpec(ref),
+		v1alpha1.DaemonSetStatus{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_DaemonSetStatus(ref),
+		v1alpha1.IP{}.OpenAPIModelName():                                 schema_ironcore_net_api_core_v1alpha1_IP(ref),
+		v1alpha1.IPAddress{}.OpenAPIModelName():                          schema_ironcore_net_api_core_v1alpha1_IPAddress(ref),
+		v1alpha1.IPAddressClaimRef{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_IPAddressClaimRef(ref),
+		v1alpha1.IPAddressHistory{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_IPAddressHistory(ref),
+		v1alpha1.IPAddressHistoryList{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_IPAddressHistoryList(ref),
+		v1alpha1.IPAddressHistorySpec{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_IPAddressHistorySpec(ref),
+		v1alpha1.IPAddressLease{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_IPAddressLease(ref),
+		v1alpha1.IPAddressList{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_IPAddressList(ref),
+		v1alpha1.IPAddressSpec{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_IPAddressSpec(ref),
+		v1alpha1.IPBlock{}.OpenAPIModelName():                            schema_ironcore_net_api_core_v1alpha1_IPBlock(ref),
+		v1alpha1.IPClaimRef{}.OpenAPIModelName():                         schema_ironcore_net_api_core_v1alpha1_IPClaimRef(ref),
+		v1alpha1.IPList{}.OpenAPIModelName():                             schema_ironcore_net_api_core_v1alpha1_IPList(ref),
+		v1alpha1.IPPool{}.OpenAPIModelName():                             schema_ironcore_net_api_core_v1alpha1_IPPool(ref),
+		v1alpha1.IPPoolList{}.OpenAPIModelName():                         schema_ironcore_net_api_core_v1alpha1_IPPoolList(ref),
+		v1alpha1.IPPoolPrefixStatus{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_IPPoolPrefixStatus(ref),
+		v1alpha1.IPPoolSpec{}.OpenAPIModelName():                         schema_ironcore_net_api_core_v1alpha1_IPPoolSpec(ref),
+		v1alpha1.IPPoolStatus{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_IPPoolStatus(ref),
+		v1alpha1.IPSpec{}.OpenAPIModelName():                             schema_ironcore_net_api_core_v1alpha1_IPSpec(ref),
+		v1alpha1.IPStatus{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_IPStatus(ref),
+		v1alpha1.Instance{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_Instance(ref),
+		v1alpha1.InstanceAffinityTerm{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_InstanceAffinityTerm(ref),
+		v1alpha1.InstanceAntiAffinity{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_InstanceAntiAffinity(ref),
+		v1alpha1.InstanceList{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_InstanceList(ref),
+		v1alpha1.InstanceSpec{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_InstanceSpec(ref),
+		v1alpha1.InstanceStatus{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_InstanceStatus(ref),
+		v1alpha1.InstanceTemplate{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_InstanceTemplate(ref),
+		v1alpha1.LoadBalancer{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_LoadBalancer(ref),
+		v1alpha1.LoadBalancerDestination{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_LoadBalancerDestination(ref),
+		v1alpha1.LoadBalancerIP{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_LoadBalancerIP(ref),
+		v1alpha1.LoadBalancerList{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_LoadBalancerList(ref),
+		v1alpha1.LoadBalancerPort{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_LoadBalancerPort(ref),
+		v1alpha1.LoadBalancerRouting{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_LoadBalancerRouting(ref),
+		v1alpha1.LoadBalancerRoutingList{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_LoadBalancerRoutingList(ref),
+		v1alpha1.LoadBalancerSpec{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_LoadBalancerSpec(ref),
+		v1alpha1.LoadBalancerStatus{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_LoadBalancerStatus(ref),
+		v1alpha1.LoadBalancerTargetRef{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_LoadBalancerTargetRef(ref),
+		v1alpha1.LocalUIDReference{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_LocalUIDReference(ref),
+		v1alpha1.NATGateway{}.OpenAPIModelName():                         schema_ironcore_net_api_core_v1alpha1_NATGateway(ref),
+		v1alpha1.NATGatewayAutoscaler{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscaler(ref),
+		v1alpha1.NATGatewayAutoscalerBehavior{}.OpenAPIModelName():       schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerBehavior(ref),
+		v1alpha1.NATGatewayAutoscalerIPFamilyStatus{}.OpenAPIModelName(): schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerIPFamilyStatus(ref),
+		v1alpha1.NATGatewayAutoscalerList{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerList(ref),
+		v1alpha1.NATGatewayAutoscalerRecommendation{}.OpenAPIModelName(): schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerRecommendation(ref),
+		v1alpha1.NATGatewayAutoscalerScalingRules{}.OpenAPIModelName():   schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerScalingRules(ref),
+		v1alpha1.NATGatewayAutoscalerSpec{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerSpec(ref),
+		v1alpha1.NATGatewayAutoscalerStatus{}.OpenAPIModelName():         schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerStatus(ref),
+		v1alpha1.NATGatewayDrainingIPStatus{}.OpenAPIModelName():         schema_ironcore_net_api_core_v1alpha1_NATGatewayDrainingIPStatus(ref),
+		v1alpha1.NATGatewayIP{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NATGatewayIP(ref),
+		v1alpha1.NATGatewayIPFamilyStatus{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NATGatewayIPFamilyStatus(ref),
+		v1alpha1.NATGatewayIPStatus{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_NATGatewayIPStatus(ref),
+		v1alpha1.NATGatewayList{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_NATGatewayList(ref),
+		v1alpha1.NATGatewayNAT64{}.OpenAPIModelName():                    schema_ironcore_net_api_core_v1alpha1_NATGatewayNAT64(ref),
+		v1alpha1.NATGatewayPortBlockExpansion{}.OpenAPIModelName():       schema_ironcore_net_api_core_v1alpha1_NATGatewayPortBlockExpansion(ref),
+		v1alpha1.NATGatewayPortsOverride{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_NATGatewayPortsOverride(ref),
+		v1alpha1.NATGatewayReservation{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_NATGatewayReservation(ref),
+		v1alpha1.NATGatewaySpec{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_NATGatewaySpec(ref),
+		v1alpha1.NATGatewayStatus{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NATGatewayStatus(ref),
+		v1alpha1.NATGatewayStickyReservations{}.OpenAPIModelName():       schema_ironcore_net_api_core_v1alpha1_NATGatewayStickyReservations(ref),
+		v1alpha1.NATIP{}.OpenAPIModelName():                              schema_ironcore_net_api_core_v1alpha1_NATIP(ref),
+		v1alpha1.NATIPSection{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NATIPSection(ref),
+		v1alpha1.NATTable{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_NATTable(ref),
+		v1alpha1.NATTableIPTargetRef{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NATTableIPTargetRef(ref),
+		v1alpha1.NATTableList{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NATTableList(ref),
+		v1alpha1.Network{}.OpenAPIModelName():                            schema_ironcore_net_api_core_v1alpha1_Network(ref),
+		v1alpha1.NetworkID{}.OpenAPIModelName():                          schema_ironcore_net_api_core_v1alpha1_NetworkID(ref),
+		v1alpha1.NetworkIDClaimRef{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NetworkIDClaimRef(ref),
+		v1alpha1.NetworkIDList{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_NetworkIDList(ref),
+		v1alpha1.NetworkIDSpec{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_NetworkIDSpec(ref),
+		v1alpha1.NetworkIPAddress{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NetworkIPAddress(ref),
+		v1alpha1.NetworkIPAddressList{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkIPAddressList(ref),
+		v1alpha1.NetworkIPAddressSpec{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkIPAddressSpec(ref),
+		v1alpha1.NetworkInterface{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NetworkInterface(ref),
+		v1alpha1.NetworkInterfaceList{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceList(ref),
+		v1alpha1.NetworkInterfaceNAT{}.OpenAPIModelName():                schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNAT(ref),
+		v1alpha1.NetworkInterfaceNATClaimRef{}.OpenAPIModelName():        schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNATClaimRef(ref),
+		v1alpha1.NetworkInterfaceNATStatus{}.OpenAPIModelName():          schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNATStatus(ref),
+		v1alpha1.NetworkInterfacePublicIP{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkInterfacePublicIP(ref),
+		v1alpha1.NetworkInterfaceSpec{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceSpec(ref),
+		v1alpha1.NetworkInterfaceStatus{}.OpenAPIModelName():             schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceStatus(ref),
+		v1alpha1.NetworkList{}.OpenAPIModelName():                        schema_ironcore_net_api_core_v1alpha1_NetworkList(ref),
+		v1alpha1.NetworkPeering{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_NetworkPeering(ref),
+		v1alpha1.NetworkPeeringStatus{}.OpenAPIModelName():               schema_ironcore_net_api_core_v1alpha1_NetworkPeeringStatus(ref),
+		v1alpha1.NetworkPolicy{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_NetworkPolicy(ref),
+		v1alpha1.NetworkPolicyEgressRule{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_NetworkPolicyEgressRule(ref),
+		v1alpha1.NetworkPolicyIngressRule{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_NetworkPolicyIngressRule(ref),
+		v1alpha1.NetworkPolicyList{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NetworkPolicyList(ref),
+		v1alpha1.NetworkPolicyPeer{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NetworkPolicyPeer(ref),
+		v1alpha1.NetworkPolicyPort{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NetworkPolicyPort(ref),
+		v1alpha1.NetworkPolicyRule{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRule(ref),
+		v1alpha1.NetworkPolicyRuleList{}.OpenAPIModelName():              schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRuleList(ref),
+		v1alpha1.NetworkPolicySpec{}.OpenAPIModelName():                  schema_ironcore_net_api_core_v1alpha1_NetworkPolicySpec(ref),
+		v1alpha1.NetworkQuota{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NetworkQuota(ref),
+		v1alpha1.NetworkQuotaList{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NetworkQuotaList(ref),
+		v1alpha1.NetworkQuotaSpec{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NetworkQuotaSpec(ref),
+		v1alpha1.NetworkQuotaStatus{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_NetworkQuotaStatus(ref),
+		v1alpha1.NetworkSpec{}.OpenAPIModelName():                        schema_ironcore_net_api_core_v1alpha1_NetworkSpec(ref),
+		v1alpha1.NetworkStatus{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_NetworkStatus(ref),
+		v1alpha1.Node{}.OpenAPIModelName():                               schema_ironcore_net_api_core_v1alpha1_Node(ref),
+		v1alpha1.NodeAffinity{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NodeAffinity(ref),
+		v1alpha1.NodeList{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_NodeList(ref),
+		v1alpha1.NodeSelector{}.OpenAPIModelName():                       schema_ironcore_net_api_core_v1alpha1_NodeSelector(ref),
+		v1alpha1.NodeSelectorRequirement{}.OpenAPIModelName():            schema_ironcore_net_api_core_v1alpha1_NodeSelectorRequirement(ref),
+		v1alpha1.NodeSelectorTerm{}.OpenAPIModelName():                   schema_ironcore_net_api_core_v1alpha1_NodeSelectorTerm(ref),
+		v1alpha1.NodeSpec{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_NodeSpec(ref),
+		v1alpha1.NodeStatus{}.OpenAPIModelName():                         schema_ironcore_net_api_core_v1alpha1_NodeStatus(ref),
+		v1alpha1.ObjectIP{}.OpenAPIModelName():                           schema_ironcore_net_api_core_v1alpha1_ObjectIP(ref),
+		v1alpha1.ObjectSelector{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_ObjectSelector(ref),
+		v1alpha1.PCIAddress{}.OpenAPIModelName():                         schema_ironcore_net_api_core_v1alpha1_PCIAddress(ref),
+		v1alpha1.PeeringPrefix{}.OpenAPIModelName():                      schema_ironcore_net_api_core_v1alpha1_PeeringPrefix(ref),
+		v1alpha1.PortForwarding{}.OpenAPIModelName():                     schema_ironcore_net_api_core_v1alpha1_PortForwarding(ref),
+		v1alpha1.PortForwardingList{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_PortForwardingList(ref),
+		v1alpha1.PortForwardingSpec{}.OpenAPIModelName():                 schema_ironcore_net_api_core_v1alpha1_PortForwardingSpec(ref),
+		v1alpha1.Rule{}.OpenAPIModelName():                               schema_ironcore_net_api_core_v1alpha1_Rule(ref),
+		v1alpha1.TAPDevice{}.OpenAPIModelName():                          schema_ironcore_net_api_core_v1alpha1_TAPDevice(ref),
+		v1alpha1.TargetNetworkInterface{}.OpenAPIModelName():             schema_ironcore_net_api_core_v1alpha1_TargetNetworkInterface(ref),
+		v1alpha1.TopologySpreadConstraint{}.OpenAPIModelName():           schema_ironcore_net_api_core_v1alpha1_TopologySpreadConstraint(ref),
+		net.IP{}.OpenAPIModelName():                                      schema_ironcore_net_apimachinery_api_net_IP(ref),
+		net.IPPrefix{}.OpenAPIModelName():                                schema_ironcore_net_apimachinery_api_net_IPPrefix(ref),
+		v1.AWSElasticBlockStoreVolumeSource{}.OpenAPIModelName():         schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		v1.Affinity{}.OpenAPIModelName():                                 schema_k8sio_api_core_v1_Affinity(ref),
+		v1.AppArmorProfile{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_AppArmorProfile(ref),
+		v1.AttachedVolume{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_AttachedVolume(ref),
+		v1.AvoidPods{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_AvoidPods(ref),
+		v1.AzureDiskVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		v1.AzureFilePersistentVolumeSource{}.OpenAPIModelName():          schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		v1.AzureFileVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		v1.Binding{}.OpenAPIModelName():                                  schema_k8sio_api_core_v1_Binding(ref),
+		v1.CSIPersistentVolumeSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		v1.CSIVolumeSource{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		v1.Capabilities{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_Capabilities(ref),
+		v1.CephFSPersistentVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		v1.CephFSVolumeSource{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		v1.CinderPersistentVolumeSource{}.OpenAPIModelName():             schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		v1.CinderVolumeSource{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		v1.ClientIPConfig{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		v1.ClusterTrustBundleProjection{}.OpenAPIModelName():             schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
+		v1.ComponentCondition{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ComponentCondition(ref),
+		v1.ComponentStatus{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_ComponentStatus(ref),
+		v1.ComponentStatusList{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		v1.ConfigMap{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_ConfigMap(ref),
+		v1.ConfigMapEnvSource{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		v1.ConfigMapKeySelector{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		v1.ConfigMapList{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ConfigMapList(ref),
+		v1.ConfigMapNodeConfigSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		v1.ConfigMapProjection{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		v1.ConfigMapVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		v1.Container{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_Container(ref),
+		v1.ContainerExtendedResourceRequest{}.OpenAPIModelName():         schema_k8sio_api_core_v1_ContainerExtendedResourceRequest(ref),
+		v1.ContainerImage{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_ContainerImage(ref),
+		v1.ContainerPort{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ContainerPort(ref),
+		v1.ContainerResizePolicy{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
+		v1.ContainerRestartRule{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ContainerRestartRule(ref),
+		v1.ContainerRestartRuleOnExitCodes{}.OpenAPIModelName():          schema_k8sio_api_core_v1_ContainerRestartRuleOnExitCodes(ref),
+		v1.ContainerState{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_ContainerState(ref),
+		v1.ContainerStateRunning{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		v1.ContainerStateTerminated{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		v1.ContainerStateWaiting{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		v1.ContainerStatus{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_ContainerStatus(ref),
+		v1.ContainerUser{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ContainerUser(ref),
+		v1.DaemonEndpoint{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		v1.DownwardAPIProjection{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		v1.DownwardAPIVolumeFile{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		v1.DownwardAPIVolumeSource{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		v1.EmptyDirVolumeSource{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		v1.EndpointAddress{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_EndpointAddress(ref),
+		v1.EndpointPort{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_EndpointPort(ref),
+		v1.EndpointSubset{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_EndpointSubset(ref),
+		v1.Endpoints{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_Endpoints(ref),
+		v1.EndpointsList{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_EndpointsList(ref),
+		v1.EnvFromSource{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_EnvFromSource(ref),
+		v1.EnvVar{}.OpenAPIModelName():                                   schema_k8sio_api_core_v1_EnvVar(ref),
+		v1.EnvVarSource{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_EnvVarSource(ref),
+		v1.EphemeralContainer{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_EphemeralContainer(ref),
+		v1.EphemeralContainerCommon{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
+		v1.EphemeralVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
+		v1.Event{}.OpenAPIModelName():                                    schema_k8sio_api_core_v1_Event(ref),
+		v1.EventList{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_EventList(ref),
+		v1.EventSeries{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_EventSeries(ref),
+		v1.EventSource{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_EventSource(ref),
+		v1.ExecAction{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_ExecAction(ref),
+		v1.FCVolumeSource{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		v1.FileKeySelector{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_FileKeySelector(ref),
+		v1.FlexPersistentVolumeSource{}.OpenAPIModelName():               schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		v1.FlexVolumeSource{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		v1.FlockerVolumeSource{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		v1.GCEPersistentDiskVolumeSource{}.OpenAPIModelName():            schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		v1.GRPCAction{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_GRPCAction(ref),
+		v1.GitRepoVolumeSource{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		v1.GlusterfsPersistentVolumeSource{}.OpenAPIModelName():          schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		v1.GlusterfsVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		v1.HTTPGetAction{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		v1.HTTPHeader{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_HTTPHeader(ref),
+		v1.HostAlias{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_HostAlias(ref),
+		v1.HostIP{}.OpenAPIModelName():                                   schema_k8sio_api_core_v1_HostIP(ref),
+		v1.HostPathVolumeSource{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		v1.ISCSIPersistentVolumeSource{}.OpenAPIModelName():              schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		v1.ISCSIVolumeSource{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		v1.ImageVolumeSource{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_ImageVolumeSource(ref),
+		v1.KeyToPath{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_KeyToPath(ref),
+		v1.Lifecycle{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_Lifecycle(ref),
+		v1.LifecycleHandler{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_LifecycleHandler(ref),
+		v1.LimitRange{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_LimitRange(ref),
+		v1.LimitRangeItem{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		v1.LimitRangeList{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_LimitRangeList(ref),
+		v1.LimitRangeSpec{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		v1.LinuxContainerUser{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_LinuxContainerUser(ref),
+		v1.List{}.OpenAPIModelName():                                     schema_k8sio_api_core_v1_List(ref),
+		v1.LoadBalancerIngress{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		v1.LoadBalancerStatus{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		v1.LocalObjectReference{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		v1.LocalVolumeSource{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		v1.ModifyVolumeStatus{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
+		v1.NFSVolumeSource{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		v1.Namespace{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_Namespace(ref),
+		v1.NamespaceCondition{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_NamespaceCondition(ref),
+		v1.NamespaceList{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_NamespaceList(ref),
+		v1.NamespaceSpec{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		v1.NamespaceStatus{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		v1.Node{}.OpenAPIModelName():                                     schema_k8sio_api_core_v1_Node(ref),
+		v1.NodeAddress{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_NodeAddress(ref),
+		v1.NodeAffinity{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_NodeAffinity(ref),
+		v1.NodeCondition{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_NodeCondition(ref),
+		v1.NodeConfigSource{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		v1.NodeConfigStatus{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		v1.NodeDaemonEndpoints{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		v1.NodeFeatures{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_NodeFeatures(ref),
+		v1.NodeList{}.OpenAPIModelName():                                 schema_k8sio_api_core_v1_NodeList(ref),
+		v1.NodeProxyOptions{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		v1.NodeRuntimeHandler{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
+		v1.NodeRuntimeHandlerFeatures{}.OpenAPIModelName():               schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
+		v1.NodeSelector{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_NodeSelector(ref),
+		v1.NodeSelectorRequirement{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		v1.NodeSelectorTerm{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		v1.NodeSpec{}.OpenAPIModelName():                                 schema_k8sio_api_core_v1_NodeSpec(ref),
+		v1.NodeStatus{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_NodeStatus(ref),
+		v1.NodeSwapStatus{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_NodeSwapStatus(ref),
+		v1.NodeSystemInfo{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		v1.ObjectFieldSelector{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		v1.ObjectReference{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_ObjectReference(ref),
+		v1.PersistentVolume{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_PersistentVolume(ref),
+		v1.PersistentVolumeClaim{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		v1.PersistentVolumeClaimCondition{}.OpenAPIModelName():           schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		v1.PersistentVolumeClaimList{}.OpenAPIModelName():                schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		v1.PersistentVolumeClaimSpec{}.OpenAPIModelName():                schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		v1.PersistentVolumeClaimStatus{}.OpenAPIModelName():              schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		v1.PersistentVolumeClaimTemplate{}.OpenAPIModelName():            schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
+		v1.PersistentVolumeClaimVolumeSource{}.OpenAPIModelName():        schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		v1.PersistentVolumeList{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		v1.PersistentVolumeSource{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		v1.PersistentVolumeSpec{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		v1.PersistentVolumeStatus{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		v1.PhotonPersistentDiskVolumeSource{}.OpenAPIModelName():         schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		v1.Pod{}.OpenAPIModelName():                                      schema_k8sio_api_core_v1_Pod(ref),
+		v1.PodAffinity{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_PodAffinity(ref),
+		v1.PodAffinityTerm{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		v1.PodAntiAffinity{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		v1.PodAttachOptions{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		v1.PodCertificateProjection{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_PodCertificateProjection(ref),
+		v1.PodCondition{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_PodCondition(ref),
+		v1.PodDNSConfig{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		v1.PodDNSConfigOption{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		v1.PodExecOptions{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_PodExecOptions(ref),
+		v1.PodExtendedResourceClaimStatus{}.OpenAPIModelName():           schema_k8sio_api_core_v1_PodExtendedResourceClaimStatus(ref),
+		v1.PodIP{}.OpenAPIModelName():                                    schema_k8sio_api_core_v1_PodIP(ref),
+		v1.PodList{}.OpenAPIModelName():                                  schema_k8sio_api_core_v1_PodList(ref),
+		v1.PodLogOptions{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_PodLogOptions(ref),
+		v1.PodOS{}.OpenAPIModelName():                                    schema_k8sio_api_core_v1_PodOS(ref),
+		v1.PodPortForwardOptions{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		v1.PodProxyOptions{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		v1.PodReadinessGate{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		v1.PodResourceClaim{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_PodResourceClaim(ref),
+		v1.PodResourceClaimStatus{}.OpenAPIModelName():                   schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
+		v1.PodSchedulingGate{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_PodSchedulingGate(ref),
+		v1.PodSecurityContext{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		v1.PodSignature{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_PodSignature(ref),
+		v1.PodSpec{}.OpenAPIModelName():                                  schema_k8sio_api_core_v1_PodSpec(ref),
+		v1.PodStatus{}.OpenAPIModelName():                                schema_k8sio_api_core_v1_PodStatus(ref),
+		v1.PodStatusResult{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_PodStatusResult(ref),
+		v1.PodTemplate{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_PodTemplate(ref),
+		v1.PodTemplateList{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_PodTemplateList(ref),
+		v1.PodTemplateSpec{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		v1.PortStatus{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_PortStatus(ref),
+		v1.PortworxVolumeSource{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		v1.PreferAvoidPodsEntry{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		v1.PreferredSchedulingTerm{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		v1.Probe{}.OpenAPIModelName():                                    schema_k8sio_api_core_v1_Probe(ref),
+		v1.ProbeHandler{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_ProbeHandler(ref),
+		v1.ProjectedVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		v1.QuobyteVolumeSource{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		v1.RBDPersistentVolumeSource{}.OpenAPIModelName():                schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		v1.RBDVolumeSource{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		v1.RangeAllocation{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_RangeAllocation(ref),
+		v1.ReplicationController{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ReplicationController(ref),
+		v1.ReplicationControllerCondition{}.OpenAPIModelName():           schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		v1.ReplicationControllerList{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		v1.ReplicationControllerSpec{}.OpenAPIModelName():                schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		v1.ReplicationControllerStatus{}.OpenAPIModelName():              schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		v1.ResourceClaim{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ResourceClaim(ref),
+		v1.ResourceFieldSelector{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		v1.ResourceHealth{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_ResourceHealth(ref),
+		v1.ResourceQuota{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ResourceQuota(ref),
+		v1.ResourceQuotaList{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		v1.ResourceQuotaSpec{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		v1.ResourceQuotaStatus{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		v1.ResourceRequirements{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		v1.ResourceStatus{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_ResourceStatus(ref),
+		v1.SELinuxOptions{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		v1.ScaleIOPersistentVolumeSource{}.OpenAPIModelName():            schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		v1.ScaleIOVolumeSource{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		v1.ScopeSelector{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ScopeSelector(ref),
+		v1.ScopedResourceSelectorRequirement{}.OpenAPIModelName():        schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		v1.SeccompProfile{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_SeccompProfile(ref),
+		v1.Secret{}.OpenAPIModelName():                                   schema_k8sio_api_core_v1_Secret(ref),
+		v1.SecretEnvSource{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		v1.SecretKeySelector{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		v1.SecretList{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_SecretList(ref),
+		v1.SecretProjection{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_SecretProjection(ref),
+		v1.SecretReference{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_SecretReference(ref),
+		v1.SecretVolumeSource{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		v1.SecurityContext{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_SecurityContext(ref),
+		v1.SerializedReference{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_SerializedReference(ref),
+		v1.Service{}.OpenAPIModelName():                                  schema_k8sio_api_core_v1_Service(ref),
+		v1.ServiceAccount{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_ServiceAccount(ref),
+		v1.ServiceAccountList{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		v1.ServiceAccountTokenProjection{}.OpenAPIModelName():            schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		v1.ServiceList{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_ServiceList(ref),
+		v1.ServicePort{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_ServicePort(ref),
+		v1.ServiceProxyOptions{}.OpenAPIModelName():                      schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		v1.ServiceSpec{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_ServiceSpec(ref),
+		v1.ServiceStatus{}.OpenAPIModelName():                            schema_k8sio_api_core_v1_ServiceStatus(ref),
+		v1.SessionAffinityConfig{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		v1.SleepAction{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_SleepAction(ref),
+		v1.StorageOSPersistentVolumeSource{}.OpenAPIModelName():          schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		v1.StorageOSVolumeSource{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		v1.Sysctl{}.OpenAPIModelName():                                   schema_k8sio_api_core_v1_Sysctl(ref),
+		v1.TCPSocketAction{}.OpenAPIModelName():                          schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		v1.Taint{}.OpenAPIModelName():                                    schema_k8sio_api_core_v1_Taint(ref),
+		v1.Toleration{}.OpenAPIModelName():                               schema_k8sio_api_core_v1_Toleration(ref),
+		v1.TopologySelectorLabelRequirement{}.OpenAPIModelName():         schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		v1.TopologySelectorTerm{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		v1.TopologySpreadConstraint{}.OpenAPIModelName():                 schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
+		v1.TypedLocalObjectReference{}.OpenAPIModelName():                schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		v1.TypedObjectReference{}.OpenAPIModelName():                     schema_k8sio_api_core_v1_TypedObjectReference(ref),
+		v1.Volume{}.OpenAPIModelName():                                   schema_k8sio_api_core_v1_Volume(ref),
+		v1.VolumeDevice{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_VolumeDevice(ref),
+		v1.VolumeMount{}.OpenAPIModelName():                              schema_k8sio_api_core_v1_VolumeMount(ref),
+		v1.VolumeMountStatus{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_VolumeMountStatus(ref),
+		v1.VolumeNodeAffinity{}.OpenAPIModelName():                       schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		v1.VolumeProjection{}.OpenAPIModelName():                         schema_k8sio_api_core_v1_VolumeProjection(ref),
+		v1.VolumeResourceRequirements{}.OpenAPIModelName():               schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
+		v1.VolumeSource{}.OpenAPIModelName():                             schema_k8sio_api_core_v1_VolumeSource(ref),
+		v1.VsphereVirtualDiskVolumeSource{}.OpenAPIModelName():           schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		v1.WeightedPodAffinityTerm{}.OpenAPIModelName():                  schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		v1.WindowsSecurityContextOptions{}.OpenAPIModelName():            schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
+		v1.WorkloadReference{}.OpenAPIModelName():                        schema_k8sio_api_core_v1_WorkloadReference(ref),
+		resource.Quantity{}.OpenAPIModelName():                           schema_apimachinery_pkg_api_resource_Quantity(ref),
+		metav1.APIGroup{}.OpenAPIModelName():                             schema_pkg_apis_meta_v1_APIGroup(ref),
+		metav1.APIGroupList{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_APIGroupList(ref),
+		metav1.APIResource{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_APIResource(ref),
+		metav1.APIResourceList{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_APIResourceList(ref),
+		metav1.APIVersions{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_APIVersions(ref),
+		metav1.ApplyOptions{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		metav1.Condition{}.OpenAPIModelName():                            schema_pkg_apis_meta_v1_Condition(ref),
+		metav1.CreateOptions{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_CreateOptions(ref),
+		metav1.DeleteOptions{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		metav1.Duration{}.OpenAPIModelName():                             schema_pkg_apis_meta_v1_Duration(ref),
+		metav1.FieldSelectorRequirement{}.OpenAPIModelName():             schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		metav1.FieldsV1{}.OpenAPIModelName():                             schema_pkg_apis_meta_v1_FieldsV1(ref),
+		metav1.GetOptions{}.OpenAPIModelName():                           schema_pkg_apis_meta_v1_GetOptions(ref),
+		metav1.GroupKind{}.OpenAPIModelName():                            schema_pkg_apis_meta_v1_GroupKind(ref),
+		metav1.GroupResource{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_GroupResource(ref),
+		metav1.GroupVersion{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_GroupVersion(ref),
+		metav1.GroupVersionForDiscovery{}.OpenAPIModelName():             schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		metav1.GroupVersionKind{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		metav1.GroupVersionResource{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		metav1.InternalEvent{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_InternalEvent(ref),
+		metav1.LabelSelector{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_LabelSelector(ref),
+		metav1.LabelSelectorRequirement{}.OpenAPIModelName():             schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		metav1.List{}.OpenAPIModelName():                                 schema_pkg_apis_meta_v1_List(ref),
+		metav1.ListMeta{}.OpenAPIModelName():                             schema_pkg_apis_meta_v1_ListMeta(ref),
+		metav1.ListOptions{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_ListOptions(ref),
+		metav1.ManagedFieldsEntry{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		metav1.MicroTime{}.OpenAPIModelName():                            schema_pkg_apis_meta_v1_MicroTime(ref),
+		metav1.ObjectMeta{}.OpenAPIModelName():                           schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		metav1.OwnerReference{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_OwnerReference(ref),
+		metav1.PartialObjectMetadata{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		metav1.PartialObjectMetadataList{}.OpenAPIModelName():            schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		metav1.Patch{}.OpenAPIModelName():                                schema_pkg_apis_meta_v1_Patch(ref),
+		metav1.PatchOptions{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_PatchOptions(ref),
+		metav1.Preconditions{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_Preconditions(ref),
+		metav1.RootPaths{}.OpenAPIModelName():                            schema_pkg_apis_meta_v1_RootPaths(ref),
+		metav1.ServerAddressByClientCIDR{}.OpenAPIModelName():            schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		metav1.Status{}.OpenAPIModelName():                               schema_pkg_apis_meta_v1_Status(ref),
+		metav1.StatusCause{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_StatusCause(ref),
+		metav1.StatusDetails{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_StatusDetails(ref),
+		metav1.Table{}.OpenAPIModelName():                                schema_pkg_apis_meta_v1_Table(ref),
+		metav1.TableColumnDefinition{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		metav1.TableOptions{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_TableOptions(ref),
+		metav1.TableRow{}.OpenAPIModelName():                             schema_pkg_apis_meta_v1_TableRow(ref),
+		metav1.TableRowCondition{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		metav1.Time{}.OpenAPIModelName():                                 schema_pkg_apis_meta_v1_Time(ref),
+		metav1.Timestamp{}.OpenAPIModelName():                            schema_pkg_apis_meta_v1_Timestamp(ref),
+		metav1.TypeMeta{}.OpenAPIModelName():                             schema_pkg_apis_meta_v1_TypeMeta(ref),
+		metav1.UpdateOptions{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		metav1.WatchEvent{}.OpenAPIModelName():                           schema_pkg_apis_meta_v1_WatchEvent(ref),
+		runtime.RawExtension{}.OpenAPIModelName():                        schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		runtime.TypeMeta{}.OpenAPIModelName():                            schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		runtime.Unknown{}.OpenAPIModelName():                             schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		version.Info{}.OpenAPIModelName():                                schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -697,9 +729,176 @@ func schema_ironcore_net_api_core_v1alpha1_IPAddressClaimRef(ref common.Referenc
 							Format: "",
 						},
 					},
+					"pool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pool is the name of the IP pool the address was allocated from. Empty for addresses of statically configured prefixes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPAddressHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAddressHistory is the schema for the ipaddresshistories API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.IPAddressHistorySpec{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.IPAddressHistorySpec{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPAddressHistoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAddressHistoryList contains a list of IPAddressHistory.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.IPAddressHistory{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.IPAddressHistory{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPAddressHistorySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the address the history is about.",
+							Ref:         ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
+					"leases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Leases are the leases of the address, oldest first. Leases are only ever appended and released. Leases released longer than the retention period ago are pruned.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.IPAddressLease{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ip"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.IPAddressLease{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPAddressLease(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAddressLease is a period an address was allocated to a claimer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimRef references the claimer the address was allocated to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1alpha1.IPAddressClaimRef{}.OpenAPIModelName()),
+						},
+					},
+					"allocatedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllocatedTime is the time the address was allocated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"releasedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleasedTime is the time the address was released. Unset while the address is allocated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
 				},
+				Required: []string{"claimRef", "allocatedTime"},
 			},
 		},
+		Dependencies: []string{
+			v1alpha1.IPAddressClaimRef{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -897,61 +1096,58 @@ func schema_ironcore_net_api_core_v1alpha1_IPList(ref common.ReferenceCallback)
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_IPSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_IPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "IPPool is the schema for the ippools API.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ipFamily": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Possible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
-							Enum:        []interface{}{"", "IPv4", "IPv6"},
 						},
 					},
-					"ip": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref(net.IP{}.OpenAPIModelName()),
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-					"claimRef": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref(v1alpha1.IPClaimRef{}.OpenAPIModelName()),
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.IPPoolSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.IPPoolStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.IPClaimRef{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName()},
-	}
-}
-
-func schema_ironcore_net_api_core_v1alpha1_IPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
+			v1alpha1.IPPoolSpec{}.OpenAPIModelName(), v1alpha1.IPPoolStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_Instance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_IPPoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Instance is the schema for the instances API.",
+				Description: "IPPoolList contains a list of IPPool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -971,81 +1167,351 @@ func schema_ironcore_net_api_core_v1alpha1_Instance(ref common.ReferenceCallback
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.InstanceSpec{}.OpenAPIModelName()),
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.InstanceStatus{}.OpenAPIModelName()),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.IPPool{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.InstanceSpec{}.OpenAPIModelName(), v1alpha1.InstanceStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+			v1alpha1.IPPool{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_InstanceAffinityTerm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_IPPoolPrefixStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstanceAffinityTerm defines a set of instances (namely those matching the labelSelector that this instance should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a instance of the set of instances is running.",
+				Description: "IPPoolPrefixStatus is the utilization of a prefix of an IP pool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labelSelector": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LabelSelector over a set of resources, in this case instances.",
-							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+							Description: "Prefix is the prefix the utilization is reported for.",
+							Ref:         ref(net.IPPrefix{}.OpenAPIModelName()),
 						},
 					},
-					"topologyKey": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TopologyKey indicates that this instance should be co-located (affinity) or not co-located (anti-affinity) with the instances matching the labelSelector, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected instances is running. Empty topologyKey is not allowed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Total is the number of IPs in the prefix. For very large prefixes, the number is capped at the maximum int64 value.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the number of allocated IPs in the prefix.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"free": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Free is the number of IPs in the prefix that are not allocated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"topologyKey"},
+				Required: []string{"prefix", "total", "used", "free"},
 			},
 		},
 		Dependencies: []string{
-			metav1.LabelSelector{}.OpenAPIModelName()},
+			net.IPPrefix{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_InstanceAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_IPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"requiredDuringSchedulingIgnoredDuringExecution": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequiredDuringSchedulingIgnoredDuringExecution specifies anti-affinity requirements at scheduling time, that, if not met, will cause the instance not be scheduled onto the node. When there are multiple elements, the lists of nodes corresponding to each instanceAffinityTerm are intersected, i.e. all terms must be satisfied.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.InstanceAffinityTerm{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Description: "Type is the type of IPs allocated from the pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the IP family of the pool.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes are the prefixes IPs are allocated from. Prefixes can be added at runtime to grow the pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(net.IPPrefix{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"excluded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Excluded are ranges inside the prefixes that are never allocated, e.g. gateway, broadcast or infrastructure addresses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(net.IPPrefix{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"reserved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reserved are ranges inside the prefixes that are never allocated dynamically. Addresses of reserved ranges can only be requested explicitly by users allowed to 'use' the pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(net.IPPrefix{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"allocationStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllocationStrategy is the strategy dynamic IPs are allocated from the pool with. If unset, the default strategy of the apiserver is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace makes the pool a bring-your-own-IP pool of the namespace: Only IPs of the namespace can be allocated from it, and only if they select the pool by pool ref or pool selector. If unset, IPs of all namespaces can be allocated from the pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "ipFamily", "prefixes"},
+			},
+		},
+		Dependencies: []string{
+			net.IPPrefix{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes reports the utilization of each prefix of the pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.IPPoolPrefixStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.IPPoolPrefixStatus{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Possible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
+					"claimRef": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref(v1alpha1.IPClaimRef{}.OpenAPIModelName()),
+						},
+					},
+					"poolRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PoolRef references the IP pool to allocate the IP from. Mutually exclusive with PoolSelector.",
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"poolSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PoolSelector selects the IP pools to allocate the IP from. Mutually exclusive with PoolRef. If neither is set, the IP is allocated from any pool of matching type and IP family.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.IPClaimRef{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_IPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_Instance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Instance is the schema for the instances API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.InstanceSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.InstanceStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.InstanceSpec{}.OpenAPIModelName(), v1alpha1.InstanceStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_InstanceAffinityTerm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstanceAffinityTerm defines a set of instances (namely those matching the labelSelector that this instance should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a instance of the set of instances is running.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector over a set of resources, in this case instances.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey indicates that this instance should be co-located (affinity) or not co-located (anti-affinity) with the instances matching the labelSelector, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected instances is running. Empty topologyKey is not allowed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"topologyKey"},
+			},
+		},
+		Dependencies: []string{
+			metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_InstanceAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requiredDuringSchedulingIgnoredDuringExecution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredDuringSchedulingIgnoredDuringExecution specifies anti-affinity requirements at scheduling time, that, if not met, will cause the instance not be scheduled onto the node. When there are multiple elements, the lists of nodes corresponding to each instanceAffinityTerm are intersected, i.e. all terms must be satisfied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.InstanceAffinityTerm{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		Dependencies: []string{
 			v1alpha1.InstanceAffinityTerm{}.OpenAPIModelName()},
@@ -1316,12 +1782,31 @@ func schema_ironcore_net_api_core_v1alpha1_LoadBalancerDestination(ref common.Re
 							Ref:         ref(v1alpha1.LoadBalancerTargetRef{}.OpenAPIModelName()),
 						},
 					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the destination. If unset, the destination is Active.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"drainingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainingTimestamp is the time the destination started draining. Has to be set if State is Draining.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"drainTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainTimeout is the duration a draining destination is kept after DrainingTimestamp. If unset, a draining destination is removed immediately.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"ip"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.LoadBalancerTargetRef{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName()},
+			v1alpha1.LoadBalancerTargetRef{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -1451,7 +1936,7 @@ func schema_ironcore_net_api_core_v1alpha1_LoadBalancerRouting(ref common.Refere
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LoadBalancerRouting is the schema for the loadbalancerroutings API.",
+				Description: "LoadBalancerRouting is the schema for the loadbalancerroutings API. The destinations of a LoadBalancer may be split across multiple LoadBalancerRouting slices, each labeled with LoadBalancerNameLabel.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1565,8 +2050,37 @@ func schema_ironcore_net_api_core_v1alpha1_LoadBalancerSpec(ref common.Reference
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
-					"ips": {
-						VendorExtensible: spec.VendorExtensible{
+					"ipFamilyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilyPolicy specifies whether the load balancer is single- or dual-stack. If unspecified, defaults to SingleStack for a single IP family and to RequireDualStack otherwise.\n\nPossible enum values:\n - `\"PreferDualStack\"` indicates that this service prefers dual-stack when the cluster is configured for dual-stack. If the cluster is not configured for dual-stack the service will be assigned a single IPFamily. If the IPFamily is not set in service.spec.ipFamilies then the service will be assigned the default IPFamily configured on the cluster\n - `\"RequireDualStack\"` indicates that this service requires dual-stack. Using IPFamilyPolicyRequireDualStack on a single stack cluster will result in validation errors. The IPFamilies (and their order) assigned to this service is based on service.spec.ipFamilies. If service.spec.ipFamilies was not provided then it will be assigned according to how they are configured on the cluster. If service.spec.ipFamilies has only one entry then the alternative IPFamily will be added by apiserver\n - `\"SingleStack\"` indicates that this service is required to have a single IPFamily. The IPFamily assigned is based on the default IPFamily used by the cluster or as identified by service.spec.ipFamilies field",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"PreferDualStack", "RequireDualStack", "SingleStack"},
+						},
+					},
+					"ipFamilies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies are the IP families of the load balancer. The first family is the primary family. If unspecified, defaults to the IP families of IPs. An IP is allocated for every family that is not covered by IPs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+										Enum:    []interface{}{"", "IPv4", "IPv6"},
+									},
+								},
+							},
+						},
+					},
+					"ips": {
+						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-patch-merge-key": "name",
 								"x-kubernetes-patch-strategy":  "merge,retainKeys",
@@ -1808,6 +2322,76 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscaler(ref common.Refer
 	}
 }
 
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerBehavior(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleUp are the scaling rules for adding IPs.",
+							Ref:         ref(v1alpha1.NATGatewayAutoscalerScalingRules{}.OpenAPIModelName()),
+						},
+					},
+					"scaleDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDown are the scaling rules for removing IPs.",
+							Ref:         ref(v1alpha1.NATGatewayAutoscalerScalingRules{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NATGatewayAutoscalerScalingRules{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerIPFamilyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the IP family of the status.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the autoscaler changed the number of IPs of the IP family.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"recommendations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommendations are the latest times each number of IPs was recommended within the stabilization windows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayAutoscalerRecommendation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ipFamily"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NATGatewayAutoscalerRecommendation{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1857,6 +2441,68 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerList(ref common.R
 	}
 }
 
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerRecommendation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ips": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPs is the recommended number of IPs.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the latest time the number of IPs was recommended.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"ips", "time"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerScalingRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stabilizationWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StabilizationWindow is the period past recommendations are considered for. When scaling up, the lowest recommendation of the window is used, when scaling down, the highest one.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"maxIPsPerStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIPsPerStep is the maximum number of IPs added or removed per IP family in a single scaling step. If unset, the number of IPs per step is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"stepPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StepPeriod is the minimum period between two scaling steps. If unset, scaling steps are not delayed.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1872,24 +2518,44 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerSpec(ref common.R
 					},
 					"minPublicIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinPublicIPs is the minimum number of public IPs to allocate for a NAT Gateway.",
+							Description: "MinPublicIPs is the minimum number of public IPs to allocate per IP family of a NAT Gateway.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
 					"maxPublicIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxPublicIPs is the maximum number of public IPs to allocate for a NAT Gateway.",
+							Description: "MaxPublicIPs is the maximum number of public IPs to allocate per IP family of a NAT Gateway.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"targetUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetUtilizationPercentage is the targeted percentage of used port ranges of the IPs of an IP family. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"spareSlots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SpareSlots is the number of port ranges of PortsPerNetworkInterface ports kept available per IP family in addition to the requested ones.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"behavior": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Behavior configures the scaling behavior of the autoscaler. If unset, the number of IPs is changed without stabilization and rate limits.",
+							Ref:         ref(v1alpha1.NATGatewayAutoscalerBehavior{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"natGatewayRef"},
 			},
 		},
 		Dependencies: []string{
-			v1.LocalObjectReference{}.OpenAPIModelName()},
+			v1alpha1.NATGatewayAutoscalerBehavior{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
 	}
 }
 
@@ -1898,8 +2564,106 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayAutoscalerStatus(ref common
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"currentIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentIPs is the number of IPs of the NAT gateway not being drained.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"desiredIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredIPs is the number of IPs the autoscaler desires for the NAT gateway.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the autoscaler changed the number of IPs of any IP family.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies are the scale times and recommendations per IP family.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayAutoscalerIPFamilyStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the conditions of the autoscaler.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			v1alpha1.NATGatewayAutoscalerIPFamilyStatus{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayDrainingIPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the draining NAT gateway IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migratedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratedTime is the time all network interfaces had been migrated off the IP.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"drained": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drained reports whether the drain period of the IP elapsed and the IP can be removed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -1917,12 +2681,113 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayIP(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the IP family of the IP. Has to match IP if specified. If unspecified, defaults to the IP family of IP or to the primary IP family of the NAT gateway.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
 					"ip": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IP specifies a specific IP to allocate. If empty, a random IP will be allocated if possible.",
 							Ref:         ref(net.IP{}.OpenAPIModelName()),
 						},
 					},
+					"draining": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Draining marks the IP for removal. Network interfaces are migrated off a draining IP and no new port ranges are allocated on it.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			net.IP{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayIPFamilyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the IP family of the status.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"usedNATIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedNATIPs is the number of NAT IPs of the IP family in-use.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"requestedNATIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestedNATIPs is the number of requested NAT IPs of the IP family.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"ipFamily"},
+			},
+		},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayIPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the NAT gateway IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the IP of the NAT gateway IP.",
+							Ref:         ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
+					"totalSlots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalSlots is the total number of port ranges of PortsPerNetworkInterface ports of the IP.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"usedSlots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedSlots is the number of used port ranges of the IP.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"networkInterfaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaces is the number of network interfaces NATed using the IP.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -1973,11 +2838,140 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayList(ref common.ReferenceCa
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NATGateway{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayNAT64(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the IPv6 prefix IPv4 destinations are embedded in. Only the well-known prefix 64:ff9b::/96 the data plane translates is supported. Defaults to 64:ff9b::/96.",
+							Ref:         ref(net.IPPrefix{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			net.IPPrefix{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayPortBlockExpansion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxExtraPortBlocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxExtraPortBlocks is the maximum number of additional port blocks of PortsPerNetworkInterface ports granted to a network interface.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"coolDownPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoolDownPeriod is the period a network interface has to be free of NAT port pressure before its additional port blocks are reclaimed. Defaults to 5 minutes.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"maxExtraPortBlocks"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayPortsOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkInterfaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceSelector selects the network interfaces the override applies to.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"portsPerNetworkInterface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PortsPerNetworkInterface specifies how many ports to allocate per selected network interface. Has to be a multiple of the PortsPerNetworkInterface of the NAT gateway.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"networkInterfaceSelector", "portsPerNetworkInterface"},
+			},
+		},
+		Dependencies: []string{
+			metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key identifies the network interface the port range is reserved for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the NAT gateway IP of the port range.",
+							Ref:         ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the first port of the port range.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndPort is the last port of the port range.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"releasedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleasedTime is the time the network interface of the reservation has been gone since. Unset while the port range is allocated for the network interface.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"key", "ip", "port", "endPort"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.NATGateway{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+			net.IP{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -1989,13 +2983,33 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewaySpec(ref common.ReferenceCa
 				Properties: map[string]spec.Schema{
 					"ipFamily": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPFamily is the IP family of the NAT gateway.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
-							Default:     "",
+							Description: "IPFamily is the primary IP family of the NAT gateway. If unspecified, defaults to the first of IPFamilies.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
 							Type:        []string{"string"},
 							Format:      "",
 							Enum:        []interface{}{"", "IPv4", "IPv6"},
 						},
 					},
+					"ipFamilies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies are the IP families the NAT gateway serves. Every IP family is NATed using the IPs of that family. If unspecified, defaults to IPFamily.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+										Enum:    []interface{}{"", "IPv4", "IPv6"},
+									},
+								},
+							},
+						},
+					},
 					"networkRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "NetworkRef references the network the NAT gateway is part of.",
@@ -2003,6 +3017,19 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewaySpec(ref common.ReferenceCa
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"networkInterfaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceSelector selects the network interfaces of the network the NAT gateway NATs. If unset, all network interfaces of the network are selected.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority resolves conflicts between NAT gateways of the same network selecting the same network interface. The network interface is NATed by the NAT gateway with the highest priority. On equal priority, the oldest NAT gateway, then the NAT gateway with the lowest name wins.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"ips": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -2031,12 +3058,55 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewaySpec(ref common.ReferenceCa
 							Format:      "int32",
 						},
 					},
+					"portsPerNetworkInterfaceOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PortsPerNetworkInterfaceOverrides override PortsPerNetworkInterface for the network interfaces selected by them. The first matching override applies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayPortsOverride{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"portBlockExpansion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PortBlockExpansion configures granting additional port blocks to network interfaces reporting NAT port pressure. If unset, no additional port blocks are granted.",
+							Ref:         ref(v1alpha1.NATGatewayPortBlockExpansion{}.OpenAPIModelName()),
+						},
+					},
+					"ipDrainPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPDrainPeriod is the period a draining IP is retained after all network interfaces have been migrated off it, letting existing flows finish. Defaults to 2 minutes.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"nat64": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NAT64 makes the NAT gateway translate IPv6-only network interfaces to its IPv4 IPs. A NAT64 NAT gateway has to serve IPv4 only.",
+							Ref:         ref(v1alpha1.NATGatewayNAT64{}.OpenAPIModelName()),
+						},
+					},
+					"stickyReservations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StickyReservations makes the NAT gateway remember the port range of a network interface and restore it when the network interface is recreated. A network interface is identified by the value of its NATReservationKeyLabel or, if unset, by its UID. If unset, port ranges are not remembered.",
+							Ref:         ref(v1alpha1.NATGatewayStickyReservations{}.OpenAPIModelName()),
+						},
+					},
 				},
-				Required: []string{"ipFamily", "networkRef", "portsPerNetworkInterface"},
+				Required: []string{"networkRef", "portsPerNetworkInterface"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.NATGatewayIP{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
+			v1alpha1.NATGatewayIP{}.OpenAPIModelName(), v1alpha1.NATGatewayNAT64{}.OpenAPIModelName(), v1alpha1.NATGatewayPortBlockExpansion{}.OpenAPIModelName(), v1alpha1.NATGatewayPortsOverride{}.OpenAPIModelName(), v1alpha1.NATGatewayStickyReservations{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -2060,9 +3130,111 @@ func schema_ironcore_net_api_core_v1alpha1_NATGatewayStatus(ref common.Reference
 							Format:      "int64",
 						},
 					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies are the used and requested NAT IPs per IP family.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayIPFamilyStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"drainingIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainingIPs report the draining state of the draining IPs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayDrainingIPStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"ips": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPs report the port range usage per NAT gateway IP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayIPStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"reservations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reservations are the port ranges reserved for network interfaces if StickyReservations is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NATGatewayReservation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the conditions of the NAT gateway.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NATGatewayDrainingIPStatus{}.OpenAPIModelName(), v1alpha1.NATGatewayIPFamilyStatus{}.OpenAPIModelName(), v1alpha1.NATGatewayIPStatus{}.OpenAPIModelName(), v1alpha1.NATGatewayReservation{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NATGatewayStickyReservations(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retentionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPeriod is the period the port range of a network interface stays reserved after the network interface is gone. Defaults to 24 hours.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -2148,7 +3320,7 @@ func schema_ironcore_net_api_core_v1alpha1_NATTable(ref common.ReferenceCallback
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NATTable is the schema for the nattables API.",
+				Description: "NATTable is the schema for the nattables API. The sections of a NATGateway are split into one NATTable slice per node, each labeled with NATGatewayNameLabel and the TopologyPartitionLabel of its node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2398,23 +3570,134 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkIDClaimRef(ref common.Referenc
 							Format: "",
 						},
 					},
-					"uid": {
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkIDList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkIDList contains a list of NetworkID.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NetworkID{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NetworkID{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkIDSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimRef": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.NetworkIDClaimRef{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"claimRef"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NetworkIDClaimRef{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkIPAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkIPAddress is the schema for the networkipaddresses API. A NetworkIPAddress records that an address of a network is in use by a network interface or an internal load balancer of the network. Its name is derived from the network name and the address, so at most one object can claim an address of a network.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.NetworkIPAddressSpec{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			v1alpha1.NetworkIPAddressSpec{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_NetworkIDList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_NetworkIPAddressList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkIDList contains a list of NetworkID.",
+				Description: "NetworkIPAddressList contains a list of NetworkIPAddress.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2444,7 +3727,7 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkIDList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.NetworkID{}.OpenAPIModelName()),
+										Ref:     ref(v1alpha1.NetworkIPAddress{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -2455,28 +3738,42 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkIDList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.NetworkID{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+			v1alpha1.NetworkIPAddress{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_NetworkIDSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_NetworkIPAddressSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
+					"networkRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkRef references the network the address belongs to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the claimed address.",
+							Ref:         ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
 					"claimRef": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.NetworkIDClaimRef{}.OpenAPIModelName()),
+							Description: "ClaimRef references the network interface or internal load balancer claiming the address.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1alpha1.IPAddressClaimRef{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"claimRef"},
+				Required: []string{"networkRef", "ip", "claimRef"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.NetworkIDClaimRef{}.OpenAPIModelName()},
+			v1alpha1.IPAddressClaimRef{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
 	}
 }
 
@@ -2636,6 +3933,51 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNATClaimRef(ref commo
 	}
 }
 
+func schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceNATStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkInterfaceNATStatus is the NAT status of a network interface for an IP family.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the IP family of the NAT.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is the number of NAT ports programmed for the network interface.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"portPressure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PortPressure reports whether the network interface exhausts its NAT ports.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time Ports or PortPressure changed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"ipFamily"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_ironcore_net_api_core_v1alpha1_NetworkInterfacePublicIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2835,11 +4177,25 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkInterfaceStatus(ref common.Ref
 							},
 						},
 					},
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NATs are the NAT statuses of the network interface per IP family.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NetworkInterfaceNATStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.PCIAddress{}.OpenAPIModelName(), v1alpha1.TAPDevice{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName(), net.IPPrefix{}.OpenAPIModelName()},
+			v1alpha1.NetworkInterfaceNATStatus{}.OpenAPIModelName(), v1alpha1.PCIAddress{}.OpenAPIModelName(), v1alpha1.TAPDevice{}.OpenAPIModelName(), net.IP{}.OpenAPIModelName(), net.IPPrefix{}.OpenAPIModelName()},
 	}
 }
 
@@ -3274,34 +4630,209 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRule(ref common.Referenc
 							},
 						},
 					},
-					"egressRule": {
+					"egressRule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressRules are the egress rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.Rule{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networkRef"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.LocalUIDReference{}.OpenAPIModelName(), v1alpha1.Rule{}.OpenAPIModelName(), v1alpha1.TargetNetworkInterface{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRulesList contains a list of NetworkPolicyRule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NetworkPolicyRule{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NetworkPolicyRule{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkRef is the network to regulate using this policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"networkInterfaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceSelector selects the network interfaces that are subject to this policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority is an optional field that specifies the order in which the policy is applied. Policies with higher \"order\" are applied after those with lower order.  If the order is omitted, it may be considered to be \"infinite\" - i.e. the policy will be applied last.  Policies with identical order will be applied in alphanumerical order based on the Policy \"Name\".",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress specifies rules for ingress traffic.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NetworkPolicyIngressRule{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress specifies rules for egress traffic.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.NetworkPolicyEgressRule{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"policyTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PolicyTypes specifies the types of policies this network policy contains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networkRef", "networkInterfaceSelector"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.NetworkPolicyEgressRule{}.OpenAPIModelName(), v1alpha1.NetworkPolicyIngressRule{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkQuota is the schema for the networkquotas API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.NetworkQuotaSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressRules are the egress rules.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.Rule{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.NetworkQuotaStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"networkRef"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.LocalUIDReference{}.OpenAPIModelName(), v1alpha1.Rule{}.OpenAPIModelName(), v1alpha1.TargetNetworkInterface{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+			v1alpha1.NetworkQuotaSpec{}.OpenAPIModelName(), v1alpha1.NetworkQuotaStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_NetworkQuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NetworkPolicyRulesList contains a list of NetworkPolicyRule.",
+				Description: "NetworkQuotaList contains a list of NetworkQuota.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3331,7 +4862,7 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRuleList(ref common.Refe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.NetworkPolicyRule{}.OpenAPIModelName()),
+										Ref:     ref(v1alpha1.NetworkQuota{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -3342,86 +4873,63 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkPolicyRuleList(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.NetworkPolicyRule{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+			v1alpha1.NetworkQuota{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_ironcore_net_api_core_v1alpha1_NetworkPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_net_api_core_v1alpha1_NetworkQuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"networkRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NetworkRef is the network to regulate using this policy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
-						},
-					},
-					"networkInterfaceSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NetworkInterfaceSelector selects the network interfaces that are subject to this policy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
-						},
-					},
-					"priority": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Priority is an optional field that specifies the order in which the policy is applied. Policies with higher \"order\" are applied after those with lower order.  If the order is omitted, it may be considered to be \"infinite\" - i.e. the policy will be applied last.  Policies with identical order will be applied in alphanumerical order based on the Policy \"Name\".",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"ingress": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ingress specifies rules for ingress traffic.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.NetworkPolicyIngressRule{}.OpenAPIModelName()),
-									},
-								},
-							},
-						},
-					},
-					"egress": {
+					"hard": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Egress specifies rules for egress traffic.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Hard is the maximum number of each resource allowed in the namespace. Resources not listed are not limited.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.NetworkPolicyEgressRule{}.OpenAPIModelName()),
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
 									},
 								},
 							},
 						},
 					},
-					"policyTypes": {
+				},
+			},
+		},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_NetworkQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PolicyTypes specifies the types of policies this network policy contains.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Used is the number of each limited resource used in the namespace. It is increased by the apiserver when admitting objects and recomputed by the controller manager.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"networkRef", "networkInterfaceSelector"},
 			},
 		},
-		Dependencies: []string{
-			v1alpha1.NetworkPolicyEgressRule{}.OpenAPIModelName(), v1alpha1.NetworkPolicyIngressRule{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -3438,6 +4946,24 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkSpec(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"prefixes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes are the internal address space of the network. IPs of internal load balancers that don't specify an IP are allocated from them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(net.IPPrefix{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 					"peerings": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Peerings are the network peerings with this network",
@@ -3456,7 +4982,7 @@ func schema_ironcore_net_api_core_v1alpha1_NetworkSpec(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.NetworkPeering{}.OpenAPIModelName()},
+			v1alpha1.NetworkPeering{}.OpenAPIModelName(), net.IPPrefix{}.OpenAPIModelName()},
 	}
 }
 
@@ -3903,6 +5429,169 @@ func schema_ironcore_net_api_core_v1alpha1_PeeringPrefix(ref common.ReferenceCal
 	}
 }
 
+func schema_ironcore_net_api_core_v1alpha1_PortForwarding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PortForwarding is the schema for the portforwardings API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.PortForwardingSpec{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.PortForwardingSpec{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_PortForwardingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PortForwardingList contains a list of PortForwarding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.PortForwarding{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.PortForwarding{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_ironcore_net_api_core_v1alpha1_PortForwardingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the IP family of the port forwarding.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP specifies the public IP to forward from. If empty, a random IP will be allocated if possible.",
+							Ref:         ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
+					"networkInterfaceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceRef references the network interface to forward to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"targetIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetIP is the IP of the network interface to forward to.",
+							Ref:         ref(net.IP{}.OpenAPIModelName()),
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol to forward. If not specified, defaults to TCP.\n\nPossible enum values:\n - `\"SCTP\"` is the SCTP protocol.\n - `\"TCP\"` is the TCP protocol.\n - `\"UDP\"` is the UDP protocol.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"SCTP", "TCP", "UDP"},
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the public port to forward.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndPort marks the end of the public port range to forward. If unspecified, only a single port, Port, will be forwarded. The range must not span more than 1024 ports.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetPort is the port of the network interface Port is forwarded to. Ports cannot be translated, so if specified, it must equal Port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"ipFamily", "networkInterfaceRef", "targetIP", "port"},
+			},
+		},
+		Dependencies: []string{
+			net.IP{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
 func schema_ironcore_net_api_core_v1alpha1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ironcore-dev/ironcore-net/utils/migration"
 	"github.com/ironcore-dev/ironcore-net/utils/migrations"
@@ -79,6 +80,8 @@ func main() {
 	var watchFilterValue string
 
 	var isNodeAffinityAware bool
+	var loadBalancerDestinationDrainTimeout time.Duration
+	var loadBalancerRoutingMaxDestinations int
 
 	var tlsOpts []func(*tls.Config)
 
@@ -100,6 +103,10 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&isNodeAffinityAware, "is-node-affinity-aware", false, "If set, will determine node affinity topology for loadbalancer daemonsets.")
+	flag.DurationVar(&loadBalancerDestinationDrainTimeout, "loadbalancer-destination-drain-timeout", 0,
+		"Duration a removed load balancer destination is kept draining, keeping its established connections, before it is dropped. If zero, destinations are dropped immediately.")
+	flag.IntVar(&loadBalancerRoutingMaxDestinations, "loadbalancer-routing-max-destinations", controllers.DefaultLoadBalancerRoutingMaxDestinations,
+		"Maximum number of destinations per load balancer routing slice. If zero, all destinations are kept in a single slice.")
 
 	configOptions.BindFlags(flag.CommandLine)
 	apiNetGetConfigOptions.BindFlags(flag.CommandLine, config.WithNamePrefix(apiNetFlagPrefix))
@@ -329,12 +336,14 @@ func main() {
 	}
 
 	if err = (&controllers.LoadBalancerReconciler{
-		Client:              mgr.GetClient(),
-		APINetClient:        apiNetCluster.GetClient(),
-		APINetInterface:     apiNetIface,
-		APINetNamespace:     apiNetNamespace,
-		WatchFilterValue:    watchFilterValue,
-		IsNodeAffinityAware: isNodeAffinityAware,
+		Client:                  mgr.GetClient(),
+		APINetClient:            apiNetCluster.GetClient(),
+		APINetInterface:         apiNetIface,
+		APINetNamespace:         apiNetNamespace,
+		WatchFilterValue:        watchFilterValue,
+		IsNodeAffinityAware:     isNodeAffinityAware,
+		DestinationDrainTimeout: loadBalancerDestinationDrainTimeout,
+		RoutingMaxDestinations:  loadBalancerRoutingMaxDestinations,
 	}).SetupWithManager(mgr, apiNetCluster.GetCache()); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LoadBalancer")
 		os.Exit(1)
@@ -342,6 +351,7 @@ func main() {
 
 	if err = (&controllers.NATGatewayReconciler{
 		Client:           mgr.GetClient(),
+		EventRecorder:    mgr.GetEventRecorder("natgateways"),
 		APINetClient:     apiNetCluster.GetClient(),
 		APINetInterface:  apiNetIface,
 		APINetNamespace:  apiNetNamespace,
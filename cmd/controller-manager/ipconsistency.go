@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/internal/ipconsistency"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// runIPConsistencyCheckOnce runs a single IP consistency check, prints the issues found
+// and, if fix is set, fixes the fixable ones. It returns the exit code of the check:
+// 0 if no issues remain, 1 otherwise.
+func runIPConsistencyCheckOnce(ctx context.Context, cfg *rest.Config, gracePeriod time.Duration, fix bool) int {
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create client")
+		return 1
+	}
+
+	checker := &ipconsistency.Checker{
+		Client:      c,
+		Reader:      c,
+		GracePeriod: gracePeriod,
+	}
+	issues, err := checker.Check(ctx)
+	if err != nil {
+		setupLog.Error(err, "unable to check IP consistency")
+		return 1
+	}
+
+	var remaining int
+	for _, issue := range issues {
+		if !fix || !issue.Fixable() {
+			fmt.Println(issue)
+			remaining++
+			continue
+		}
+		if _, err := checker.Fix(ctx, issue); err != nil {
+			setupLog.Error(err, "unable to fix IP consistency issue", "issue", issue.String())
+			fmt.Println(issue)
+			remaining++
+			continue
+		}
+		fmt.Printf("%s (fixed)\n", issue)
+	}
+
+	if remaining > 0 {
+		return 1
+	}
+	return 0
+}
@@ -8,6 +8,7 @@ import (
 	goflag "flag"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ironcore-dev/controller-utils/configutils"
 	ironcorenetv1alpha1 "github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
@@ -16,6 +17,7 @@ import (
 	"github.com/ironcore-dev/ironcore-net/internal/controllers"
 	ironcorenet "github.com/ironcore-dev/ironcore-net/internal/controllers/certificate/ironcore-net"
 	"github.com/ironcore-dev/ironcore-net/internal/controllers/scheduler"
+	"github.com/ironcore-dev/ironcore-net/internal/ipconsistency"
 	"github.com/ironcore-dev/ironcore-net/utils/expectations"
 	flag "github.com/spf13/pflag"
 	"k8s.io/utils/lru"
@@ -56,6 +58,11 @@ func main() {
 	var enableHTTP2 bool
 	var enableLeaderElection bool
 	var probeAddr string
+	var ipPoolUtilizationWarningThreshold int32
+	var ipConsistencyCheckInterval time.Duration
+	var ipConsistencyGracePeriod time.Duration
+	var ipConsistencyFix bool
+	var checkIPConsistencyOnce bool
 	var tlsOpts []func(*tls.Config)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
@@ -68,6 +75,19 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false, "If set, HTTP/2 will be enabled for the metrics.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.Int32Var(&ipPoolUtilizationWarningThreshold, "ip-pool-utilization-warning-threshold",
+		controllers.DefaultIPPoolUtilizationWarningThresholdPercentage,
+		"Utilization of an IP pool in percent at which a warning event is emitted.")
+	flag.DurationVar(&ipConsistencyCheckInterval, "ip-consistency-check-interval",
+		controllers.DefaultIPConsistencyCheckInterval,
+		"Interval between two consistency checks of IPs, IP addresses and their claimers.")
+	flag.DurationVar(&ipConsistencyGracePeriod, "ip-consistency-grace-period",
+		controllers.DefaultIPConsistencyGracePeriod,
+		"Minimum age of an object before it is checked for IP consistency issues.")
+	flag.BoolVar(&ipConsistencyFix, "ip-consistency-fix", false,
+		"If set, fixable IP consistency issues are fixed instead of only being reported.")
+	flag.BoolVar(&checkIPConsistencyOnce, "check-ip-consistency-once", false,
+		"If set, run a single IP consistency check, print the issues found and exit instead of starting the manager.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -88,6 +108,10 @@ func main() {
 		os.Exit(1)
 	}
 
+	if checkIPConsistencyOnce {
+		os.Exit(runIPConsistencyCheckOnce(ctx, cfg, ipConsistencyGracePeriod, ipConsistencyFix))
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -198,6 +222,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.IPPoolReconciler{
+		Client:                                mgr.GetClient(),
+		EventRecorder:                         mgr.GetEventRecorder("ippools"),
+		UtilizationWarningThresholdPercentage: ipPoolUtilizationWarningThreshold,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IPPool")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.LoadBalancerReconciler{
 		Client: mgr.GetClient(),
 	}).SetupWithManager(mgr); err != nil {
@@ -205,6 +238,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.NetworkQuotaReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NetworkQuota")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.NetworkPolicyReconciler{
 		Client: mgr.GetClient(),
 	}).SetupWithManager(mgr); err != nil {
@@ -236,6 +276,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.NetworkIPAddressGCReconciler{
+		Client:       mgr.GetClient(),
+		APIReader:    mgr.GetAPIReader(),
+		GracePeriod:  controllers.DefaultNetworkIPAddressGCGracePeriod,
+		AbsenceCache: lru.New(500),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NetworkIPAddressGC")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.NetworkInterfaceNATReleaseReconciler{
 		Client:       mgr.GetClient(),
 		APIReader:    mgr.GetAPIReader(),
@@ -244,6 +294,20 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "NetworkInterfaceNATRelease")
 	}
 
+	if err = (&controllers.IPConsistencyController{
+		Checker: &ipconsistency.Checker{
+			Client:      mgr.GetClient(),
+			Reader:      mgr.GetAPIReader(),
+			GracePeriod: ipConsistencyGracePeriod,
+		},
+		EventRecorder: mgr.GetEventRecorder("ipconsistency"),
+		Interval:      ipConsistencyCheckInterval,
+		Fix:           ipConsistencyFix,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IPConsistency")
+		os.Exit(1)
+	}
+
 	schedulerCache := scheduler.NewCache(
 		mgr.GetLogger().WithName("scheduler").WithName("cache"),
 		scheduler.DefaultCacheStrategy,
@@ -267,6 +331,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := apinetclient.SetupNetworkInterfaceNodeNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to setup field indexer", "field", apinetclient.NetworkInterfaceSpecNodeRefNameField)
+		os.Exit(1)
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
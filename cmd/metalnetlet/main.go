@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ironcore-dev/controller-utils/configutils"
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	metalnetletconfig "github.com/ironcore-dev/ironcore-net/metalnetlet/client/config"
 	"github.com/ironcore-dev/ironcore-net/metalnetlet/controllers"
+	"github.com/ironcore-dev/ironcore-net/metalnetlet/natportusage"
 	"github.com/ironcore-dev/ironcore-net/utils/migration"
 	"github.com/ironcore-dev/ironcore-net/utils/migrations"
 	"github.com/ironcore-dev/ironcore-net/utils/origin"
@@ -73,6 +75,8 @@ func main() {
 	var metalnetNamespace string
 	var metalnetNodeSelectorValue string
 	var disableNetworkPeering bool
+	var dpserviceExporterPort int32
+	var natPortUsageResyncInterval time.Duration
 	var tlsOpts []func(*tls.Config)
 
 	var skipMigrations bool
@@ -99,6 +103,11 @@ func main() {
 	flag.BoolVar(&disableNetworkPeering, "disable-network-peering", false,
 		"Disable the metalnet based network peering. If set to true the network peering is handled externally.")
 	flag.StringVar(&metalnetNodeSelectorValue, "metalnet-node-selector", "", "Selector for metalnet nodes to expose as apinet nodes.")
+	flag.Int32Var(&dpserviceExporterPort, "dpservice-exporter-port", 0,
+		"Port of the dpservice-exporter on the metalnet nodes to get the NAT port usage of network interfaces from. "+
+			"If zero, network interfaces never report NAT port pressure.")
+	flag.DurationVar(&natPortUsageResyncInterval, "nat-port-usage-resync-interval", controllers.DefaultNATPortUsageResyncInterval,
+		"Interval to re-check the NAT port usage of NATed network interfaces in.")
 
 	flag.BoolVar(&skipMigrations, "skip-migrations", false, "Whether to skip any migration before start or not.")
 
@@ -214,6 +223,14 @@ func main() {
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "bf12dae0.metalnetlet.apinet.ironcore.dev",
 		LeaderElectionConfig:   metalnetCfg,
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				// Only watch the NAT table slices of the nodes of this partition.
+				&v1alpha1.NATTable{}: {
+					Label: labels.SelectorFromSet(labels.Set{v1alpha1.TopologyPartitionLabel: name}),
+				},
+			},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -307,6 +324,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := (&controllers.PortForwardingReconciler{
+		Client:            mgr.GetClient(),
+		MetalnetClient:    metalnetCluster.GetClient(),
+		MetalnetAPIReader: metalnetCluster.GetAPIReader(),
+		PartitionName:     name,
+		MetalnetNamespace: metalnetNamespace,
+	}).SetupWithManager(mgr, metalnetCluster.GetCache()); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PortForwarding")
+		os.Exit(1)
+	}
+
 	if err := (&controllers.MetalnetNodeReconciler{
 		Client:         mgr.GetClient(),
 		MetalnetClient: metalnetCluster.GetClient(),
@@ -328,11 +356,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	var natPortUsageGetter natportusage.Getter
+	if dpserviceExporterPort != 0 {
+		natPortUsageGetter = &natportusage.ExporterGetter{
+			NodeReader: metalnetCluster.GetClient(),
+			Port:       dpserviceExporterPort,
+		}
+	}
+
 	if err = (&controllers.NetworkInterfaceReconciler{
-		Client:            mgr.GetClient(),
-		MetalnetClient:    metalnetCluster.GetClient(),
-		PartitionName:     name,
-		MetalnetNamespace: metalnetNamespace,
+		Client:                     mgr.GetClient(),
+		APIReader:                  mgr.GetAPIReader(),
+		MetalnetClient:             metalnetCluster.GetClient(),
+		PartitionName:              name,
+		MetalnetNamespace:          metalnetNamespace,
+		NATPortUsageGetter:         natPortUsageGetter,
+		NATPortUsageResyncInterval: natPortUsageResyncInterval,
 	}).SetupWithManager(mgr, metalnetCluster.GetCache()); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Network")
 		os.Exit(1)
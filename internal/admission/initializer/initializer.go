@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package initializer
+
+import (
+	informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions"
+	clientset "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+// WantsIronCoreNetInformerFactory is implemented by admission plugins that need the ironcore-net informers.
+type WantsIronCoreNetInformerFactory interface {
+	SetIronCoreNetInformerFactory(informers.SharedInformerFactory)
+	admission.InitializationValidator
+}
+
+// WantsIronCoreNetClientSet is implemented by admission plugins that need an ironcore-net client.
+type WantsIronCoreNetClientSet interface {
+	SetIronCoreNetClientSet(clientset.Interface)
+	admission.InitializationValidator
+}
+
+type pluginInitializer struct {
+	informers informers.SharedInformerFactory
+	client    clientset.Interface
+}
+
+var _ admission.PluginInitializer = pluginInitializer{}
+
+// New creates an admission plugin initializer handing out the given informer factory and client.
+func New(informers informers.SharedInformerFactory, client clientset.Interface) admission.PluginInitializer {
+	return pluginInitializer{
+		informers: informers,
+		client:    client,
+	}
+}
+
+func (i pluginInitializer) Initialize(plugin admission.Interface) {
+	if wants, ok := plugin.(WantsIronCoreNetInformerFactory); ok {
+		wants.SetIronCoreNetInformerFactory(i.informers)
+	}
+	if wants, ok := plugin.(WantsIronCoreNetClientSet); ok {
+		wants.SetIronCoreNetClientSet(i.client)
+	}
+}
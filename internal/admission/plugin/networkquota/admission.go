@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkquota
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions"
+	clientset "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned"
+	v1alpha1listers "github.com/ironcore-dev/ironcore-net/client-go/listers/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/internal/admission/initializer"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const (
+	PluginName = "NetworkQuota"
+
+	// maxUpdateAttempts is the number of times the usage of a network quota is charged
+	// before giving up on conflicts.
+	maxUpdateAttempts = 5
+)
+
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		plugin := newPlugin()
+		return plugin, nil
+	})
+}
+
+// networkQuotaPlugin rejects creating and updating objects that would exceed a network quota of their namespace.
+//
+// Like the Kubernetes ResourceQuota admission, the plugin charges the requested resources to the
+// status.used of each network quota limiting them. The status is updated with optimistic concurrency,
+// so concurrent requests through any apiserver replica cannot exceed a quota. Usage of deleted objects
+// is given back by the network quota controller, which recomputes the status.
+//
+// Every IP is counted by a single resource: IPs of NAT gateways by natgateways.ips, public IPs of network
+// interfaces by networkinterfaces.publicips and all other IPs, including those of public load balancers and
+// port forwardings, by the ips resource of their family. The IPs the apiserver allocates for a claimer are
+// charged when admitting the claimer, so they are not charged again. IPs claimed by anyone else are always
+// charged to the ips resource of their family.
+type networkQuotaPlugin struct {
+	*admission.Handler
+
+	client             clientset.Interface
+	networkQuotaLister v1alpha1listers.NetworkQuotaLister
+}
+
+var (
+	_ admission.ValidationInterface               = &networkQuotaPlugin{}
+	_ initializer.WantsIronCoreNetInformerFactory = &networkQuotaPlugin{}
+	_ initializer.WantsIronCoreNetClientSet       = &networkQuotaPlugin{}
+)
+
+func newPlugin() *networkQuotaPlugin {
+	return &networkQuotaPlugin{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+func (p *networkQuotaPlugin) SetIronCoreNetInformerFactory(f informers.SharedInformerFactory) {
+	networkQuotaInformer := f.Core().V1alpha1().NetworkQuotas()
+	p.networkQuotaLister = networkQuotaInformer.Lister()
+	p.SetReadyFunc(networkQuotaInformer.Informer().HasSynced)
+}
+
+func (p *networkQuotaPlugin) SetIronCoreNetClientSet(client clientset.Interface) {
+	p.client = client
+}
+
+func (p *networkQuotaPlugin) ValidateInitialization() error {
+	if p.networkQuotaLister == nil {
+		return fmt.Errorf("missing network quota lister")
+	}
+	if p.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	return nil
+}
+
+func ipFamilyResourceName(ipFamily corev1.IPFamily) (core.NetworkQuotaResourceName, bool) {
+	switch ipFamily {
+	case corev1.IPv4Protocol:
+		return core.NetworkQuotaResourceIPv4IPs, true
+	case corev1.IPv6Protocol:
+		return core.NetworkQuotaResourceIPv6IPs, true
+	default:
+		return "", false
+	}
+}
+
+// isAllocatedByAPIServer reports whether the request is made by the apiserver itself, which is the case
+// for the IPs allocated for a claimer.
+func isAllocatedByAPIServer(a admission.Attributes) bool {
+	userInfo := a.GetUserInfo()
+	return userInfo != nil && userInfo.GetName() == user.APIServerUser
+}
+
+// addIPFamilies adds the number of IPs of each family the request adds to res.
+func addIPFamilies[T any](res map[core.NetworkQuotaResourceName]int64, ips, oldIPs []T, ipFamily func(T) corev1.IPFamily) {
+	counts := make(map[core.NetworkQuotaResourceName]int64)
+	for _, ip := range ips {
+		if name, ok := ipFamilyResourceName(ipFamily(ip)); ok {
+			counts[name]++
+		}
+	}
+	for _, ip := range oldIPs {
+		if name, ok := ipFamilyResourceName(ipFamily(ip)); ok {
+			counts[name]--
+		}
+	}
+	for name, n := range counts {
+		if n > 0 {
+			res[name] += n
+		}
+	}
+}
+
+// requested returns the number of each resource the request adds to the namespace.
+func requested(a admission.Attributes) map[core.NetworkQuotaResourceName]int64 {
+	res := make(map[core.NetworkQuotaResourceName]int64)
+	isCreate := a.GetOperation() == admission.Create
+
+	switch obj := a.GetObject().(type) {
+	case *core.IP:
+		if obj.Spec.ClaimRef != nil && isAllocatedByAPIServer(a) {
+			// Charged when admitting its claimer.
+			break
+		}
+		if name, ok := ipFamilyResourceName(obj.Spec.IPFamily); ok && isCreate {
+			res[name] = 1
+		}
+	case *core.Network:
+		if isCreate {
+			res[core.NetworkQuotaResourceNetworks] = 1
+		}
+	case *core.LoadBalancer:
+		if isCreate {
+			res[core.NetworkQuotaResourceLoadBalancers] = 1
+		}
+		if obj.Spec.Type == core.LoadBalancerTypePublic {
+			var oldIPs []core.LoadBalancerIP
+			if old, ok := a.GetOldObject().(*core.LoadBalancer); ok && !isCreate && old.Spec.Type == core.LoadBalancerTypePublic {
+				oldIPs = old.Spec.IPs
+			}
+			addIPFamilies(res, obj.Spec.IPs, oldIPs, func(ip core.LoadBalancerIP) corev1.IPFamily { return ip.IPFamily })
+		}
+	case *core.PortForwarding:
+		if name, ok := ipFamilyResourceName(obj.Spec.IPFamily); ok && isCreate {
+			res[name] = 1
+		}
+	case *core.NATGateway:
+		n := int64(len(obj.Spec.IPs))
+		if old, ok := a.GetOldObject().(*core.NATGateway); ok && !isCreate {
+			n -= int64(len(old.Spec.IPs))
+		}
+		if n > 0 {
+			res[core.NetworkQuotaResourceNATGatewayIPs] = n
+		}
+	case *core.NetworkInterface:
+		n := int64(len(obj.Spec.PublicIPs))
+		if old, ok := a.GetOldObject().(*core.NetworkInterface); ok && !isCreate {
+			n -= int64(len(old.Spec.PublicIPs))
+		}
+		if n > 0 {
+			res[core.NetworkQuotaResourceNetworkInterfacePublicIPs] = n
+		}
+	}
+	return res
+}
+
+func (p *networkQuotaPlugin) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	req := requested(a)
+	if len(req) == 0 {
+		return nil
+	}
+
+	if !p.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	namespace := a.GetNamespace()
+	networkQuotas, err := p.networkQuotaLister.NetworkQuotas(namespace).List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("error listing network quotas: %w", err))
+	}
+	slices.SortFunc(networkQuotas, func(a, b *v1alpha1.NetworkQuota) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, networkQuota := range networkQuotas {
+		if !limitsAny(networkQuota, req) {
+			continue
+		}
+
+		if err := p.charge(ctx, a, networkQuota, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func limitsAny(networkQuota *v1alpha1.NetworkQuota, req map[core.NetworkQuotaResourceName]int64) bool {
+	for name := range req {
+		if _, ok := networkQuota.Spec.Hard[v1alpha1.NetworkQuotaResourceName(name)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// charge adds the requested resources to the usage of the network quota. On conflicts, the network quota
+// is retrieved again and the request is checked against its new usage.
+func (p *networkQuotaPlugin) charge(
+	ctx context.Context,
+	a admission.Attributes,
+	networkQuota *v1alpha1.NetworkQuota,
+	req map[core.NetworkQuotaResourceName]int64,
+) error {
+	name := networkQuota.Name
+	networkQuotaClient := p.client.CoreV1alpha1().NetworkQuotas(networkQuota.Namespace)
+	for attempt := 1; ; attempt++ {
+		used, err := checkQuota(networkQuota, req)
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+
+		if a.IsDryRun() {
+			return nil
+		}
+
+		newNetworkQuota := networkQuota.DeepCopy()
+		newNetworkQuota.Status.Used = used
+		_, err = networkQuotaClient.UpdateStatus(ctx, newNetworkQuota, metav1.UpdateOptions{})
+		if err == nil {
+			return nil
+		}
+		if !apierrors.IsConflict(err) || attempt == maxUpdateAttempts {
+			return apierrors.NewInternalError(fmt.Errorf("error updating usage of network quota %s: %w", name, err))
+		}
+
+		networkQuota, err = networkQuotaClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return apierrors.NewInternalError(fmt.Errorf("error getting network quota %s: %w", name, err))
+		}
+	}
+}
+
+// checkQuota returns the usage of the network quota after the request or an error if the request
+// exceeds the network quota.
+func checkQuota(networkQuota *v1alpha1.NetworkQuota, req map[core.NetworkQuotaResourceName]int64) (map[v1alpha1.NetworkQuotaResourceName]int64, error) {
+	names := make([]core.NetworkQuotaResourceName, 0, len(req))
+	for name := range req {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	res := make(map[v1alpha1.NetworkQuotaResourceName]int64, len(networkQuota.Status.Used))
+	for name, used := range networkQuota.Status.Used {
+		res[name] = used
+	}
+
+	for _, name := range names {
+		limit, ok := networkQuota.Spec.Hard[v1alpha1.NetworkQuotaResourceName(name)]
+		if !ok {
+			continue
+		}
+
+		used, ok := networkQuota.Status.Used[v1alpha1.NetworkQuotaResourceName(name)]
+		if !ok {
+			return nil, fmt.Errorf("status unknown for network quota: %s, resource: %s", networkQuota.Name, name)
+		}
+
+		if used+req[name] > limit {
+			return nil, fmt.Errorf("exceeded network quota: %s, requested: %s=%d, used: %s=%d, limited: %s=%d",
+				networkQuota.Name, name, req[name], name, used, name, limit)
+		}
+		res[v1alpha1.NetworkQuotaResourceName(name)] = used + req[name]
+	}
+	return res, nil
+}
@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkquota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions"
+	"github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/fake"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestNetworkQuota(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "NetworkQuota Suite")
+}
+
+var _ = Describe("NetworkQuota", func() {
+	const namespace = "default"
+
+	var (
+		plugin    *networkQuotaPlugin
+		clientset *fake.Clientset
+		factory   informers.SharedInformerFactory
+	)
+
+	BeforeEach(func() {
+		clientset = fake.NewClientset()
+		factory = informers.NewSharedInformerFactory(clientset, 0)
+		plugin = newPlugin()
+		plugin.SetIronCoreNetInformerFactory(factory)
+		plugin.SetIronCoreNetClientSet(clientset)
+		plugin.SetReadyFunc(func() bool { return true })
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+	})
+
+	addQuota := func(hard, used map[v1alpha1.NetworkQuotaResourceName]int64) {
+		networkQuota := &v1alpha1.NetworkQuota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "quota"},
+			Spec:       v1alpha1.NetworkQuotaSpec{Hard: hard},
+			Status:     v1alpha1.NetworkQuotaStatus{Used: used},
+		}
+		Expect(clientset.Tracker().Add(networkQuota)).To(Succeed())
+		Expect(factory.Core().V1alpha1().NetworkQuotas().Informer().GetIndexer().Add(networkQuota)).To(Succeed())
+	}
+
+	getUsed := func() map[v1alpha1.NetworkQuotaResourceName]int64 {
+		networkQuota, err := clientset.CoreV1alpha1().NetworkQuotas(namespace).Get(context.TODO(), "quota", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return networkQuota.Status.Used
+	}
+
+	validateAs := func(userInfo user.Info, obj, oldObj runtime.Object, op admission.Operation) error {
+		resource := core.Resource("ips").WithVersion("")
+		switch obj.(type) {
+		case *core.NATGateway:
+			resource = core.Resource("natgateways").WithVersion("")
+		case *core.LoadBalancer:
+			resource = core.Resource("loadbalancers").WithVersion("")
+		case *core.PortForwarding:
+			resource = core.Resource("portforwardings").WithVersion("")
+		}
+		attrs := admission.NewAttributesRecord(obj, oldObj, core.SchemeGroupVersion.WithKind(""), namespace, "", resource, "", op, nil, false, userInfo)
+		return plugin.Validate(context.TODO(), attrs, nil)
+	}
+
+	validate := func(obj, oldObj runtime.Object, op admission.Operation) error {
+		return validateAs(&user.DefaultInfo{Name: "foo"}, obj, oldObj, op)
+	}
+
+	It("should reject creating IPs exceeding the quota of their family", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+		)
+
+		err := validate(&core.IP{Spec: core.IPSpec{IPFamily: corev1.IPv4Protocol}}, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+		Expect(err.Error()).To(ContainSubstring("exceeded network quota: quota, requested: ips.ipv4=1, used: ips.ipv4=1, limited: ips.ipv4=1"))
+
+		By("allowing IPs of the other family")
+		Expect(validate(&core.IP{Spec: core.IPSpec{IPFamily: corev1.IPv6Protocol}}, nil, admission.Create)).To(Succeed())
+	})
+
+	It("should charge admitted requests to the status of the quota", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 2},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 0},
+		)
+
+		Expect(validate(&core.IP{Spec: core.IPSpec{IPFamily: corev1.IPv4Protocol}}, nil, admission.Create)).To(Succeed())
+		Expect(getUsed()).To(HaveKeyWithValue(v1alpha1.NetworkQuotaResourceIPv4IPs, int64(1)))
+	})
+
+	It("should reject requests while the usage of the quota is unknown", func() {
+		addQuota(map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1}, nil)
+
+		err := validate(&core.IP{Spec: core.IPSpec{IPFamily: corev1.IPv4Protocol}}, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+		Expect(err.Error()).To(ContainSubstring("status unknown for network quota: quota, resource: ips.ipv4"))
+	})
+
+	It("should check the current usage if the status of the quota changed concurrently", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 0},
+		)
+
+		By("using up the quota through another apiserver once the status is updated")
+		clientset.PrependReactor("update", "networkquotas", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "status" {
+				return false, nil, nil
+			}
+			clientset.ReactionChain = clientset.ReactionChain[1:]
+
+			networkQuota, err := clientset.Tracker().Get(v1alpha1.SchemeGroupVersion.WithResource("networkquotas"), namespace, "quota")
+			Expect(err).NotTo(HaveOccurred())
+			current := networkQuota.(*v1alpha1.NetworkQuota).DeepCopy()
+			current.Status.Used[v1alpha1.NetworkQuotaResourceIPv4IPs] = 1
+			Expect(clientset.Tracker().Update(v1alpha1.SchemeGroupVersion.WithResource("networkquotas"), current, namespace)).To(Succeed())
+			return true, nil, apierrors.NewConflict(core.Resource("networkquotas"), "quota", nil)
+		})
+
+		err := validate(&core.IP{Spec: core.IPSpec{IPFamily: corev1.IPv4Protocol}}, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+		Expect(err.Error()).To(ContainSubstring("used: ips.ipv4=1"))
+	})
+
+	It("should only exempt IPs claimed on behalf of the apiserver", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+		)
+		claimedIP := &core.IP{Spec: core.IPSpec{
+			IPFamily: corev1.IPv4Protocol,
+			ClaimRef: &core.IPClaimRef{Resource: "natgateways", Name: "natgw", UID: "natgw-uid"},
+		}}
+
+		By("rejecting a claimed IP created by a user")
+		err := validate(claimedIP, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+
+		By("allowing a claimed IP allocated by the apiserver")
+		Expect(validateAs(&user.DefaultInfo{Name: user.APIServerUser}, claimedIP, nil, admission.Create)).To(Succeed())
+	})
+
+	It("should reject a NAT gateway whose IPs exceed the limit before its IPs are allocated", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceNATGatewayIPs: 2},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceNATGatewayIPs: 0},
+		)
+
+		By("rejecting a NAT gateway with more IPs than allowed")
+		err := validate(&core.NATGateway{Spec: core.NATGatewaySpec{
+			IPFamily: corev1.IPv4Protocol,
+			IPs:      []core.NATGatewayIP{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+		}}, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+		Expect(err.Error()).To(ContainSubstring("exceeded network quota: quota, requested: natgateways.ips=3, used: natgateways.ips=0, limited: natgateways.ips=2"))
+
+		By("allowing a NAT gateway with as many IPs as allowed")
+		Expect(validate(&core.NATGateway{Spec: core.NATGatewaySpec{
+			IPFamily: corev1.IPv4Protocol,
+			IPs:      []core.NATGatewayIP{{Name: "a"}, {Name: "b"}},
+		}}, nil, admission.Create)).To(Succeed())
+		Expect(getUsed()).To(HaveKeyWithValue(v1alpha1.NetworkQuotaResourceNATGatewayIPs, int64(2)))
+	})
+
+	It("should charge the IPs of public load balancers to the quota of their family", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{
+				v1alpha1.NetworkQuotaResourceIPv4IPs:       1,
+				v1alpha1.NetworkQuotaResourceLoadBalancers: 2,
+			},
+			map[v1alpha1.NetworkQuotaResourceName]int64{
+				v1alpha1.NetworkQuotaResourceIPv4IPs:       0,
+				v1alpha1.NetworkQuotaResourceLoadBalancers: 0,
+			},
+		)
+
+		By("rejecting a public load balancer with more IPs than allowed")
+		err := validate(&core.LoadBalancer{Spec: core.LoadBalancerSpec{
+			Type: core.LoadBalancerTypePublic,
+			IPs:  []core.LoadBalancerIP{{Name: "a", IPFamily: corev1.IPv4Protocol}, {Name: "b", IPFamily: corev1.IPv4Protocol}},
+		}}, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+		Expect(err.Error()).To(ContainSubstring("requested: ips.ipv4=2"))
+
+		By("allowing an internal load balancer with the same IPs")
+		Expect(validate(&core.LoadBalancer{Spec: core.LoadBalancerSpec{
+			Type: core.LoadBalancerTypeInternal,
+			IPs:  []core.LoadBalancerIP{{Name: "a", IPFamily: corev1.IPv4Protocol}, {Name: "b", IPFamily: corev1.IPv4Protocol}},
+		}}, nil, admission.Create)).To(Succeed())
+		Expect(getUsed()).To(Equal(map[v1alpha1.NetworkQuotaResourceName]int64{
+			v1alpha1.NetworkQuotaResourceIPv4IPs:       0,
+			v1alpha1.NetworkQuotaResourceLoadBalancers: 1,
+		}))
+	})
+
+	It("should charge the IPs of port forwardings to the quota of their family", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceIPv4IPs: 1},
+		)
+
+		err := validate(&core.PortForwarding{Spec: core.PortForwardingSpec{IPFamily: corev1.IPv4Protocol}}, nil, admission.Create)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+	})
+
+	It("should allow any creation without a quota", func() {
+		Expect(validate(&core.IP{Spec: core.IPSpec{IPFamily: corev1.IPv4Protocol}}, nil, admission.Create)).To(Succeed())
+	})
+
+	It("should only check increases of NAT gateway IPs on update", func() {
+		addQuota(
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceNATGatewayIPs: 2},
+			map[v1alpha1.NetworkQuotaResourceName]int64{v1alpha1.NetworkQuotaResourceNATGatewayIPs: 2},
+		)
+
+		oldNATGateway := &core.NATGateway{Spec: core.NATGatewaySpec{IPs: []core.NATGatewayIP{{Name: "a"}, {Name: "b"}}}}
+
+		By("allowing an update not adding IPs")
+		Expect(validate(oldNATGateway.DeepCopy(), oldNATGateway, admission.Update)).To(Succeed())
+
+		By("rejecting an update adding an IP")
+		newNATGateway := oldNATGateway.DeepCopy()
+		newNATGateway.Spec.IPs = append(newNATGateway.Spec.IPs, core.NATGatewayIP{Name: "c"})
+		err := validate(newNATGateway, oldNATGateway, admission.Update)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+	})
+})
@@ -21,6 +21,14 @@ type IPSpec struct {
 	IPFamily corev1.IPFamily
 	IP       net.IP
 	ClaimRef *IPClaimRef
+
+	// PoolRef references the IP pool to allocate the IP from.
+	// Mutually exclusive with PoolSelector.
+	PoolRef *corev1.LocalObjectReference
+	// PoolSelector selects the IP pools to allocate the IP from.
+	// Mutually exclusive with PoolRef. If neither is set, the IP is allocated
+	// from any pool of matching type and IP family.
+	PoolSelector *metav1.LabelSelector
 }
 
 type IPClaimRef struct {
@@ -20,6 +20,9 @@ type IPAddressClaimRef struct {
 	Namespace string
 	Name      string
 	UID       types.UID
+	// Pool is the name of the IP pool the address was allocated from.
+	// Empty for addresses of statically configured prefixes.
+	Pool string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type IPAddressHistorySpec struct {
+	// IP is the address the history is about.
+	IP net.IP
+
+	// Leases are the leases of the address, oldest first.
+	// Leases are only ever appended and released. Leases released longer than the
+	// retention period ago are pruned.
+	Leases []IPAddressLease
+}
+
+// IPAddressLease is a period an address was allocated to a claimer.
+type IPAddressLease struct {
+	// ClaimRef references the claimer the address was allocated to.
+	ClaimRef IPAddressClaimRef
+	// AllocatedTime is the time the address was allocated.
+	AllocatedTime metav1.Time
+	// ReleasedTime is the time the address was released. Unset while the address is allocated.
+	ReleasedTime *metav1.Time
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+
+// IPAddressHistory is the schema for the ipaddresshistories API.
+type IPAddressHistory struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec IPAddressHistorySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPAddressHistoryList contains a list of IPAddressHistory.
+type IPAddressHistoryList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []IPAddressHistory
+}
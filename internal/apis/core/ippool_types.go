@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type IPPoolSpec struct {
+	// Type is the type of IPs allocated from the pool.
+	Type IPType
+	// IPFamily is the IP family of the pool.
+	IPFamily corev1.IPFamily
+	// Prefixes are the prefixes IPs are allocated from.
+	// Prefixes can be added at runtime to grow the pool.
+	Prefixes []net.IPPrefix
+	// Excluded are ranges inside the prefixes that are never allocated,
+	// e.g. gateway, broadcast or infrastructure addresses.
+	Excluded []net.IPPrefix
+	// Reserved are ranges inside the prefixes that are never allocated dynamically.
+	// Addresses of reserved ranges can only be requested explicitly by users
+	// allowed to 'use' the pool.
+	Reserved []net.IPPrefix
+	// AllocationStrategy is the strategy dynamic IPs are allocated from the pool with.
+	// If unset, the default strategy of the apiserver is used.
+	AllocationStrategy AllocationStrategy
+	// Namespace makes the pool a bring-your-own-IP pool of the namespace:
+	// Only IPs of the namespace can be allocated from it, and only if they select
+	// the pool by pool ref or pool selector. If unset, IPs of all namespaces can be
+	// allocated from the pool.
+	Namespace string
+}
+
+// AllocationStrategy determines which free ID of a range is allocated next.
+type AllocationStrategy string
+
+const (
+	// AllocationStrategyRandom allocates a random free ID.
+	AllocationStrategyRandom AllocationStrategy = "Random"
+	// AllocationStrategyLowestFree allocates the lowest free ID.
+	AllocationStrategyLowestFree AllocationStrategy = "LowestFree"
+	// AllocationStrategySequentialAfterLast allocates the next free ID after the last allocated one.
+	// The last allocated ID is tracked in memory per apiserver replica.
+	AllocationStrategySequentialAfterLast AllocationStrategy = "SequentialAfterLast"
+	// AllocationStrategyHashed allocates the next free ID after one derived from a hash of the claimer.
+	AllocationStrategyHashed AllocationStrategy = "Hashed"
+)
+
+type IPPoolStatus struct {
+	// Prefixes reports the utilization of each prefix of the pool.
+	Prefixes []IPPoolPrefixStatus
+}
+
+// IPPoolPrefixStatus is the utilization of a prefix of an IP pool.
+type IPPoolPrefixStatus struct {
+	// Prefix is the prefix the utilization is reported for.
+	Prefix net.IPPrefix
+	// Total is the number of IPs in the prefix.
+	// For very large prefixes, the number is capped at the maximum int64 value.
+	Total int64
+	// Used is the number of allocated IPs in the prefix.
+	Used int64
+	// Free is the number of IPs in the prefix that are not allocated.
+	Free int64
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+
+// IPPool is the schema for the ippools API.
+type IPPool struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   IPPoolSpec
+	Status IPPoolStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPPoolList contains a list of IPPool.
+type IPPoolList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []IPPool
+}
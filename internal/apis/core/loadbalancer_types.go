@@ -4,6 +4,8 @@
 package core
 
 import (
+	"slices"
+
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,6 +25,17 @@ type LoadBalancerSpec struct {
 	// NetworkRef references the network the load balancer is part of.
 	NetworkRef corev1.LocalObjectReference
 
+	// IPFamilyPolicy specifies whether the load balancer is single- or dual-stack.
+	// If unspecified, defaults to SingleStack for a single IP family and to RequireDualStack otherwise.
+	// +optional
+	IPFamilyPolicy *corev1.IPFamilyPolicy
+
+	// IPFamilies are the IP families of the load balancer. The first family is the primary family.
+	// If unspecified, defaults to the IP families of IPs. An IP is allocated for every family
+	// that is not covered by IPs.
+	// +optional
+	IPFamilies []corev1.IPFamily
+
 	// IPs specifies the IPs of the load balancer.
 	// +optional
 	// +patchMergeKey=name
@@ -92,6 +105,21 @@ func LoadBalancerDaemonSetName(lbName string) string {
 	return "lb-" + lbName
 }
 
+// GetLoadBalancerIPFamilies returns the distinct IP families of the IPs of the load balancer in order of occurrence.
+func GetLoadBalancerIPFamilies(loadBalancer *LoadBalancer) []corev1.IPFamily {
+	var res []corev1.IPFamily
+	for _, ip := range loadBalancer.Spec.IPs {
+		ipFamily := ip.IPFamily
+		if ipFamily == "" && ip.IP.IsValid() {
+			ipFamily = ip.IP.Family()
+		}
+		if ipFamily != "" && !slices.Contains(res, ipFamily) {
+			res = append(res, ipFamily)
+		}
+	}
+	return res
+}
+
 func GetLoadBalancerIPs(loadBalancer *LoadBalancer) []net.IP {
 	res := make([]net.IP, len(loadBalancer.Spec.IPs))
 	for i, ip := range loadBalancer.Spec.IPs {
@@ -10,12 +10,31 @@ import (
 )
 
 type NATGatewaySpec struct {
-	// IPFamily is the IP family of the NAT gateway.
+	// IPFamily is the primary IP family of the NAT gateway.
+	// If unspecified, defaults to the first of IPFamilies.
+	// +optional
 	IPFamily corev1.IPFamily
 
+	// IPFamilies are the IP families the NAT gateway serves. Every IP family is NATed
+	// using the IPs of that family. If unspecified, defaults to IPFamily.
+	// +optional
+	// +listType=atomic
+	IPFamilies []corev1.IPFamily
+
 	// NetworkRef references the network the NAT gateway is part of.
 	NetworkRef corev1.LocalObjectReference
 
+	// NetworkInterfaceSelector selects the network interfaces of the network the NAT gateway NATs.
+	// If unset, all network interfaces of the network are selected.
+	// +optional
+	NetworkInterfaceSelector *metav1.LabelSelector
+
+	// Priority resolves conflicts between NAT gateways of the same network selecting the same network
+	// interface. The network interface is NATed by the NAT gateway with the highest priority. On equal
+	// priority, the oldest NAT gateway, then the NAT gateway with the lowest name wins.
+	// +optional
+	Priority int32
+
 	// IPs specifies the IPs of the NAT gateway.
 	// +optional
 	// +patchMergeKey=name
@@ -24,13 +43,74 @@ type NATGatewaySpec struct {
 
 	// PortsPerNetworkInterface specifies how many ports to allocate per network interface.
 	PortsPerNetworkInterface int32
+
+	// PortsPerNetworkInterfaceOverrides override PortsPerNetworkInterface for the network interfaces
+	// selected by them. The first matching override applies.
+	// +optional
+	// +listType=atomic
+	PortsPerNetworkInterfaceOverrides []NATGatewayPortsOverride
+
+	// PortBlockExpansion configures granting additional port blocks to network interfaces
+	// reporting NAT port pressure. If unset, no additional port blocks are granted.
+	// +optional
+	PortBlockExpansion *NATGatewayPortBlockExpansion
+
+	// IPDrainPeriod is the period a draining IP is retained after all network interfaces
+	// have been migrated off it, letting existing flows finish. Defaults to 2 minutes.
+	// +optional
+	IPDrainPeriod *metav1.Duration
+
+	// NAT64 makes the NAT gateway translate IPv6-only network interfaces to its IPv4 IPs.
+	// A NAT64 NAT gateway has to serve IPv4 only.
+	NAT64 *NATGatewayNAT64
+
+	// StickyReservations makes the NAT gateway remember the port range of a network interface and restore it
+	// when the network interface is recreated. A network interface is identified by the value of its
+	// NATReservationKeyLabel or, if unset, by its UID. If unset, port ranges are not remembered.
+	StickyReservations *NATGatewayStickyReservations
+}
+
+type NATGatewayPortBlockExpansion struct {
+	// MaxExtraPortBlocks is the maximum number of additional port blocks of PortsPerNetworkInterface
+	// ports granted to a network interface.
+	MaxExtraPortBlocks int32
+	// CoolDownPeriod is the period a network interface has to be free of NAT port pressure
+	// before its additional port blocks are reclaimed. Defaults to 5 minutes.
+	// +optional
+	CoolDownPeriod *metav1.Duration
+}
+
+type NATGatewayPortsOverride struct {
+	// NetworkInterfaceSelector selects the network interfaces the override applies to.
+	NetworkInterfaceSelector *metav1.LabelSelector
+	// PortsPerNetworkInterface specifies how many ports to allocate per selected network interface.
+	// Has to be a multiple of the PortsPerNetworkInterface of the NAT gateway.
+	PortsPerNetworkInterface int32
+}
+
+type NATGatewayNAT64 struct {
+	// Prefix is the IPv6 prefix IPv4 destinations are embedded in. Only the well-known prefix
+	// 64:ff9b::/96 the data plane translates is supported. Defaults to 64:ff9b::/96.
+	Prefix net.IPPrefix
+}
+
+type NATGatewayStickyReservations struct {
+	// RetentionPeriod is the period the port range of a network interface stays reserved after
+	// the network interface is gone. Defaults to 24 hours.
+	RetentionPeriod *metav1.Duration
 }
 
 type NATGatewayIP struct {
 	// Name is the semantic name of the NAT gateway IP.
 	Name string
+	// IPFamily is the IP family of the IP. Has to match IP if specified. If unspecified,
+	// defaults to the IP family of IP or to the primary IP family of the NAT gateway.
+	IPFamily corev1.IPFamily
 	// IP specifies a specific IP to allocate. If empty, a random IP will be allocated if possible.
 	IP net.IP
+	// Draining marks the IP for removal. Network interfaces are migrated off a draining IP
+	// and no new port ranges are allocated on it.
+	Draining bool
 }
 
 type NATGatewayStatus struct {
@@ -38,6 +118,75 @@ type NATGatewayStatus struct {
 	UsedNATIPs int64
 	// RequestedNATIPs is the number of requested NAT IPs.
 	RequestedNATIPs int64
+	// IPFamilies are the used and requested NAT IPs per IP family.
+	IPFamilies []NATGatewayIPFamilyStatus
+	// DrainingIPs report the draining state of the draining IPs.
+	DrainingIPs []NATGatewayDrainingIPStatus
+	// IPs report the port range usage per NAT gateway IP.
+	IPs []NATGatewayIPStatus
+	// Reservations are the port ranges reserved for network interfaces if StickyReservations is set.
+	Reservations []NATGatewayReservation
+	// Conditions are the conditions of the NAT gateway.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition
+}
+
+// NATGatewayNAT64WellKnownPrefix is the well-known NAT64 prefix (RFC 6052).
+const NATGatewayNAT64WellKnownPrefix = "64:ff9b::/96"
+
+const (
+	// NATGatewayFull reports whether a network interface could not be assigned a port range
+	// because all port ranges of the IPs of an IP family are in use.
+	NATGatewayFull = "Full"
+)
+
+type NATGatewayDrainingIPStatus struct {
+	// Name is the name of the draining NAT gateway IP.
+	Name string
+	// MigratedTime is the time all network interfaces had been migrated off the IP.
+	MigratedTime *metav1.Time
+	// Drained reports whether the drain period of the IP elapsed and the IP can be removed.
+	Drained bool
+}
+
+type NATGatewayIPFamilyStatus struct {
+	// IPFamily is the IP family of the status.
+	IPFamily corev1.IPFamily
+	// UsedNATIPs is the number of NAT IPs of the IP family in-use.
+	UsedNATIPs int64
+	// RequestedNATIPs is the number of requested NAT IPs of the IP family.
+	RequestedNATIPs int64
+}
+
+type NATGatewayReservation struct {
+	// Key identifies the network interface the port range is reserved for.
+	Key string
+	// IP is the NAT gateway IP of the port range.
+	IP net.IP
+	// Port is the first port of the port range.
+	Port int32
+	// EndPort is the last port of the port range.
+	EndPort int32
+	// ReleasedTime is the time the network interface of the reservation has been gone since.
+	// Unset while the port range is allocated for the network interface.
+	ReleasedTime *metav1.Time
+}
+
+type NATGatewayIPStatus struct {
+	// Name is the name of the NAT gateway IP.
+	Name string
+	// IP is the IP of the NAT gateway IP.
+	IP net.IP
+	// TotalSlots is the total number of port ranges of PortsPerNetworkInterface ports of the IP.
+	TotalSlots int32
+	// UsedSlots is the number of used port ranges of the IP.
+	UsedSlots int32
+	// NetworkInterfaces is the number of network interfaces NATed using the IP.
+	NetworkInterfaces int32
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -12,15 +12,93 @@ type NATGatewayAutoscalerSpec struct {
 	// NATGatewayRef points to the target NATGateway to scale.
 	NATGatewayRef corev1.LocalObjectReference
 
-	// MinPublicIPs is the minimum number of public IPs to allocate for a NAT Gateway.
+	// MinPublicIPs is the minimum number of public IPs to allocate per IP family of a NAT Gateway.
 	MinPublicIPs *int32
-	// MaxPublicIPs is the maximum number of public IPs to allocate for a NAT Gateway.
+	// MaxPublicIPs is the maximum number of public IPs to allocate per IP family of a NAT Gateway.
 	MaxPublicIPs *int32
+
+	// TargetUtilizationPercentage is the targeted percentage of used port ranges of the IPs
+	// of an IP family. Defaults to 100.
+	// +optional
+	TargetUtilizationPercentage *int32
+	// SpareSlots is the number of port ranges of PortsPerNetworkInterface ports kept available
+	// per IP family in addition to the requested ones.
+	// +optional
+	SpareSlots *int32
+
+	// Behavior configures the scaling behavior of the autoscaler. If unset, the number of IPs
+	// is changed without stabilization and rate limits.
+	// +optional
+	Behavior *NATGatewayAutoscalerBehavior
+}
+
+type NATGatewayAutoscalerBehavior struct {
+	// ScaleUp are the scaling rules for adding IPs.
+	// +optional
+	ScaleUp *NATGatewayAutoscalerScalingRules
+	// ScaleDown are the scaling rules for removing IPs.
+	// +optional
+	ScaleDown *NATGatewayAutoscalerScalingRules
+}
+
+type NATGatewayAutoscalerScalingRules struct {
+	// StabilizationWindow is the period past recommendations are considered for. When scaling up,
+	// the lowest recommendation of the window is used, when scaling down, the highest one.
+	// +optional
+	StabilizationWindow *metav1.Duration
+	// MaxIPsPerStep is the maximum number of IPs added or removed per IP family in a single
+	// scaling step. If unset, the number of IPs per step is not limited.
+	// +optional
+	MaxIPsPerStep *int32
+	// StepPeriod is the minimum period between two scaling steps. If unset, scaling steps
+	// are not delayed.
+	// +optional
+	StepPeriod *metav1.Duration
 }
 
 type NATGatewayAutoscalerStatus struct {
+	// CurrentIPs is the number of IPs of the NAT gateway not being drained.
+	CurrentIPs int32
+	// DesiredIPs is the number of IPs the autoscaler desires for the NAT gateway.
+	DesiredIPs int32
+	// LastScaleTime is the last time the autoscaler changed the number of IPs of any IP family.
+	LastScaleTime *metav1.Time
+	// IPFamilies are the scale times and recommendations per IP family.
+	IPFamilies []NATGatewayAutoscalerIPFamilyStatus
+	// Conditions are the conditions of the autoscaler.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition
 }
 
+type NATGatewayAutoscalerIPFamilyStatus struct {
+	// IPFamily is the IP family of the status.
+	IPFamily corev1.IPFamily
+	// LastScaleTime is the last time the autoscaler changed the number of IPs of the IP family.
+	LastScaleTime *metav1.Time
+	// Recommendations are the latest times each number of IPs was recommended
+	// within the stabilization windows.
+	Recommendations []NATGatewayAutoscalerRecommendation
+}
+
+type NATGatewayAutoscalerRecommendation struct {
+	// IPs is the recommended number of IPs.
+	IPs int32
+	// Time is the latest time the number of IPs was recommended.
+	Time metav1.Time
+}
+
+const (
+	// NATGatewayAutoscalerScalingActive reports whether the autoscaler is able to scale its NAT gateway.
+	NATGatewayAutoscalerScalingActive = "ScalingActive"
+	// NATGatewayAutoscalerScalingLimited reports whether the desired number of IPs is limited
+	// by the minimum / maximum number of IPs or by the scaling rules.
+	NATGatewayAutoscalerScalingLimited = "ScalingLimited"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +genclient
 
@@ -14,6 +14,8 @@ import (
 // +genclient
 
 // NATTable is the schema for the nattables API.
+// The sections of a NATGateway are split into one NATTable slice per node, each labeled with
+// NATGatewayNameLabel and the TopologyPartitionLabel of its node.
 type NATTable struct {
 	metav1.TypeMeta
 	metav1.ObjectMeta
@@ -11,6 +11,9 @@ import (
 type NetworkSpec struct {
 	// ID is the ID of the network.
 	ID string
+	// Prefixes are the internal address space of the network.
+	// IPs of internal load balancers that don't specify an IP are allocated from them.
+	Prefixes []net.IPPrefix
 	// Peerings are the network peerings with this network
 	Peerings []NetworkPeering
 }
@@ -101,6 +101,20 @@ type NetworkInterfaceStatus struct {
 	PublicIPs []net.IP
 	// NATIPs are the NAT IPs of the network interface.
 	NATIPs []net.IP
+	// NATs are the NAT statuses of the network interface per IP family.
+	NATs []NetworkInterfaceNATStatus
+}
+
+// NetworkInterfaceNATStatus is the NAT status of a network interface for an IP family.
+type NetworkInterfaceNATStatus struct {
+	// IPFamily is the IP family of the NAT.
+	IPFamily corev1.IPFamily
+	// Ports is the number of NAT ports programmed for the network interface.
+	Ports int32
+	// PortPressure reports whether the network interface exhausts its NAT ports.
+	PortPressure bool
+	// LastTransitionTime is the last time Ports or PortPressure changed.
+	LastTransitionTime *metav1.Time
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
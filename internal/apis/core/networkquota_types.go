@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkQuotaResourceName is the name of a resource limited by a network quota.
+type NetworkQuotaResourceName string
+
+const (
+	// NetworkQuotaResourceIPv4IPs is the number of IPv4 IPs.
+	NetworkQuotaResourceIPv4IPs NetworkQuotaResourceName = "ips.ipv4"
+	// NetworkQuotaResourceIPv6IPs is the number of IPv6 IPs.
+	NetworkQuotaResourceIPv6IPs NetworkQuotaResourceName = "ips.ipv6"
+	// NetworkQuotaResourceNetworks is the number of networks and thus of the VNIs allocated for them.
+	NetworkQuotaResourceNetworks NetworkQuotaResourceName = "networks"
+	// NetworkQuotaResourceLoadBalancers is the number of load balancers.
+	NetworkQuotaResourceLoadBalancers NetworkQuotaResourceName = "loadbalancers"
+	// NetworkQuotaResourceNATGatewayIPs is the total number of IPs of all NAT gateways.
+	NetworkQuotaResourceNATGatewayIPs NetworkQuotaResourceName = "natgateways.ips"
+	// NetworkQuotaResourceNetworkInterfacePublicIPs is the total number of public IPs of all network interfaces.
+	NetworkQuotaResourceNetworkInterfacePublicIPs NetworkQuotaResourceName = "networkinterfaces.publicips"
+)
+
+type NetworkQuotaSpec struct {
+	// Hard is the maximum number of each resource allowed in the namespace.
+	// Resources not listed are not limited.
+	Hard map[NetworkQuotaResourceName]int64
+}
+
+type NetworkQuotaStatus struct {
+	// Used is the number of each limited resource used in the namespace.
+	// It is increased by the apiserver when admitting objects and recomputed by the controller manager.
+	Used map[NetworkQuotaResourceName]int64
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+
+// NetworkQuota is the schema for the networkquotas API.
+type NetworkQuota struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   NetworkQuotaSpec
+	Status NetworkQuotaStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkQuotaList contains a list of NetworkQuota.
+type NetworkQuotaList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []NetworkQuota
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PortForwardingSpec struct {
+	// IPFamily is the IP family of the port forwarding.
+	IPFamily corev1.IPFamily
+
+	// IP specifies the public IP to forward from. If empty, a random IP will be allocated if possible.
+	IP net.IP
+
+	// NetworkInterfaceRef references the network interface to forward to.
+	NetworkInterfaceRef corev1.LocalObjectReference
+
+	// TargetIP is the IP of the network interface to forward to.
+	TargetIP net.IP
+
+	// Protocol is the protocol to forward.
+	// If not specified, defaults to TCP.
+	Protocol *corev1.Protocol
+
+	// Port is the public port to forward.
+	Port int32
+
+	// EndPort marks the end of the public port range to forward.
+	// If unspecified, only a single port, Port, will be forwarded.
+	// The range must not span more than 1024 ports.
+	EndPort *int32
+
+	// TargetPort is the port of the network interface Port is forwarded to.
+	// Ports cannot be translated, so if specified, it must equal Port.
+	TargetPort *int32
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+
+// PortForwarding is the schema for the portforwardings API.
+type PortForwarding struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec PortForwardingSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PortForwardingList contains a list of PortForwarding.
+type PortForwardingList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []PortForwarding
+}
+
+// GetPortForwardingEndPort returns the last public port forwarded by the port forwarding.
+func GetPortForwardingEndPort(portForwarding *PortForwarding) int32 {
+	if endPort := portForwarding.Spec.EndPort; endPort != nil {
+		return *endPort
+	}
+	return portForwarding.Spec.Port
+}
+
+// GetPortForwardingTargetPort returns the port of the network interface the port forwarding's Port is forwarded to.
+func GetPortForwardingTargetPort(portForwarding *PortForwarding) int32 {
+	if targetPort := portForwarding.Spec.TargetPort; targetPort != nil {
+		return *targetPort
+	}
+	return portForwarding.Spec.Port
+}
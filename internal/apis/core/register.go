@@ -24,6 +24,10 @@ var (
 	AddToScheme = SchemeBuilder.AddToScheme
 )
 
+func Kind(kind string) schema.GroupKind {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
 func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
@@ -38,6 +42,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&IPList{},
 		&IPAddress{},
 		&IPAddressList{},
+		&IPAddressHistory{},
+		&IPAddressHistoryList{},
+		&IPPool{},
+		&IPPoolList{},
 		&LoadBalancer{},
 		&LoadBalancerList{},
 		&LoadBalancerRouting{},
@@ -52,14 +60,20 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkList{},
 		&NetworkID{},
 		&NetworkIDList{},
+		&NetworkIPAddress{},
+		&NetworkIPAddressList{},
 		&NetworkInterface{},
 		&NetworkInterfaceList{},
 		&NetworkPolicy{},
 		&NetworkPolicyList{},
 		&NetworkPolicyRule{},
 		&NetworkPolicyRuleList{},
+		&NetworkQuota{},
+		&NetworkQuotaList{},
 		&Node{},
 		&NodeList{},
+		&PortForwarding{},
+		&PortForwardingList{},
 	)
 	return nil
 }
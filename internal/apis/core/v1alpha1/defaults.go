@@ -5,6 +5,7 @@ package v1alpha1
 
 import (
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,3 +30,15 @@ func SetDefaults_LoadBalancerIP(ip *v1alpha1.LoadBalancerIP) {
 		ip.IPFamily = ip.IP.Family()
 	}
 }
+
+func SetDefaults_NATGatewayNAT64(nat64 *v1alpha1.NATGatewayNAT64) {
+	if !nat64.Prefix.IsValid() {
+		nat64.Prefix = net.MustParseIPPrefix(v1alpha1.NATGatewayNAT64WellKnownPrefix)
+	}
+}
+
+func SetDefaults_LoadBalancerDestination(dst *v1alpha1.LoadBalancerDestination) {
+	if dst.State == "" {
+		dst.State = v1alpha1.LoadBalancerDestinationStateActive
+	}
+}
@@ -108,6 +108,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPAddressHistory)(nil), (*core.IPAddressHistory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressHistory_To_core_IPAddressHistory(a.(*corev1alpha1.IPAddressHistory), b.(*core.IPAddressHistory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPAddressHistory)(nil), (*corev1alpha1.IPAddressHistory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPAddressHistory_To_v1alpha1_IPAddressHistory(a.(*core.IPAddressHistory), b.(*corev1alpha1.IPAddressHistory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPAddressHistoryList)(nil), (*core.IPAddressHistoryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressHistoryList_To_core_IPAddressHistoryList(a.(*corev1alpha1.IPAddressHistoryList), b.(*core.IPAddressHistoryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPAddressHistoryList)(nil), (*corev1alpha1.IPAddressHistoryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPAddressHistoryList_To_v1alpha1_IPAddressHistoryList(a.(*core.IPAddressHistoryList), b.(*corev1alpha1.IPAddressHistoryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPAddressHistorySpec)(nil), (*core.IPAddressHistorySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressHistorySpec_To_core_IPAddressHistorySpec(a.(*corev1alpha1.IPAddressHistorySpec), b.(*core.IPAddressHistorySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPAddressHistorySpec)(nil), (*corev1alpha1.IPAddressHistorySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPAddressHistorySpec_To_v1alpha1_IPAddressHistorySpec(a.(*core.IPAddressHistorySpec), b.(*corev1alpha1.IPAddressHistorySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPAddressLease)(nil), (*core.IPAddressLease)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressLease_To_core_IPAddressLease(a.(*corev1alpha1.IPAddressLease), b.(*core.IPAddressLease), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPAddressLease)(nil), (*corev1alpha1.IPAddressLease)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPAddressLease_To_v1alpha1_IPAddressLease(a.(*core.IPAddressLease), b.(*corev1alpha1.IPAddressLease), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPAddressList)(nil), (*core.IPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IPAddressList_To_core_IPAddressList(a.(*corev1alpha1.IPAddressList), b.(*core.IPAddressList), scope)
 	}); err != nil {
@@ -158,6 +198,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPPool)(nil), (*core.IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPool_To_core_IPPool(a.(*corev1alpha1.IPPool), b.(*core.IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPPool)(nil), (*corev1alpha1.IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPPool_To_v1alpha1_IPPool(a.(*core.IPPool), b.(*corev1alpha1.IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPPoolList)(nil), (*core.IPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolList_To_core_IPPoolList(a.(*corev1alpha1.IPPoolList), b.(*core.IPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPPoolList)(nil), (*corev1alpha1.IPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPPoolList_To_v1alpha1_IPPoolList(a.(*core.IPPoolList), b.(*corev1alpha1.IPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPPoolPrefixStatus)(nil), (*core.IPPoolPrefixStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolPrefixStatus_To_core_IPPoolPrefixStatus(a.(*corev1alpha1.IPPoolPrefixStatus), b.(*core.IPPoolPrefixStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPPoolPrefixStatus)(nil), (*corev1alpha1.IPPoolPrefixStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPPoolPrefixStatus_To_v1alpha1_IPPoolPrefixStatus(a.(*core.IPPoolPrefixStatus), b.(*corev1alpha1.IPPoolPrefixStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPPoolSpec)(nil), (*core.IPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolSpec_To_core_IPPoolSpec(a.(*corev1alpha1.IPPoolSpec), b.(*core.IPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPPoolSpec)(nil), (*corev1alpha1.IPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPPoolSpec_To_v1alpha1_IPPoolSpec(a.(*core.IPPoolSpec), b.(*corev1alpha1.IPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPPoolStatus)(nil), (*core.IPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolStatus_To_core_IPPoolStatus(a.(*corev1alpha1.IPPoolStatus), b.(*core.IPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IPPoolStatus)(nil), (*corev1alpha1.IPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IPPoolStatus_To_v1alpha1_IPPoolStatus(a.(*core.IPPoolStatus), b.(*corev1alpha1.IPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.IPSpec)(nil), (*core.IPSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IPSpec_To_core_IPSpec(a.(*corev1alpha1.IPSpec), b.(*core.IPSpec), scope)
 	}); err != nil {
@@ -378,6 +468,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayAutoscalerBehavior)(nil), (*core.NATGatewayAutoscalerBehavior)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayAutoscalerBehavior_To_core_NATGatewayAutoscalerBehavior(a.(*corev1alpha1.NATGatewayAutoscalerBehavior), b.(*core.NATGatewayAutoscalerBehavior), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayAutoscalerBehavior)(nil), (*corev1alpha1.NATGatewayAutoscalerBehavior)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayAutoscalerBehavior_To_v1alpha1_NATGatewayAutoscalerBehavior(a.(*core.NATGatewayAutoscalerBehavior), b.(*corev1alpha1.NATGatewayAutoscalerBehavior), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayAutoscalerIPFamilyStatus)(nil), (*core.NATGatewayAutoscalerIPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayAutoscalerIPFamilyStatus_To_core_NATGatewayAutoscalerIPFamilyStatus(a.(*corev1alpha1.NATGatewayAutoscalerIPFamilyStatus), b.(*core.NATGatewayAutoscalerIPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayAutoscalerIPFamilyStatus)(nil), (*corev1alpha1.NATGatewayAutoscalerIPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayAutoscalerIPFamilyStatus_To_v1alpha1_NATGatewayAutoscalerIPFamilyStatus(a.(*core.NATGatewayAutoscalerIPFamilyStatus), b.(*corev1alpha1.NATGatewayAutoscalerIPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayAutoscalerList)(nil), (*core.NATGatewayAutoscalerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(a.(*corev1alpha1.NATGatewayAutoscalerList), b.(*core.NATGatewayAutoscalerList), scope)
 	}); err != nil {
@@ -388,6 +498,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayAutoscalerRecommendation)(nil), (*core.NATGatewayAutoscalerRecommendation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayAutoscalerRecommendation_To_core_NATGatewayAutoscalerRecommendation(a.(*corev1alpha1.NATGatewayAutoscalerRecommendation), b.(*core.NATGatewayAutoscalerRecommendation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayAutoscalerRecommendation)(nil), (*corev1alpha1.NATGatewayAutoscalerRecommendation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayAutoscalerRecommendation_To_v1alpha1_NATGatewayAutoscalerRecommendation(a.(*core.NATGatewayAutoscalerRecommendation), b.(*corev1alpha1.NATGatewayAutoscalerRecommendation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayAutoscalerScalingRules)(nil), (*core.NATGatewayAutoscalerScalingRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayAutoscalerScalingRules_To_core_NATGatewayAutoscalerScalingRules(a.(*corev1alpha1.NATGatewayAutoscalerScalingRules), b.(*core.NATGatewayAutoscalerScalingRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayAutoscalerScalingRules)(nil), (*corev1alpha1.NATGatewayAutoscalerScalingRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayAutoscalerScalingRules_To_v1alpha1_NATGatewayAutoscalerScalingRules(a.(*core.NATGatewayAutoscalerScalingRules), b.(*corev1alpha1.NATGatewayAutoscalerScalingRules), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayAutoscalerSpec)(nil), (*core.NATGatewayAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(a.(*corev1alpha1.NATGatewayAutoscalerSpec), b.(*core.NATGatewayAutoscalerSpec), scope)
 	}); err != nil {
@@ -408,6 +538,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayDrainingIPStatus)(nil), (*core.NATGatewayDrainingIPStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayDrainingIPStatus_To_core_NATGatewayDrainingIPStatus(a.(*corev1alpha1.NATGatewayDrainingIPStatus), b.(*core.NATGatewayDrainingIPStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayDrainingIPStatus)(nil), (*corev1alpha1.NATGatewayDrainingIPStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayDrainingIPStatus_To_v1alpha1_NATGatewayDrainingIPStatus(a.(*core.NATGatewayDrainingIPStatus), b.(*corev1alpha1.NATGatewayDrainingIPStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayIP)(nil), (*core.NATGatewayIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(a.(*corev1alpha1.NATGatewayIP), b.(*core.NATGatewayIP), scope)
 	}); err != nil {
@@ -418,6 +558,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayIPFamilyStatus)(nil), (*core.NATGatewayIPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayIPFamilyStatus_To_core_NATGatewayIPFamilyStatus(a.(*corev1alpha1.NATGatewayIPFamilyStatus), b.(*core.NATGatewayIPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayIPFamilyStatus)(nil), (*corev1alpha1.NATGatewayIPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayIPFamilyStatus_To_v1alpha1_NATGatewayIPFamilyStatus(a.(*core.NATGatewayIPFamilyStatus), b.(*corev1alpha1.NATGatewayIPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayIPStatus)(nil), (*core.NATGatewayIPStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayIPStatus_To_core_NATGatewayIPStatus(a.(*corev1alpha1.NATGatewayIPStatus), b.(*core.NATGatewayIPStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayIPStatus)(nil), (*corev1alpha1.NATGatewayIPStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayIPStatus_To_v1alpha1_NATGatewayIPStatus(a.(*core.NATGatewayIPStatus), b.(*corev1alpha1.NATGatewayIPStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayList)(nil), (*core.NATGatewayList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NATGatewayList_To_core_NATGatewayList(a.(*corev1alpha1.NATGatewayList), b.(*core.NATGatewayList), scope)
 	}); err != nil {
@@ -428,6 +588,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayNAT64)(nil), (*core.NATGatewayNAT64)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayNAT64_To_core_NATGatewayNAT64(a.(*corev1alpha1.NATGatewayNAT64), b.(*core.NATGatewayNAT64), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayNAT64)(nil), (*corev1alpha1.NATGatewayNAT64)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayNAT64_To_v1alpha1_NATGatewayNAT64(a.(*core.NATGatewayNAT64), b.(*corev1alpha1.NATGatewayNAT64), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayPortBlockExpansion)(nil), (*core.NATGatewayPortBlockExpansion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayPortBlockExpansion_To_core_NATGatewayPortBlockExpansion(a.(*corev1alpha1.NATGatewayPortBlockExpansion), b.(*core.NATGatewayPortBlockExpansion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayPortBlockExpansion)(nil), (*corev1alpha1.NATGatewayPortBlockExpansion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayPortBlockExpansion_To_v1alpha1_NATGatewayPortBlockExpansion(a.(*core.NATGatewayPortBlockExpansion), b.(*corev1alpha1.NATGatewayPortBlockExpansion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayPortsOverride)(nil), (*core.NATGatewayPortsOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayPortsOverride_To_core_NATGatewayPortsOverride(a.(*corev1alpha1.NATGatewayPortsOverride), b.(*core.NATGatewayPortsOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayPortsOverride)(nil), (*corev1alpha1.NATGatewayPortsOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayPortsOverride_To_v1alpha1_NATGatewayPortsOverride(a.(*core.NATGatewayPortsOverride), b.(*corev1alpha1.NATGatewayPortsOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayReservation)(nil), (*core.NATGatewayReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayReservation_To_core_NATGatewayReservation(a.(*corev1alpha1.NATGatewayReservation), b.(*core.NATGatewayReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayReservation)(nil), (*corev1alpha1.NATGatewayReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayReservation_To_v1alpha1_NATGatewayReservation(a.(*core.NATGatewayReservation), b.(*corev1alpha1.NATGatewayReservation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewaySpec)(nil), (*core.NATGatewaySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NATGatewaySpec_To_core_NATGatewaySpec(a.(*corev1alpha1.NATGatewaySpec), b.(*core.NATGatewaySpec), scope)
 	}); err != nil {
@@ -448,6 +648,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATGatewayStickyReservations)(nil), (*core.NATGatewayStickyReservations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayStickyReservations_To_core_NATGatewayStickyReservations(a.(*corev1alpha1.NATGatewayStickyReservations), b.(*core.NATGatewayStickyReservations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NATGatewayStickyReservations)(nil), (*corev1alpha1.NATGatewayStickyReservations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NATGatewayStickyReservations_To_v1alpha1_NATGatewayStickyReservations(a.(*core.NATGatewayStickyReservations), b.(*corev1alpha1.NATGatewayStickyReservations), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NATIP)(nil), (*core.NATIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NATIP_To_core_NATIP(a.(*corev1alpha1.NATIP), b.(*core.NATIP), scope)
 	}); err != nil {
@@ -548,6 +758,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkIPAddress)(nil), (*core.NetworkIPAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkIPAddress_To_core_NetworkIPAddress(a.(*corev1alpha1.NetworkIPAddress), b.(*core.NetworkIPAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkIPAddress)(nil), (*corev1alpha1.NetworkIPAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkIPAddress_To_v1alpha1_NetworkIPAddress(a.(*core.NetworkIPAddress), b.(*corev1alpha1.NetworkIPAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkIPAddressList)(nil), (*core.NetworkIPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkIPAddressList_To_core_NetworkIPAddressList(a.(*corev1alpha1.NetworkIPAddressList), b.(*core.NetworkIPAddressList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkIPAddressList)(nil), (*corev1alpha1.NetworkIPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkIPAddressList_To_v1alpha1_NetworkIPAddressList(a.(*core.NetworkIPAddressList), b.(*corev1alpha1.NetworkIPAddressList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkIPAddressSpec)(nil), (*core.NetworkIPAddressSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkIPAddressSpec_To_core_NetworkIPAddressSpec(a.(*corev1alpha1.NetworkIPAddressSpec), b.(*core.NetworkIPAddressSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkIPAddressSpec)(nil), (*corev1alpha1.NetworkIPAddressSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkIPAddressSpec_To_v1alpha1_NetworkIPAddressSpec(a.(*core.NetworkIPAddressSpec), b.(*corev1alpha1.NetworkIPAddressSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkInterface)(nil), (*core.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkInterface_To_core_NetworkInterface(a.(*corev1alpha1.NetworkInterface), b.(*core.NetworkInterface), scope)
 	}); err != nil {
@@ -588,6 +828,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkInterfaceNATStatus)(nil), (*core.NetworkInterfaceNATStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceNATStatus_To_core_NetworkInterfaceNATStatus(a.(*corev1alpha1.NetworkInterfaceNATStatus), b.(*core.NetworkInterfaceNATStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkInterfaceNATStatus)(nil), (*corev1alpha1.NetworkInterfaceNATStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkInterfaceNATStatus_To_v1alpha1_NetworkInterfaceNATStatus(a.(*core.NetworkInterfaceNATStatus), b.(*corev1alpha1.NetworkInterfaceNATStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkInterfacePublicIP)(nil), (*core.NetworkInterfacePublicIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkInterfacePublicIP_To_core_NetworkInterfacePublicIP(a.(*corev1alpha1.NetworkInterfacePublicIP), b.(*core.NetworkInterfacePublicIP), scope)
 	}); err != nil {
@@ -738,6 +988,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkQuota)(nil), (*core.NetworkQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkQuota_To_core_NetworkQuota(a.(*corev1alpha1.NetworkQuota), b.(*core.NetworkQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkQuota)(nil), (*corev1alpha1.NetworkQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkQuota_To_v1alpha1_NetworkQuota(a.(*core.NetworkQuota), b.(*corev1alpha1.NetworkQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkQuotaList)(nil), (*core.NetworkQuotaList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkQuotaList_To_core_NetworkQuotaList(a.(*corev1alpha1.NetworkQuotaList), b.(*core.NetworkQuotaList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkQuotaList)(nil), (*corev1alpha1.NetworkQuotaList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkQuotaList_To_v1alpha1_NetworkQuotaList(a.(*core.NetworkQuotaList), b.(*corev1alpha1.NetworkQuotaList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkQuotaSpec)(nil), (*core.NetworkQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkQuotaSpec_To_core_NetworkQuotaSpec(a.(*corev1alpha1.NetworkQuotaSpec), b.(*core.NetworkQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkQuotaSpec)(nil), (*corev1alpha1.NetworkQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkQuotaSpec_To_v1alpha1_NetworkQuotaSpec(a.(*core.NetworkQuotaSpec), b.(*corev1alpha1.NetworkQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkQuotaStatus)(nil), (*core.NetworkQuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkQuotaStatus_To_core_NetworkQuotaStatus(a.(*corev1alpha1.NetworkQuotaStatus), b.(*core.NetworkQuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkQuotaStatus)(nil), (*corev1alpha1.NetworkQuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkQuotaStatus_To_v1alpha1_NetworkQuotaStatus(a.(*core.NetworkQuotaStatus), b.(*corev1alpha1.NetworkQuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.NetworkSpec)(nil), (*core.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkSpec_To_core_NetworkSpec(a.(*corev1alpha1.NetworkSpec), b.(*core.NetworkSpec), scope)
 	}); err != nil {
@@ -878,6 +1168,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.PortForwarding)(nil), (*core.PortForwarding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortForwarding_To_core_PortForwarding(a.(*corev1alpha1.PortForwarding), b.(*core.PortForwarding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PortForwarding)(nil), (*corev1alpha1.PortForwarding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PortForwarding_To_v1alpha1_PortForwarding(a.(*core.PortForwarding), b.(*corev1alpha1.PortForwarding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.PortForwardingList)(nil), (*core.PortForwardingList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortForwardingList_To_core_PortForwardingList(a.(*corev1alpha1.PortForwardingList), b.(*core.PortForwardingList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PortForwardingList)(nil), (*corev1alpha1.PortForwardingList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PortForwardingList_To_v1alpha1_PortForwardingList(a.(*core.PortForwardingList), b.(*corev1alpha1.PortForwardingList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*corev1alpha1.PortForwardingSpec)(nil), (*core.PortForwardingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortForwardingSpec_To_core_PortForwardingSpec(a.(*corev1alpha1.PortForwardingSpec), b.(*core.PortForwardingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PortForwardingSpec)(nil), (*corev1alpha1.PortForwardingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PortForwardingSpec_To_v1alpha1_PortForwardingSpec(a.(*core.PortForwardingSpec), b.(*corev1alpha1.PortForwardingSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*corev1alpha1.Rule)(nil), (*core.Rule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Rule_To_core_Rule(a.(*corev1alpha1.Rule), b.(*core.Rule), scope)
 	}); err != nil {
@@ -1107,6 +1427,7 @@ func autoConvert_v1alpha1_IPAddressClaimRef_To_core_IPAddressClaimRef(in *corev1
 	out.Namespace = in.Namespace
 	out.Name = in.Name
 	out.UID = types.UID(in.UID)
+	out.Pool = in.Pool
 	return nil
 }
 
@@ -1121,6 +1442,7 @@ func autoConvert_core_IPAddressClaimRef_To_v1alpha1_IPAddressClaimRef(in *core.I
 	out.Namespace = in.Namespace
 	out.Name = in.Name
 	out.UID = types.UID(in.UID)
+	out.Pool = in.Pool
 	return nil
 }
 
@@ -1129,6 +1451,104 @@ func Convert_core_IPAddressClaimRef_To_v1alpha1_IPAddressClaimRef(in *core.IPAdd
 	return autoConvert_core_IPAddressClaimRef_To_v1alpha1_IPAddressClaimRef(in, out, s)
 }
 
+func autoConvert_v1alpha1_IPAddressHistory_To_core_IPAddressHistory(in *corev1alpha1.IPAddressHistory, out *core.IPAddressHistory, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_IPAddressHistorySpec_To_core_IPAddressHistorySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressHistory_To_core_IPAddressHistory is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressHistory_To_core_IPAddressHistory(in *corev1alpha1.IPAddressHistory, out *core.IPAddressHistory, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressHistory_To_core_IPAddressHistory(in, out, s)
+}
+
+func autoConvert_core_IPAddressHistory_To_v1alpha1_IPAddressHistory(in *core.IPAddressHistory, out *corev1alpha1.IPAddressHistory, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_IPAddressHistorySpec_To_v1alpha1_IPAddressHistorySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_IPAddressHistory_To_v1alpha1_IPAddressHistory is an autogenerated conversion function.
+func Convert_core_IPAddressHistory_To_v1alpha1_IPAddressHistory(in *core.IPAddressHistory, out *corev1alpha1.IPAddressHistory, s conversion.Scope) error {
+	return autoConvert_core_IPAddressHistory_To_v1alpha1_IPAddressHistory(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAddressHistoryList_To_core_IPAddressHistoryList(in *corev1alpha1.IPAddressHistoryList, out *core.IPAddressHistoryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.IPAddressHistory)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressHistoryList_To_core_IPAddressHistoryList is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressHistoryList_To_core_IPAddressHistoryList(in *corev1alpha1.IPAddressHistoryList, out *core.IPAddressHistoryList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressHistoryList_To_core_IPAddressHistoryList(in, out, s)
+}
+
+func autoConvert_core_IPAddressHistoryList_To_v1alpha1_IPAddressHistoryList(in *core.IPAddressHistoryList, out *corev1alpha1.IPAddressHistoryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]corev1alpha1.IPAddressHistory)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_IPAddressHistoryList_To_v1alpha1_IPAddressHistoryList is an autogenerated conversion function.
+func Convert_core_IPAddressHistoryList_To_v1alpha1_IPAddressHistoryList(in *core.IPAddressHistoryList, out *corev1alpha1.IPAddressHistoryList, s conversion.Scope) error {
+	return autoConvert_core_IPAddressHistoryList_To_v1alpha1_IPAddressHistoryList(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAddressHistorySpec_To_core_IPAddressHistorySpec(in *corev1alpha1.IPAddressHistorySpec, out *core.IPAddressHistorySpec, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Leases = *(*[]core.IPAddressLease)(unsafe.Pointer(&in.Leases))
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressHistorySpec_To_core_IPAddressHistorySpec is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressHistorySpec_To_core_IPAddressHistorySpec(in *corev1alpha1.IPAddressHistorySpec, out *core.IPAddressHistorySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressHistorySpec_To_core_IPAddressHistorySpec(in, out, s)
+}
+
+func autoConvert_core_IPAddressHistorySpec_To_v1alpha1_IPAddressHistorySpec(in *core.IPAddressHistorySpec, out *corev1alpha1.IPAddressHistorySpec, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Leases = *(*[]corev1alpha1.IPAddressLease)(unsafe.Pointer(&in.Leases))
+	return nil
+}
+
+// Convert_core_IPAddressHistorySpec_To_v1alpha1_IPAddressHistorySpec is an autogenerated conversion function.
+func Convert_core_IPAddressHistorySpec_To_v1alpha1_IPAddressHistorySpec(in *core.IPAddressHistorySpec, out *corev1alpha1.IPAddressHistorySpec, s conversion.Scope) error {
+	return autoConvert_core_IPAddressHistorySpec_To_v1alpha1_IPAddressHistorySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAddressLease_To_core_IPAddressLease(in *corev1alpha1.IPAddressLease, out *core.IPAddressLease, s conversion.Scope) error {
+	if err := Convert_v1alpha1_IPAddressClaimRef_To_core_IPAddressClaimRef(&in.ClaimRef, &out.ClaimRef, s); err != nil {
+		return err
+	}
+	out.AllocatedTime = in.AllocatedTime
+	out.ReleasedTime = (*v1.Time)(unsafe.Pointer(in.ReleasedTime))
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressLease_To_core_IPAddressLease is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressLease_To_core_IPAddressLease(in *corev1alpha1.IPAddressLease, out *core.IPAddressLease, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressLease_To_core_IPAddressLease(in, out, s)
+}
+
+func autoConvert_core_IPAddressLease_To_v1alpha1_IPAddressLease(in *core.IPAddressLease, out *corev1alpha1.IPAddressLease, s conversion.Scope) error {
+	if err := Convert_core_IPAddressClaimRef_To_v1alpha1_IPAddressClaimRef(&in.ClaimRef, &out.ClaimRef, s); err != nil {
+		return err
+	}
+	out.AllocatedTime = in.AllocatedTime
+	out.ReleasedTime = (*v1.Time)(unsafe.Pointer(in.ReleasedTime))
+	return nil
+}
+
+// Convert_core_IPAddressLease_To_v1alpha1_IPAddressLease is an autogenerated conversion function.
+func Convert_core_IPAddressLease_To_v1alpha1_IPAddressLease(in *core.IPAddressLease, out *corev1alpha1.IPAddressLease, s conversion.Scope) error {
+	return autoConvert_core_IPAddressLease_To_v1alpha1_IPAddressLease(in, out, s)
+}
+
 func autoConvert_v1alpha1_IPAddressList_To_core_IPAddressList(in *corev1alpha1.IPAddressList, out *core.IPAddressList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.IPAddress)(unsafe.Pointer(&in.Items))
@@ -1247,11 +1667,145 @@ func Convert_core_IPList_To_v1alpha1_IPList(in *core.IPList, out *corev1alpha1.I
 	return autoConvert_core_IPList_To_v1alpha1_IPList(in, out, s)
 }
 
+func autoConvert_v1alpha1_IPPool_To_core_IPPool(in *corev1alpha1.IPPool, out *core.IPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_IPPoolSpec_To_core_IPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_IPPoolStatus_To_core_IPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPPool_To_core_IPPool is an autogenerated conversion function.
+func Convert_v1alpha1_IPPool_To_core_IPPool(in *corev1alpha1.IPPool, out *core.IPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPool_To_core_IPPool(in, out, s)
+}
+
+func autoConvert_core_IPPool_To_v1alpha1_IPPool(in *core.IPPool, out *corev1alpha1.IPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_IPPoolSpec_To_v1alpha1_IPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_IPPoolStatus_To_v1alpha1_IPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_IPPool_To_v1alpha1_IPPool is an autogenerated conversion function.
+func Convert_core_IPPool_To_v1alpha1_IPPool(in *core.IPPool, out *corev1alpha1.IPPool, s conversion.Scope) error {
+	return autoConvert_core_IPPool_To_v1alpha1_IPPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolList_To_core_IPPoolList(in *corev1alpha1.IPPoolList, out *core.IPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.IPPool)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolList_To_core_IPPoolList is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolList_To_core_IPPoolList(in *corev1alpha1.IPPoolList, out *core.IPPoolList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolList_To_core_IPPoolList(in, out, s)
+}
+
+func autoConvert_core_IPPoolList_To_v1alpha1_IPPoolList(in *core.IPPoolList, out *corev1alpha1.IPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]corev1alpha1.IPPool)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_IPPoolList_To_v1alpha1_IPPoolList is an autogenerated conversion function.
+func Convert_core_IPPoolList_To_v1alpha1_IPPoolList(in *core.IPPoolList, out *corev1alpha1.IPPoolList, s conversion.Scope) error {
+	return autoConvert_core_IPPoolList_To_v1alpha1_IPPoolList(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolPrefixStatus_To_core_IPPoolPrefixStatus(in *corev1alpha1.IPPoolPrefixStatus, out *core.IPPoolPrefixStatus, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.Total = in.Total
+	out.Used = in.Used
+	out.Free = in.Free
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolPrefixStatus_To_core_IPPoolPrefixStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolPrefixStatus_To_core_IPPoolPrefixStatus(in *corev1alpha1.IPPoolPrefixStatus, out *core.IPPoolPrefixStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolPrefixStatus_To_core_IPPoolPrefixStatus(in, out, s)
+}
+
+func autoConvert_core_IPPoolPrefixStatus_To_v1alpha1_IPPoolPrefixStatus(in *core.IPPoolPrefixStatus, out *corev1alpha1.IPPoolPrefixStatus, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.Total = in.Total
+	out.Used = in.Used
+	out.Free = in.Free
+	return nil
+}
+
+// Convert_core_IPPoolPrefixStatus_To_v1alpha1_IPPoolPrefixStatus is an autogenerated conversion function.
+func Convert_core_IPPoolPrefixStatus_To_v1alpha1_IPPoolPrefixStatus(in *core.IPPoolPrefixStatus, out *corev1alpha1.IPPoolPrefixStatus, s conversion.Scope) error {
+	return autoConvert_core_IPPoolPrefixStatus_To_v1alpha1_IPPoolPrefixStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolSpec_To_core_IPPoolSpec(in *corev1alpha1.IPPoolSpec, out *core.IPPoolSpec, s conversion.Scope) error {
+	out.Type = core.IPType(in.Type)
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.Prefixes = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	out.Excluded = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Excluded))
+	out.Reserved = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Reserved))
+	out.AllocationStrategy = core.AllocationStrategy(in.AllocationStrategy)
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolSpec_To_core_IPPoolSpec is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolSpec_To_core_IPPoolSpec(in *corev1alpha1.IPPoolSpec, out *core.IPPoolSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolSpec_To_core_IPPoolSpec(in, out, s)
+}
+
+func autoConvert_core_IPPoolSpec_To_v1alpha1_IPPoolSpec(in *core.IPPoolSpec, out *corev1alpha1.IPPoolSpec, s conversion.Scope) error {
+	out.Type = corev1alpha1.IPType(in.Type)
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.Prefixes = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	out.Excluded = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Excluded))
+	out.Reserved = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Reserved))
+	out.AllocationStrategy = corev1alpha1.AllocationStrategy(in.AllocationStrategy)
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_core_IPPoolSpec_To_v1alpha1_IPPoolSpec is an autogenerated conversion function.
+func Convert_core_IPPoolSpec_To_v1alpha1_IPPoolSpec(in *core.IPPoolSpec, out *corev1alpha1.IPPoolSpec, s conversion.Scope) error {
+	return autoConvert_core_IPPoolSpec_To_v1alpha1_IPPoolSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolStatus_To_core_IPPoolStatus(in *corev1alpha1.IPPoolStatus, out *core.IPPoolStatus, s conversion.Scope) error {
+	out.Prefixes = *(*[]core.IPPoolPrefixStatus)(unsafe.Pointer(&in.Prefixes))
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolStatus_To_core_IPPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolStatus_To_core_IPPoolStatus(in *corev1alpha1.IPPoolStatus, out *core.IPPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolStatus_To_core_IPPoolStatus(in, out, s)
+}
+
+func autoConvert_core_IPPoolStatus_To_v1alpha1_IPPoolStatus(in *core.IPPoolStatus, out *corev1alpha1.IPPoolStatus, s conversion.Scope) error {
+	out.Prefixes = *(*[]corev1alpha1.IPPoolPrefixStatus)(unsafe.Pointer(&in.Prefixes))
+	return nil
+}
+
+// Convert_core_IPPoolStatus_To_v1alpha1_IPPoolStatus is an autogenerated conversion function.
+func Convert_core_IPPoolStatus_To_v1alpha1_IPPoolStatus(in *core.IPPoolStatus, out *corev1alpha1.IPPoolStatus, s conversion.Scope) error {
+	return autoConvert_core_IPPoolStatus_To_v1alpha1_IPPoolStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_IPSpec_To_core_IPSpec(in *corev1alpha1.IPSpec, out *core.IPSpec, s conversion.Scope) error {
 	out.Type = core.IPType(in.Type)
 	out.IPFamily = corev1.IPFamily(in.IPFamily)
 	out.IP = in.IP
 	out.ClaimRef = (*core.IPClaimRef)(unsafe.Pointer(in.ClaimRef))
+	out.PoolRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PoolRef))
+	out.PoolSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PoolSelector))
 	return nil
 }
 
@@ -1265,6 +1819,8 @@ func autoConvert_core_IPSpec_To_v1alpha1_IPSpec(in *core.IPSpec, out *corev1alph
 	out.IPFamily = corev1.IPFamily(in.IPFamily)
 	out.IP = in.IP
 	out.ClaimRef = (*corev1alpha1.IPClaimRef)(unsafe.Pointer(in.ClaimRef))
+	out.PoolRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PoolRef))
+	out.PoolSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PoolSelector))
 	return nil
 }
 
@@ -1504,6 +2060,9 @@ func Convert_core_LoadBalancer_To_v1alpha1_LoadBalancer(in *core.LoadBalancer, o
 func autoConvert_v1alpha1_LoadBalancerDestination_To_core_LoadBalancerDestination(in *corev1alpha1.LoadBalancerDestination, out *core.LoadBalancerDestination, s conversion.Scope) error {
 	out.IP = in.IP
 	out.TargetRef = (*core.LoadBalancerTargetRef)(unsafe.Pointer(in.TargetRef))
+	out.State = core.LoadBalancerDestinationState(in.State)
+	out.DrainingTimestamp = (*v1.Time)(unsafe.Pointer(in.DrainingTimestamp))
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
 	return nil
 }
 
@@ -1515,6 +2074,9 @@ func Convert_v1alpha1_LoadBalancerDestination_To_core_LoadBalancerDestination(in
 func autoConvert_core_LoadBalancerDestination_To_v1alpha1_LoadBalancerDestination(in *core.LoadBalancerDestination, out *corev1alpha1.LoadBalancerDestination, s conversion.Scope) error {
 	out.IP = in.IP
 	out.TargetRef = (*corev1alpha1.LoadBalancerTargetRef)(unsafe.Pointer(in.TargetRef))
+	out.State = corev1alpha1.LoadBalancerDestinationState(in.State)
+	out.DrainingTimestamp = (*v1.Time)(unsafe.Pointer(in.DrainingTimestamp))
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
 	return nil
 }
 
@@ -1640,6 +2202,8 @@ func Convert_core_LoadBalancerRoutingList_To_v1alpha1_LoadBalancerRoutingList(in
 func autoConvert_v1alpha1_LoadBalancerSpec_To_core_LoadBalancerSpec(in *corev1alpha1.LoadBalancerSpec, out *core.LoadBalancerSpec, s conversion.Scope) error {
 	out.Type = core.LoadBalancerType(in.Type)
 	out.NetworkRef = in.NetworkRef
+	out.IPFamilyPolicy = (*corev1.IPFamilyPolicy)(unsafe.Pointer(in.IPFamilyPolicy))
+	out.IPFamilies = *(*[]corev1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.IPs = *(*[]core.LoadBalancerIP)(unsafe.Pointer(&in.IPs))
 	out.Ports = *(*[]core.LoadBalancerPort)(unsafe.Pointer(&in.Ports))
 	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
@@ -1657,6 +2221,8 @@ func Convert_v1alpha1_LoadBalancerSpec_To_core_LoadBalancerSpec(in *corev1alpha1
 func autoConvert_core_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(in *core.LoadBalancerSpec, out *corev1alpha1.LoadBalancerSpec, s conversion.Scope) error {
 	out.Type = corev1alpha1.LoadBalancerType(in.Type)
 	out.NetworkRef = in.NetworkRef
+	out.IPFamilyPolicy = (*corev1.IPFamilyPolicy)(unsafe.Pointer(in.IPFamilyPolicy))
+	out.IPFamilies = *(*[]corev1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.IPs = *(*[]corev1alpha1.LoadBalancerIP)(unsafe.Pointer(&in.IPs))
 	out.Ports = *(*[]corev1alpha1.LoadBalancerPort)(unsafe.Pointer(&in.Ports))
 	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
@@ -1796,124 +2362,412 @@ func autoConvert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler(in *
 	return nil
 }
 
-// Convert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler is an autogenerated conversion function.
-func Convert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler(in *core.NATGatewayAutoscaler, out *corev1alpha1.NATGatewayAutoscaler, s conversion.Scope) error {
-	return autoConvert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler(in, out, s)
+// Convert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler(in *core.NATGatewayAutoscaler, out *corev1alpha1.NATGatewayAutoscaler, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscaler_To_v1alpha1_NATGatewayAutoscaler(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerBehavior_To_core_NATGatewayAutoscalerBehavior(in *corev1alpha1.NATGatewayAutoscalerBehavior, out *core.NATGatewayAutoscalerBehavior, s conversion.Scope) error {
+	out.ScaleUp = (*core.NATGatewayAutoscalerScalingRules)(unsafe.Pointer(in.ScaleUp))
+	out.ScaleDown = (*core.NATGatewayAutoscalerScalingRules)(unsafe.Pointer(in.ScaleDown))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerBehavior_To_core_NATGatewayAutoscalerBehavior is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerBehavior_To_core_NATGatewayAutoscalerBehavior(in *corev1alpha1.NATGatewayAutoscalerBehavior, out *core.NATGatewayAutoscalerBehavior, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerBehavior_To_core_NATGatewayAutoscalerBehavior(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerBehavior_To_v1alpha1_NATGatewayAutoscalerBehavior(in *core.NATGatewayAutoscalerBehavior, out *corev1alpha1.NATGatewayAutoscalerBehavior, s conversion.Scope) error {
+	out.ScaleUp = (*corev1alpha1.NATGatewayAutoscalerScalingRules)(unsafe.Pointer(in.ScaleUp))
+	out.ScaleDown = (*corev1alpha1.NATGatewayAutoscalerScalingRules)(unsafe.Pointer(in.ScaleDown))
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerBehavior_To_v1alpha1_NATGatewayAutoscalerBehavior is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerBehavior_To_v1alpha1_NATGatewayAutoscalerBehavior(in *core.NATGatewayAutoscalerBehavior, out *corev1alpha1.NATGatewayAutoscalerBehavior, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerBehavior_To_v1alpha1_NATGatewayAutoscalerBehavior(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerIPFamilyStatus_To_core_NATGatewayAutoscalerIPFamilyStatus(in *corev1alpha1.NATGatewayAutoscalerIPFamilyStatus, out *core.NATGatewayAutoscalerIPFamilyStatus, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.LastScaleTime = (*v1.Time)(unsafe.Pointer(in.LastScaleTime))
+	out.Recommendations = *(*[]core.NATGatewayAutoscalerRecommendation)(unsafe.Pointer(&in.Recommendations))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerIPFamilyStatus_To_core_NATGatewayAutoscalerIPFamilyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerIPFamilyStatus_To_core_NATGatewayAutoscalerIPFamilyStatus(in *corev1alpha1.NATGatewayAutoscalerIPFamilyStatus, out *core.NATGatewayAutoscalerIPFamilyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerIPFamilyStatus_To_core_NATGatewayAutoscalerIPFamilyStatus(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerIPFamilyStatus_To_v1alpha1_NATGatewayAutoscalerIPFamilyStatus(in *core.NATGatewayAutoscalerIPFamilyStatus, out *corev1alpha1.NATGatewayAutoscalerIPFamilyStatus, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.LastScaleTime = (*v1.Time)(unsafe.Pointer(in.LastScaleTime))
+	out.Recommendations = *(*[]corev1alpha1.NATGatewayAutoscalerRecommendation)(unsafe.Pointer(&in.Recommendations))
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerIPFamilyStatus_To_v1alpha1_NATGatewayAutoscalerIPFamilyStatus is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerIPFamilyStatus_To_v1alpha1_NATGatewayAutoscalerIPFamilyStatus(in *core.NATGatewayAutoscalerIPFamilyStatus, out *corev1alpha1.NATGatewayAutoscalerIPFamilyStatus, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerIPFamilyStatus_To_v1alpha1_NATGatewayAutoscalerIPFamilyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(in *corev1alpha1.NATGatewayAutoscalerList, out *core.NATGatewayAutoscalerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.NATGatewayAutoscaler)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(in *corev1alpha1.NATGatewayAutoscalerList, out *core.NATGatewayAutoscalerList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList(in *core.NATGatewayAutoscalerList, out *corev1alpha1.NATGatewayAutoscalerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]corev1alpha1.NATGatewayAutoscaler)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList(in *core.NATGatewayAutoscalerList, out *corev1alpha1.NATGatewayAutoscalerList, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerRecommendation_To_core_NATGatewayAutoscalerRecommendation(in *corev1alpha1.NATGatewayAutoscalerRecommendation, out *core.NATGatewayAutoscalerRecommendation, s conversion.Scope) error {
+	out.IPs = in.IPs
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerRecommendation_To_core_NATGatewayAutoscalerRecommendation is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerRecommendation_To_core_NATGatewayAutoscalerRecommendation(in *corev1alpha1.NATGatewayAutoscalerRecommendation, out *core.NATGatewayAutoscalerRecommendation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerRecommendation_To_core_NATGatewayAutoscalerRecommendation(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerRecommendation_To_v1alpha1_NATGatewayAutoscalerRecommendation(in *core.NATGatewayAutoscalerRecommendation, out *corev1alpha1.NATGatewayAutoscalerRecommendation, s conversion.Scope) error {
+	out.IPs = in.IPs
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerRecommendation_To_v1alpha1_NATGatewayAutoscalerRecommendation is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerRecommendation_To_v1alpha1_NATGatewayAutoscalerRecommendation(in *core.NATGatewayAutoscalerRecommendation, out *corev1alpha1.NATGatewayAutoscalerRecommendation, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerRecommendation_To_v1alpha1_NATGatewayAutoscalerRecommendation(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerScalingRules_To_core_NATGatewayAutoscalerScalingRules(in *corev1alpha1.NATGatewayAutoscalerScalingRules, out *core.NATGatewayAutoscalerScalingRules, s conversion.Scope) error {
+	out.StabilizationWindow = (*v1.Duration)(unsafe.Pointer(in.StabilizationWindow))
+	out.MaxIPsPerStep = (*int32)(unsafe.Pointer(in.MaxIPsPerStep))
+	out.StepPeriod = (*v1.Duration)(unsafe.Pointer(in.StepPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerScalingRules_To_core_NATGatewayAutoscalerScalingRules is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerScalingRules_To_core_NATGatewayAutoscalerScalingRules(in *corev1alpha1.NATGatewayAutoscalerScalingRules, out *core.NATGatewayAutoscalerScalingRules, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerScalingRules_To_core_NATGatewayAutoscalerScalingRules(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerScalingRules_To_v1alpha1_NATGatewayAutoscalerScalingRules(in *core.NATGatewayAutoscalerScalingRules, out *corev1alpha1.NATGatewayAutoscalerScalingRules, s conversion.Scope) error {
+	out.StabilizationWindow = (*v1.Duration)(unsafe.Pointer(in.StabilizationWindow))
+	out.MaxIPsPerStep = (*int32)(unsafe.Pointer(in.MaxIPsPerStep))
+	out.StepPeriod = (*v1.Duration)(unsafe.Pointer(in.StepPeriod))
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerScalingRules_To_v1alpha1_NATGatewayAutoscalerScalingRules is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerScalingRules_To_v1alpha1_NATGatewayAutoscalerScalingRules(in *core.NATGatewayAutoscalerScalingRules, out *corev1alpha1.NATGatewayAutoscalerScalingRules, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerScalingRules_To_v1alpha1_NATGatewayAutoscalerScalingRules(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(in *corev1alpha1.NATGatewayAutoscalerSpec, out *core.NATGatewayAutoscalerSpec, s conversion.Scope) error {
+	out.NATGatewayRef = in.NATGatewayRef
+	out.MinPublicIPs = (*int32)(unsafe.Pointer(in.MinPublicIPs))
+	out.MaxPublicIPs = (*int32)(unsafe.Pointer(in.MaxPublicIPs))
+	out.TargetUtilizationPercentage = (*int32)(unsafe.Pointer(in.TargetUtilizationPercentage))
+	out.SpareSlots = (*int32)(unsafe.Pointer(in.SpareSlots))
+	out.Behavior = (*core.NATGatewayAutoscalerBehavior)(unsafe.Pointer(in.Behavior))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(in *corev1alpha1.NATGatewayAutoscalerSpec, out *core.NATGatewayAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec(in *core.NATGatewayAutoscalerSpec, out *corev1alpha1.NATGatewayAutoscalerSpec, s conversion.Scope) error {
+	out.NATGatewayRef = in.NATGatewayRef
+	out.MinPublicIPs = (*int32)(unsafe.Pointer(in.MinPublicIPs))
+	out.MaxPublicIPs = (*int32)(unsafe.Pointer(in.MaxPublicIPs))
+	out.TargetUtilizationPercentage = (*int32)(unsafe.Pointer(in.TargetUtilizationPercentage))
+	out.SpareSlots = (*int32)(unsafe.Pointer(in.SpareSlots))
+	out.Behavior = (*corev1alpha1.NATGatewayAutoscalerBehavior)(unsafe.Pointer(in.Behavior))
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec(in *core.NATGatewayAutoscalerSpec, out *corev1alpha1.NATGatewayAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus(in *corev1alpha1.NATGatewayAutoscalerStatus, out *core.NATGatewayAutoscalerStatus, s conversion.Scope) error {
+	out.CurrentIPs = in.CurrentIPs
+	out.DesiredIPs = in.DesiredIPs
+	out.LastScaleTime = (*v1.Time)(unsafe.Pointer(in.LastScaleTime))
+	out.IPFamilies = *(*[]core.NATGatewayAutoscalerIPFamilyStatus)(unsafe.Pointer(&in.IPFamilies))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus(in *corev1alpha1.NATGatewayAutoscalerStatus, out *core.NATGatewayAutoscalerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus(in, out, s)
+}
+
+func autoConvert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus(in *core.NATGatewayAutoscalerStatus, out *corev1alpha1.NATGatewayAutoscalerStatus, s conversion.Scope) error {
+	out.CurrentIPs = in.CurrentIPs
+	out.DesiredIPs = in.DesiredIPs
+	out.LastScaleTime = (*v1.Time)(unsafe.Pointer(in.LastScaleTime))
+	out.IPFamilies = *(*[]corev1alpha1.NATGatewayAutoscalerIPFamilyStatus)(unsafe.Pointer(&in.IPFamilies))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus is an autogenerated conversion function.
+func Convert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus(in *core.NATGatewayAutoscalerStatus, out *corev1alpha1.NATGatewayAutoscalerStatus, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayDrainingIPStatus_To_core_NATGatewayDrainingIPStatus(in *corev1alpha1.NATGatewayDrainingIPStatus, out *core.NATGatewayDrainingIPStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MigratedTime = (*v1.Time)(unsafe.Pointer(in.MigratedTime))
+	out.Drained = in.Drained
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayDrainingIPStatus_To_core_NATGatewayDrainingIPStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayDrainingIPStatus_To_core_NATGatewayDrainingIPStatus(in *corev1alpha1.NATGatewayDrainingIPStatus, out *core.NATGatewayDrainingIPStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayDrainingIPStatus_To_core_NATGatewayDrainingIPStatus(in, out, s)
+}
+
+func autoConvert_core_NATGatewayDrainingIPStatus_To_v1alpha1_NATGatewayDrainingIPStatus(in *core.NATGatewayDrainingIPStatus, out *corev1alpha1.NATGatewayDrainingIPStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MigratedTime = (*v1.Time)(unsafe.Pointer(in.MigratedTime))
+	out.Drained = in.Drained
+	return nil
+}
+
+// Convert_core_NATGatewayDrainingIPStatus_To_v1alpha1_NATGatewayDrainingIPStatus is an autogenerated conversion function.
+func Convert_core_NATGatewayDrainingIPStatus_To_v1alpha1_NATGatewayDrainingIPStatus(in *core.NATGatewayDrainingIPStatus, out *corev1alpha1.NATGatewayDrainingIPStatus, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayDrainingIPStatus_To_v1alpha1_NATGatewayDrainingIPStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(in *corev1alpha1.NATGatewayIP, out *core.NATGatewayIP, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.IP = in.IP
+	out.Draining = in.Draining
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(in *corev1alpha1.NATGatewayIP, out *core.NATGatewayIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(in, out, s)
+}
+
+func autoConvert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP(in *core.NATGatewayIP, out *corev1alpha1.NATGatewayIP, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.IP = in.IP
+	out.Draining = in.Draining
+	return nil
+}
+
+// Convert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP is an autogenerated conversion function.
+func Convert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP(in *core.NATGatewayIP, out *corev1alpha1.NATGatewayIP, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayIPFamilyStatus_To_core_NATGatewayIPFamilyStatus(in *corev1alpha1.NATGatewayIPFamilyStatus, out *core.NATGatewayIPFamilyStatus, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.UsedNATIPs = in.UsedNATIPs
+	out.RequestedNATIPs = in.RequestedNATIPs
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayIPFamilyStatus_To_core_NATGatewayIPFamilyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayIPFamilyStatus_To_core_NATGatewayIPFamilyStatus(in *corev1alpha1.NATGatewayIPFamilyStatus, out *core.NATGatewayIPFamilyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayIPFamilyStatus_To_core_NATGatewayIPFamilyStatus(in, out, s)
+}
+
+func autoConvert_core_NATGatewayIPFamilyStatus_To_v1alpha1_NATGatewayIPFamilyStatus(in *core.NATGatewayIPFamilyStatus, out *corev1alpha1.NATGatewayIPFamilyStatus, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.UsedNATIPs = in.UsedNATIPs
+	out.RequestedNATIPs = in.RequestedNATIPs
+	return nil
+}
+
+// Convert_core_NATGatewayIPFamilyStatus_To_v1alpha1_NATGatewayIPFamilyStatus is an autogenerated conversion function.
+func Convert_core_NATGatewayIPFamilyStatus_To_v1alpha1_NATGatewayIPFamilyStatus(in *core.NATGatewayIPFamilyStatus, out *corev1alpha1.NATGatewayIPFamilyStatus, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayIPFamilyStatus_To_v1alpha1_NATGatewayIPFamilyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayIPStatus_To_core_NATGatewayIPStatus(in *corev1alpha1.NATGatewayIPStatus, out *core.NATGatewayIPStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IP = in.IP
+	out.TotalSlots = in.TotalSlots
+	out.UsedSlots = in.UsedSlots
+	out.NetworkInterfaces = in.NetworkInterfaces
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayIPStatus_To_core_NATGatewayIPStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayIPStatus_To_core_NATGatewayIPStatus(in *corev1alpha1.NATGatewayIPStatus, out *core.NATGatewayIPStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayIPStatus_To_core_NATGatewayIPStatus(in, out, s)
+}
+
+func autoConvert_core_NATGatewayIPStatus_To_v1alpha1_NATGatewayIPStatus(in *core.NATGatewayIPStatus, out *corev1alpha1.NATGatewayIPStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IP = in.IP
+	out.TotalSlots = in.TotalSlots
+	out.UsedSlots = in.UsedSlots
+	out.NetworkInterfaces = in.NetworkInterfaces
+	return nil
+}
+
+// Convert_core_NATGatewayIPStatus_To_v1alpha1_NATGatewayIPStatus is an autogenerated conversion function.
+func Convert_core_NATGatewayIPStatus_To_v1alpha1_NATGatewayIPStatus(in *core.NATGatewayIPStatus, out *corev1alpha1.NATGatewayIPStatus, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayIPStatus_To_v1alpha1_NATGatewayIPStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(in *corev1alpha1.NATGatewayAutoscalerList, out *core.NATGatewayAutoscalerList, s conversion.Scope) error {
+func autoConvert_v1alpha1_NATGatewayList_To_core_NATGatewayList(in *corev1alpha1.NATGatewayList, out *core.NATGatewayList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]core.NATGatewayAutoscaler)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]core.NATGateway)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList is an autogenerated conversion function.
-func Convert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(in *corev1alpha1.NATGatewayAutoscalerList, out *core.NATGatewayAutoscalerList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NATGatewayAutoscalerList_To_core_NATGatewayAutoscalerList(in, out, s)
+// Convert_v1alpha1_NATGatewayList_To_core_NATGatewayList is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayList_To_core_NATGatewayList(in *corev1alpha1.NATGatewayList, out *core.NATGatewayList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayList_To_core_NATGatewayList(in, out, s)
 }
 
-func autoConvert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList(in *core.NATGatewayAutoscalerList, out *corev1alpha1.NATGatewayAutoscalerList, s conversion.Scope) error {
+func autoConvert_core_NATGatewayList_To_v1alpha1_NATGatewayList(in *core.NATGatewayList, out *corev1alpha1.NATGatewayList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]corev1alpha1.NATGatewayAutoscaler)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]corev1alpha1.NATGateway)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList is an autogenerated conversion function.
-func Convert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList(in *core.NATGatewayAutoscalerList, out *corev1alpha1.NATGatewayAutoscalerList, s conversion.Scope) error {
-	return autoConvert_core_NATGatewayAutoscalerList_To_v1alpha1_NATGatewayAutoscalerList(in, out, s)
+// Convert_core_NATGatewayList_To_v1alpha1_NATGatewayList is an autogenerated conversion function.
+func Convert_core_NATGatewayList_To_v1alpha1_NATGatewayList(in *core.NATGatewayList, out *corev1alpha1.NATGatewayList, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayList_To_v1alpha1_NATGatewayList(in, out, s)
 }
 
-func autoConvert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(in *corev1alpha1.NATGatewayAutoscalerSpec, out *core.NATGatewayAutoscalerSpec, s conversion.Scope) error {
-	out.NATGatewayRef = in.NATGatewayRef
-	out.MinPublicIPs = (*int32)(unsafe.Pointer(in.MinPublicIPs))
-	out.MaxPublicIPs = (*int32)(unsafe.Pointer(in.MaxPublicIPs))
+func autoConvert_v1alpha1_NATGatewayNAT64_To_core_NATGatewayNAT64(in *corev1alpha1.NATGatewayNAT64, out *core.NATGatewayNAT64, s conversion.Scope) error {
+	out.Prefix = in.Prefix
 	return nil
 }
 
-// Convert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec is an autogenerated conversion function.
-func Convert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(in *corev1alpha1.NATGatewayAutoscalerSpec, out *core.NATGatewayAutoscalerSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NATGatewayAutoscalerSpec_To_core_NATGatewayAutoscalerSpec(in, out, s)
+// Convert_v1alpha1_NATGatewayNAT64_To_core_NATGatewayNAT64 is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayNAT64_To_core_NATGatewayNAT64(in *corev1alpha1.NATGatewayNAT64, out *core.NATGatewayNAT64, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayNAT64_To_core_NATGatewayNAT64(in, out, s)
 }
 
-func autoConvert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec(in *core.NATGatewayAutoscalerSpec, out *corev1alpha1.NATGatewayAutoscalerSpec, s conversion.Scope) error {
-	out.NATGatewayRef = in.NATGatewayRef
-	out.MinPublicIPs = (*int32)(unsafe.Pointer(in.MinPublicIPs))
-	out.MaxPublicIPs = (*int32)(unsafe.Pointer(in.MaxPublicIPs))
+func autoConvert_core_NATGatewayNAT64_To_v1alpha1_NATGatewayNAT64(in *core.NATGatewayNAT64, out *corev1alpha1.NATGatewayNAT64, s conversion.Scope) error {
+	out.Prefix = in.Prefix
 	return nil
 }
 
-// Convert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec is an autogenerated conversion function.
-func Convert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec(in *core.NATGatewayAutoscalerSpec, out *corev1alpha1.NATGatewayAutoscalerSpec, s conversion.Scope) error {
-	return autoConvert_core_NATGatewayAutoscalerSpec_To_v1alpha1_NATGatewayAutoscalerSpec(in, out, s)
+// Convert_core_NATGatewayNAT64_To_v1alpha1_NATGatewayNAT64 is an autogenerated conversion function.
+func Convert_core_NATGatewayNAT64_To_v1alpha1_NATGatewayNAT64(in *core.NATGatewayNAT64, out *corev1alpha1.NATGatewayNAT64, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayNAT64_To_v1alpha1_NATGatewayNAT64(in, out, s)
 }
 
-func autoConvert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus(in *corev1alpha1.NATGatewayAutoscalerStatus, out *core.NATGatewayAutoscalerStatus, s conversion.Scope) error {
+func autoConvert_v1alpha1_NATGatewayPortBlockExpansion_To_core_NATGatewayPortBlockExpansion(in *corev1alpha1.NATGatewayPortBlockExpansion, out *core.NATGatewayPortBlockExpansion, s conversion.Scope) error {
+	out.MaxExtraPortBlocks = in.MaxExtraPortBlocks
+	out.CoolDownPeriod = (*v1.Duration)(unsafe.Pointer(in.CoolDownPeriod))
 	return nil
 }
 
-// Convert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus is an autogenerated conversion function.
-func Convert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus(in *corev1alpha1.NATGatewayAutoscalerStatus, out *core.NATGatewayAutoscalerStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NATGatewayAutoscalerStatus_To_core_NATGatewayAutoscalerStatus(in, out, s)
+// Convert_v1alpha1_NATGatewayPortBlockExpansion_To_core_NATGatewayPortBlockExpansion is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayPortBlockExpansion_To_core_NATGatewayPortBlockExpansion(in *corev1alpha1.NATGatewayPortBlockExpansion, out *core.NATGatewayPortBlockExpansion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayPortBlockExpansion_To_core_NATGatewayPortBlockExpansion(in, out, s)
 }
 
-func autoConvert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus(in *core.NATGatewayAutoscalerStatus, out *corev1alpha1.NATGatewayAutoscalerStatus, s conversion.Scope) error {
+func autoConvert_core_NATGatewayPortBlockExpansion_To_v1alpha1_NATGatewayPortBlockExpansion(in *core.NATGatewayPortBlockExpansion, out *corev1alpha1.NATGatewayPortBlockExpansion, s conversion.Scope) error {
+	out.MaxExtraPortBlocks = in.MaxExtraPortBlocks
+	out.CoolDownPeriod = (*v1.Duration)(unsafe.Pointer(in.CoolDownPeriod))
 	return nil
 }
 
-// Convert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus is an autogenerated conversion function.
-func Convert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus(in *core.NATGatewayAutoscalerStatus, out *corev1alpha1.NATGatewayAutoscalerStatus, s conversion.Scope) error {
-	return autoConvert_core_NATGatewayAutoscalerStatus_To_v1alpha1_NATGatewayAutoscalerStatus(in, out, s)
+// Convert_core_NATGatewayPortBlockExpansion_To_v1alpha1_NATGatewayPortBlockExpansion is an autogenerated conversion function.
+func Convert_core_NATGatewayPortBlockExpansion_To_v1alpha1_NATGatewayPortBlockExpansion(in *core.NATGatewayPortBlockExpansion, out *corev1alpha1.NATGatewayPortBlockExpansion, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayPortBlockExpansion_To_v1alpha1_NATGatewayPortBlockExpansion(in, out, s)
 }
 
-func autoConvert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(in *corev1alpha1.NATGatewayIP, out *core.NATGatewayIP, s conversion.Scope) error {
-	out.Name = in.Name
-	out.IP = in.IP
+func autoConvert_v1alpha1_NATGatewayPortsOverride_To_core_NATGatewayPortsOverride(in *corev1alpha1.NATGatewayPortsOverride, out *core.NATGatewayPortsOverride, s conversion.Scope) error {
+	out.NetworkInterfaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NetworkInterfaceSelector))
+	out.PortsPerNetworkInterface = in.PortsPerNetworkInterface
 	return nil
 }
 
-// Convert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP is an autogenerated conversion function.
-func Convert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(in *corev1alpha1.NATGatewayIP, out *core.NATGatewayIP, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NATGatewayIP_To_core_NATGatewayIP(in, out, s)
+// Convert_v1alpha1_NATGatewayPortsOverride_To_core_NATGatewayPortsOverride is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayPortsOverride_To_core_NATGatewayPortsOverride(in *corev1alpha1.NATGatewayPortsOverride, out *core.NATGatewayPortsOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayPortsOverride_To_core_NATGatewayPortsOverride(in, out, s)
 }
 
-func autoConvert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP(in *core.NATGatewayIP, out *corev1alpha1.NATGatewayIP, s conversion.Scope) error {
-	out.Name = in.Name
-	out.IP = in.IP
+func autoConvert_core_NATGatewayPortsOverride_To_v1alpha1_NATGatewayPortsOverride(in *core.NATGatewayPortsOverride, out *corev1alpha1.NATGatewayPortsOverride, s conversion.Scope) error {
+	out.NetworkInterfaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NetworkInterfaceSelector))
+	out.PortsPerNetworkInterface = in.PortsPerNetworkInterface
 	return nil
 }
 
-// Convert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP is an autogenerated conversion function.
-func Convert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP(in *core.NATGatewayIP, out *corev1alpha1.NATGatewayIP, s conversion.Scope) error {
-	return autoConvert_core_NATGatewayIP_To_v1alpha1_NATGatewayIP(in, out, s)
+// Convert_core_NATGatewayPortsOverride_To_v1alpha1_NATGatewayPortsOverride is an autogenerated conversion function.
+func Convert_core_NATGatewayPortsOverride_To_v1alpha1_NATGatewayPortsOverride(in *core.NATGatewayPortsOverride, out *corev1alpha1.NATGatewayPortsOverride, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayPortsOverride_To_v1alpha1_NATGatewayPortsOverride(in, out, s)
 }
 
-func autoConvert_v1alpha1_NATGatewayList_To_core_NATGatewayList(in *corev1alpha1.NATGatewayList, out *core.NATGatewayList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]core.NATGateway)(unsafe.Pointer(&in.Items))
+func autoConvert_v1alpha1_NATGatewayReservation_To_core_NATGatewayReservation(in *corev1alpha1.NATGatewayReservation, out *core.NATGatewayReservation, s conversion.Scope) error {
+	out.Key = in.Key
+	out.IP = in.IP
+	out.Port = in.Port
+	out.EndPort = in.EndPort
+	out.ReleasedTime = (*v1.Time)(unsafe.Pointer(in.ReleasedTime))
 	return nil
 }
 
-// Convert_v1alpha1_NATGatewayList_To_core_NATGatewayList is an autogenerated conversion function.
-func Convert_v1alpha1_NATGatewayList_To_core_NATGatewayList(in *corev1alpha1.NATGatewayList, out *core.NATGatewayList, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NATGatewayList_To_core_NATGatewayList(in, out, s)
+// Convert_v1alpha1_NATGatewayReservation_To_core_NATGatewayReservation is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayReservation_To_core_NATGatewayReservation(in *corev1alpha1.NATGatewayReservation, out *core.NATGatewayReservation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayReservation_To_core_NATGatewayReservation(in, out, s)
 }
 
-func autoConvert_core_NATGatewayList_To_v1alpha1_NATGatewayList(in *core.NATGatewayList, out *corev1alpha1.NATGatewayList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]corev1alpha1.NATGateway)(unsafe.Pointer(&in.Items))
+func autoConvert_core_NATGatewayReservation_To_v1alpha1_NATGatewayReservation(in *core.NATGatewayReservation, out *corev1alpha1.NATGatewayReservation, s conversion.Scope) error {
+	out.Key = in.Key
+	out.IP = in.IP
+	out.Port = in.Port
+	out.EndPort = in.EndPort
+	out.ReleasedTime = (*v1.Time)(unsafe.Pointer(in.ReleasedTime))
 	return nil
 }
 
-// Convert_core_NATGatewayList_To_v1alpha1_NATGatewayList is an autogenerated conversion function.
-func Convert_core_NATGatewayList_To_v1alpha1_NATGatewayList(in *core.NATGatewayList, out *corev1alpha1.NATGatewayList, s conversion.Scope) error {
-	return autoConvert_core_NATGatewayList_To_v1alpha1_NATGatewayList(in, out, s)
+// Convert_core_NATGatewayReservation_To_v1alpha1_NATGatewayReservation is an autogenerated conversion function.
+func Convert_core_NATGatewayReservation_To_v1alpha1_NATGatewayReservation(in *core.NATGatewayReservation, out *corev1alpha1.NATGatewayReservation, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayReservation_To_v1alpha1_NATGatewayReservation(in, out, s)
 }
 
 func autoConvert_v1alpha1_NATGatewaySpec_To_core_NATGatewaySpec(in *corev1alpha1.NATGatewaySpec, out *core.NATGatewaySpec, s conversion.Scope) error {
 	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.IPFamilies = *(*[]corev1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.NetworkRef = in.NetworkRef
+	out.NetworkInterfaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NetworkInterfaceSelector))
+	out.Priority = in.Priority
 	out.IPs = *(*[]core.NATGatewayIP)(unsafe.Pointer(&in.IPs))
 	out.PortsPerNetworkInterface = in.PortsPerNetworkInterface
+	out.PortsPerNetworkInterfaceOverrides = *(*[]core.NATGatewayPortsOverride)(unsafe.Pointer(&in.PortsPerNetworkInterfaceOverrides))
+	out.PortBlockExpansion = (*core.NATGatewayPortBlockExpansion)(unsafe.Pointer(in.PortBlockExpansion))
+	out.IPDrainPeriod = (*v1.Duration)(unsafe.Pointer(in.IPDrainPeriod))
+	out.NAT64 = (*core.NATGatewayNAT64)(unsafe.Pointer(in.NAT64))
+	out.StickyReservations = (*core.NATGatewayStickyReservations)(unsafe.Pointer(in.StickyReservations))
 	return nil
 }
 
@@ -1924,9 +2778,17 @@ func Convert_v1alpha1_NATGatewaySpec_To_core_NATGatewaySpec(in *corev1alpha1.NAT
 
 func autoConvert_core_NATGatewaySpec_To_v1alpha1_NATGatewaySpec(in *core.NATGatewaySpec, out *corev1alpha1.NATGatewaySpec, s conversion.Scope) error {
 	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.IPFamilies = *(*[]corev1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.NetworkRef = in.NetworkRef
+	out.NetworkInterfaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NetworkInterfaceSelector))
+	out.Priority = in.Priority
 	out.IPs = *(*[]corev1alpha1.NATGatewayIP)(unsafe.Pointer(&in.IPs))
 	out.PortsPerNetworkInterface = in.PortsPerNetworkInterface
+	out.PortsPerNetworkInterfaceOverrides = *(*[]corev1alpha1.NATGatewayPortsOverride)(unsafe.Pointer(&in.PortsPerNetworkInterfaceOverrides))
+	out.PortBlockExpansion = (*corev1alpha1.NATGatewayPortBlockExpansion)(unsafe.Pointer(in.PortBlockExpansion))
+	out.IPDrainPeriod = (*v1.Duration)(unsafe.Pointer(in.IPDrainPeriod))
+	out.NAT64 = (*corev1alpha1.NATGatewayNAT64)(unsafe.Pointer(in.NAT64))
+	out.StickyReservations = (*corev1alpha1.NATGatewayStickyReservations)(unsafe.Pointer(in.StickyReservations))
 	return nil
 }
 
@@ -1938,6 +2800,11 @@ func Convert_core_NATGatewaySpec_To_v1alpha1_NATGatewaySpec(in *core.NATGatewayS
 func autoConvert_v1alpha1_NATGatewayStatus_To_core_NATGatewayStatus(in *corev1alpha1.NATGatewayStatus, out *core.NATGatewayStatus, s conversion.Scope) error {
 	out.UsedNATIPs = in.UsedNATIPs
 	out.RequestedNATIPs = in.RequestedNATIPs
+	out.IPFamilies = *(*[]core.NATGatewayIPFamilyStatus)(unsafe.Pointer(&in.IPFamilies))
+	out.DrainingIPs = *(*[]core.NATGatewayDrainingIPStatus)(unsafe.Pointer(&in.DrainingIPs))
+	out.IPs = *(*[]core.NATGatewayIPStatus)(unsafe.Pointer(&in.IPs))
+	out.Reservations = *(*[]core.NATGatewayReservation)(unsafe.Pointer(&in.Reservations))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -1949,6 +2816,11 @@ func Convert_v1alpha1_NATGatewayStatus_To_core_NATGatewayStatus(in *corev1alpha1
 func autoConvert_core_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(in *core.NATGatewayStatus, out *corev1alpha1.NATGatewayStatus, s conversion.Scope) error {
 	out.UsedNATIPs = in.UsedNATIPs
 	out.RequestedNATIPs = in.RequestedNATIPs
+	out.IPFamilies = *(*[]corev1alpha1.NATGatewayIPFamilyStatus)(unsafe.Pointer(&in.IPFamilies))
+	out.DrainingIPs = *(*[]corev1alpha1.NATGatewayDrainingIPStatus)(unsafe.Pointer(&in.DrainingIPs))
+	out.IPs = *(*[]corev1alpha1.NATGatewayIPStatus)(unsafe.Pointer(&in.IPs))
+	out.Reservations = *(*[]corev1alpha1.NATGatewayReservation)(unsafe.Pointer(&in.Reservations))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -1957,6 +2829,26 @@ func Convert_core_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(in *core.NATGate
 	return autoConvert_core_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_NATGatewayStickyReservations_To_core_NATGatewayStickyReservations(in *corev1alpha1.NATGatewayStickyReservations, out *core.NATGatewayStickyReservations, s conversion.Scope) error {
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayStickyReservations_To_core_NATGatewayStickyReservations is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayStickyReservations_To_core_NATGatewayStickyReservations(in *corev1alpha1.NATGatewayStickyReservations, out *core.NATGatewayStickyReservations, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayStickyReservations_To_core_NATGatewayStickyReservations(in, out, s)
+}
+
+func autoConvert_core_NATGatewayStickyReservations_To_v1alpha1_NATGatewayStickyReservations(in *core.NATGatewayStickyReservations, out *corev1alpha1.NATGatewayStickyReservations, s conversion.Scope) error {
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	return nil
+}
+
+// Convert_core_NATGatewayStickyReservations_To_v1alpha1_NATGatewayStickyReservations is an autogenerated conversion function.
+func Convert_core_NATGatewayStickyReservations_To_v1alpha1_NATGatewayStickyReservations(in *core.NATGatewayStickyReservations, out *corev1alpha1.NATGatewayStickyReservations, s conversion.Scope) error {
+	return autoConvert_core_NATGatewayStickyReservations_To_v1alpha1_NATGatewayStickyReservations(in, out, s)
+}
+
 func autoConvert_v1alpha1_NATIP_To_core_NATIP(in *corev1alpha1.NATIP, out *core.NATIP, s conversion.Scope) error {
 	out.IP = in.IP
 	out.Sections = *(*[]core.NATIPSection)(unsafe.Pointer(&in.Sections))
@@ -2205,6 +3097,82 @@ func Convert_core_NetworkIDSpec_To_v1alpha1_NetworkIDSpec(in *core.NetworkIDSpec
 	return autoConvert_core_NetworkIDSpec_To_v1alpha1_NetworkIDSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkIPAddress_To_core_NetworkIPAddress(in *corev1alpha1.NetworkIPAddress, out *core.NetworkIPAddress, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NetworkIPAddressSpec_To_core_NetworkIPAddressSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkIPAddress_To_core_NetworkIPAddress is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkIPAddress_To_core_NetworkIPAddress(in *corev1alpha1.NetworkIPAddress, out *core.NetworkIPAddress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkIPAddress_To_core_NetworkIPAddress(in, out, s)
+}
+
+func autoConvert_core_NetworkIPAddress_To_v1alpha1_NetworkIPAddress(in *core.NetworkIPAddress, out *corev1alpha1.NetworkIPAddress, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_NetworkIPAddressSpec_To_v1alpha1_NetworkIPAddressSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_NetworkIPAddress_To_v1alpha1_NetworkIPAddress is an autogenerated conversion function.
+func Convert_core_NetworkIPAddress_To_v1alpha1_NetworkIPAddress(in *core.NetworkIPAddress, out *corev1alpha1.NetworkIPAddress, s conversion.Scope) error {
+	return autoConvert_core_NetworkIPAddress_To_v1alpha1_NetworkIPAddress(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkIPAddressList_To_core_NetworkIPAddressList(in *corev1alpha1.NetworkIPAddressList, out *core.NetworkIPAddressList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.NetworkIPAddress)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkIPAddressList_To_core_NetworkIPAddressList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkIPAddressList_To_core_NetworkIPAddressList(in *corev1alpha1.NetworkIPAddressList, out *core.NetworkIPAddressList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkIPAddressList_To_core_NetworkIPAddressList(in, out, s)
+}
+
+func autoConvert_core_NetworkIPAddressList_To_v1alpha1_NetworkIPAddressList(in *core.NetworkIPAddressList, out *corev1alpha1.NetworkIPAddressList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]corev1alpha1.NetworkIPAddress)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_NetworkIPAddressList_To_v1alpha1_NetworkIPAddressList is an autogenerated conversion function.
+func Convert_core_NetworkIPAddressList_To_v1alpha1_NetworkIPAddressList(in *core.NetworkIPAddressList, out *corev1alpha1.NetworkIPAddressList, s conversion.Scope) error {
+	return autoConvert_core_NetworkIPAddressList_To_v1alpha1_NetworkIPAddressList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkIPAddressSpec_To_core_NetworkIPAddressSpec(in *corev1alpha1.NetworkIPAddressSpec, out *core.NetworkIPAddressSpec, s conversion.Scope) error {
+	out.NetworkRef = in.NetworkRef
+	out.IP = in.IP
+	if err := Convert_v1alpha1_IPAddressClaimRef_To_core_IPAddressClaimRef(&in.ClaimRef, &out.ClaimRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkIPAddressSpec_To_core_NetworkIPAddressSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkIPAddressSpec_To_core_NetworkIPAddressSpec(in *corev1alpha1.NetworkIPAddressSpec, out *core.NetworkIPAddressSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkIPAddressSpec_To_core_NetworkIPAddressSpec(in, out, s)
+}
+
+func autoConvert_core_NetworkIPAddressSpec_To_v1alpha1_NetworkIPAddressSpec(in *core.NetworkIPAddressSpec, out *corev1alpha1.NetworkIPAddressSpec, s conversion.Scope) error {
+	out.NetworkRef = in.NetworkRef
+	out.IP = in.IP
+	if err := Convert_core_IPAddressClaimRef_To_v1alpha1_IPAddressClaimRef(&in.ClaimRef, &out.ClaimRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_NetworkIPAddressSpec_To_v1alpha1_NetworkIPAddressSpec is an autogenerated conversion function.
+func Convert_core_NetworkIPAddressSpec_To_v1alpha1_NetworkIPAddressSpec(in *core.NetworkIPAddressSpec, out *corev1alpha1.NetworkIPAddressSpec, s conversion.Scope) error {
+	return autoConvert_core_NetworkIPAddressSpec_To_v1alpha1_NetworkIPAddressSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkInterface_To_core_NetworkInterface(in *corev1alpha1.NetworkInterface, out *core.NetworkInterface, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_NetworkInterfaceSpec_To_core_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2307,6 +3275,32 @@ func Convert_core_NetworkInterfaceNATClaimRef_To_v1alpha1_NetworkInterfaceNATCla
 	return autoConvert_core_NetworkInterfaceNATClaimRef_To_v1alpha1_NetworkInterfaceNATClaimRef(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkInterfaceNATStatus_To_core_NetworkInterfaceNATStatus(in *corev1alpha1.NetworkInterfaceNATStatus, out *core.NetworkInterfaceNATStatus, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.Ports = in.Ports
+	out.PortPressure = in.PortPressure
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceNATStatus_To_core_NetworkInterfaceNATStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceNATStatus_To_core_NetworkInterfaceNATStatus(in *corev1alpha1.NetworkInterfaceNATStatus, out *core.NetworkInterfaceNATStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceNATStatus_To_core_NetworkInterfaceNATStatus(in, out, s)
+}
+
+func autoConvert_core_NetworkInterfaceNATStatus_To_v1alpha1_NetworkInterfaceNATStatus(in *core.NetworkInterfaceNATStatus, out *corev1alpha1.NetworkInterfaceNATStatus, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.Ports = in.Ports
+	out.PortPressure = in.PortPressure
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	return nil
+}
+
+// Convert_core_NetworkInterfaceNATStatus_To_v1alpha1_NetworkInterfaceNATStatus is an autogenerated conversion function.
+func Convert_core_NetworkInterfaceNATStatus_To_v1alpha1_NetworkInterfaceNATStatus(in *core.NetworkInterfaceNATStatus, out *corev1alpha1.NetworkInterfaceNATStatus, s conversion.Scope) error {
+	return autoConvert_core_NetworkInterfaceNATStatus_To_v1alpha1_NetworkInterfaceNATStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkInterfacePublicIP_To_core_NetworkInterfacePublicIP(in *corev1alpha1.NetworkInterfacePublicIP, out *core.NetworkInterfacePublicIP, s conversion.Scope) error {
 	out.Name = in.Name
 	out.IPFamily = corev1.IPFamily(in.IPFamily)
@@ -2370,6 +3364,7 @@ func autoConvert_v1alpha1_NetworkInterfaceStatus_To_core_NetworkInterfaceStatus(
 	out.Prefixes = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	out.PublicIPs = *(*[]net.IP)(unsafe.Pointer(&in.PublicIPs))
 	out.NATIPs = *(*[]net.IP)(unsafe.Pointer(&in.NATIPs))
+	out.NATs = *(*[]core.NetworkInterfaceNATStatus)(unsafe.Pointer(&in.NATs))
 	return nil
 }
 
@@ -2385,6 +3380,7 @@ func autoConvert_core_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(
 	out.Prefixes = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	out.PublicIPs = *(*[]net.IP)(unsafe.Pointer(&in.PublicIPs))
 	out.NATIPs = *(*[]net.IP)(unsafe.Pointer(&in.NATIPs))
+	out.NATs = *(*[]corev1alpha1.NetworkInterfaceNATStatus)(unsafe.Pointer(&in.NATs))
 	return nil
 }
 
@@ -2685,8 +3681,103 @@ func Convert_core_NetworkPolicySpec_To_v1alpha1_NetworkPolicySpec(in *core.Netwo
 	return autoConvert_core_NetworkPolicySpec_To_v1alpha1_NetworkPolicySpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkQuota_To_core_NetworkQuota(in *corev1alpha1.NetworkQuota, out *core.NetworkQuota, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NetworkQuotaSpec_To_core_NetworkQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_NetworkQuotaStatus_To_core_NetworkQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkQuota_To_core_NetworkQuota is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkQuota_To_core_NetworkQuota(in *corev1alpha1.NetworkQuota, out *core.NetworkQuota, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkQuota_To_core_NetworkQuota(in, out, s)
+}
+
+func autoConvert_core_NetworkQuota_To_v1alpha1_NetworkQuota(in *core.NetworkQuota, out *corev1alpha1.NetworkQuota, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_NetworkQuotaSpec_To_v1alpha1_NetworkQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_NetworkQuotaStatus_To_v1alpha1_NetworkQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_NetworkQuota_To_v1alpha1_NetworkQuota is an autogenerated conversion function.
+func Convert_core_NetworkQuota_To_v1alpha1_NetworkQuota(in *core.NetworkQuota, out *corev1alpha1.NetworkQuota, s conversion.Scope) error {
+	return autoConvert_core_NetworkQuota_To_v1alpha1_NetworkQuota(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkQuotaList_To_core_NetworkQuotaList(in *corev1alpha1.NetworkQuotaList, out *core.NetworkQuotaList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.NetworkQuota)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkQuotaList_To_core_NetworkQuotaList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkQuotaList_To_core_NetworkQuotaList(in *corev1alpha1.NetworkQuotaList, out *core.NetworkQuotaList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkQuotaList_To_core_NetworkQuotaList(in, out, s)
+}
+
+func autoConvert_core_NetworkQuotaList_To_v1alpha1_NetworkQuotaList(in *core.NetworkQuotaList, out *corev1alpha1.NetworkQuotaList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]corev1alpha1.NetworkQuota)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_NetworkQuotaList_To_v1alpha1_NetworkQuotaList is an autogenerated conversion function.
+func Convert_core_NetworkQuotaList_To_v1alpha1_NetworkQuotaList(in *core.NetworkQuotaList, out *corev1alpha1.NetworkQuotaList, s conversion.Scope) error {
+	return autoConvert_core_NetworkQuotaList_To_v1alpha1_NetworkQuotaList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkQuotaSpec_To_core_NetworkQuotaSpec(in *corev1alpha1.NetworkQuotaSpec, out *core.NetworkQuotaSpec, s conversion.Scope) error {
+	out.Hard = *(*map[core.NetworkQuotaResourceName]int64)(unsafe.Pointer(&in.Hard))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkQuotaSpec_To_core_NetworkQuotaSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkQuotaSpec_To_core_NetworkQuotaSpec(in *corev1alpha1.NetworkQuotaSpec, out *core.NetworkQuotaSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkQuotaSpec_To_core_NetworkQuotaSpec(in, out, s)
+}
+
+func autoConvert_core_NetworkQuotaSpec_To_v1alpha1_NetworkQuotaSpec(in *core.NetworkQuotaSpec, out *corev1alpha1.NetworkQuotaSpec, s conversion.Scope) error {
+	out.Hard = *(*map[corev1alpha1.NetworkQuotaResourceName]int64)(unsafe.Pointer(&in.Hard))
+	return nil
+}
+
+// Convert_core_NetworkQuotaSpec_To_v1alpha1_NetworkQuotaSpec is an autogenerated conversion function.
+func Convert_core_NetworkQuotaSpec_To_v1alpha1_NetworkQuotaSpec(in *core.NetworkQuotaSpec, out *corev1alpha1.NetworkQuotaSpec, s conversion.Scope) error {
+	return autoConvert_core_NetworkQuotaSpec_To_v1alpha1_NetworkQuotaSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkQuotaStatus_To_core_NetworkQuotaStatus(in *corev1alpha1.NetworkQuotaStatus, out *core.NetworkQuotaStatus, s conversion.Scope) error {
+	out.Used = *(*map[core.NetworkQuotaResourceName]int64)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkQuotaStatus_To_core_NetworkQuotaStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkQuotaStatus_To_core_NetworkQuotaStatus(in *corev1alpha1.NetworkQuotaStatus, out *core.NetworkQuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkQuotaStatus_To_core_NetworkQuotaStatus(in, out, s)
+}
+
+func autoConvert_core_NetworkQuotaStatus_To_v1alpha1_NetworkQuotaStatus(in *core.NetworkQuotaStatus, out *corev1alpha1.NetworkQuotaStatus, s conversion.Scope) error {
+	out.Used = *(*map[corev1alpha1.NetworkQuotaResourceName]int64)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_core_NetworkQuotaStatus_To_v1alpha1_NetworkQuotaStatus is an autogenerated conversion function.
+func Convert_core_NetworkQuotaStatus_To_v1alpha1_NetworkQuotaStatus(in *core.NetworkQuotaStatus, out *corev1alpha1.NetworkQuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_NetworkQuotaStatus_To_v1alpha1_NetworkQuotaStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkSpec_To_core_NetworkSpec(in *corev1alpha1.NetworkSpec, out *core.NetworkSpec, s conversion.Scope) error {
 	out.ID = in.ID
+	out.Prefixes = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	out.Peerings = *(*[]core.NetworkPeering)(unsafe.Pointer(&in.Peerings))
 	return nil
 }
@@ -2698,6 +3789,7 @@ func Convert_v1alpha1_NetworkSpec_To_core_NetworkSpec(in *corev1alpha1.NetworkSp
 
 func autoConvert_core_NetworkSpec_To_v1alpha1_NetworkSpec(in *core.NetworkSpec, out *corev1alpha1.NetworkSpec, s conversion.Scope) error {
 	out.ID = in.ID
+	out.Prefixes = *(*[]net.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	out.Peerings = *(*[]corev1alpha1.NetworkPeering)(unsafe.Pointer(&in.Peerings))
 	return nil
 }
@@ -2995,6 +4087,88 @@ func Convert_core_PeeringPrefix_To_v1alpha1_PeeringPrefix(in *core.PeeringPrefix
 	return autoConvert_core_PeeringPrefix_To_v1alpha1_PeeringPrefix(in, out, s)
 }
 
+func autoConvert_v1alpha1_PortForwarding_To_core_PortForwarding(in *corev1alpha1.PortForwarding, out *core.PortForwarding, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_PortForwardingSpec_To_core_PortForwardingSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PortForwarding_To_core_PortForwarding is an autogenerated conversion function.
+func Convert_v1alpha1_PortForwarding_To_core_PortForwarding(in *corev1alpha1.PortForwarding, out *core.PortForwarding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortForwarding_To_core_PortForwarding(in, out, s)
+}
+
+func autoConvert_core_PortForwarding_To_v1alpha1_PortForwarding(in *core.PortForwarding, out *corev1alpha1.PortForwarding, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_PortForwardingSpec_To_v1alpha1_PortForwardingSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_PortForwarding_To_v1alpha1_PortForwarding is an autogenerated conversion function.
+func Convert_core_PortForwarding_To_v1alpha1_PortForwarding(in *core.PortForwarding, out *corev1alpha1.PortForwarding, s conversion.Scope) error {
+	return autoConvert_core_PortForwarding_To_v1alpha1_PortForwarding(in, out, s)
+}
+
+func autoConvert_v1alpha1_PortForwardingList_To_core_PortForwardingList(in *corev1alpha1.PortForwardingList, out *core.PortForwardingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.PortForwarding)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_PortForwardingList_To_core_PortForwardingList is an autogenerated conversion function.
+func Convert_v1alpha1_PortForwardingList_To_core_PortForwardingList(in *corev1alpha1.PortForwardingList, out *core.PortForwardingList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortForwardingList_To_core_PortForwardingList(in, out, s)
+}
+
+func autoConvert_core_PortForwardingList_To_v1alpha1_PortForwardingList(in *core.PortForwardingList, out *corev1alpha1.PortForwardingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]corev1alpha1.PortForwarding)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_PortForwardingList_To_v1alpha1_PortForwardingList is an autogenerated conversion function.
+func Convert_core_PortForwardingList_To_v1alpha1_PortForwardingList(in *core.PortForwardingList, out *corev1alpha1.PortForwardingList, s conversion.Scope) error {
+	return autoConvert_core_PortForwardingList_To_v1alpha1_PortForwardingList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PortForwardingSpec_To_core_PortForwardingSpec(in *corev1alpha1.PortForwardingSpec, out *core.PortForwardingSpec, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.IP = in.IP
+	out.NetworkInterfaceRef = in.NetworkInterfaceRef
+	out.TargetIP = in.TargetIP
+	out.Protocol = (*corev1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.EndPort = (*int32)(unsafe.Pointer(in.EndPort))
+	out.TargetPort = (*int32)(unsafe.Pointer(in.TargetPort))
+	return nil
+}
+
+// Convert_v1alpha1_PortForwardingSpec_To_core_PortForwardingSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PortForwardingSpec_To_core_PortForwardingSpec(in *corev1alpha1.PortForwardingSpec, out *core.PortForwardingSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortForwardingSpec_To_core_PortForwardingSpec(in, out, s)
+}
+
+func autoConvert_core_PortForwardingSpec_To_v1alpha1_PortForwardingSpec(in *core.PortForwardingSpec, out *corev1alpha1.PortForwardingSpec, s conversion.Scope) error {
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.IP = in.IP
+	out.NetworkInterfaceRef = in.NetworkInterfaceRef
+	out.TargetIP = in.TargetIP
+	out.Protocol = (*corev1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.EndPort = (*int32)(unsafe.Pointer(in.EndPort))
+	out.TargetPort = (*int32)(unsafe.Pointer(in.TargetPort))
+	return nil
+}
+
+// Convert_core_PortForwardingSpec_To_v1alpha1_PortForwardingSpec is an autogenerated conversion function.
+func Convert_core_PortForwardingSpec_To_v1alpha1_PortForwardingSpec(in *core.PortForwardingSpec, out *corev1alpha1.PortForwardingSpec, s conversion.Scope) error {
+	return autoConvert_core_PortForwardingSpec_To_v1alpha1_PortForwardingSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_Rule_To_core_Rule(in *corev1alpha1.Rule, out *core.Rule, s conversion.Scope) error {
 	out.CIDRBlock = *(*[]core.IPBlock)(unsafe.Pointer(&in.CIDRBlock))
 	out.ObjectIPs = *(*[]core.ObjectIP)(unsafe.Pointer(&in.ObjectIPs))
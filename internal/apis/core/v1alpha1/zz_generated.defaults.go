@@ -21,6 +21,12 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&corev1alpha1.IPList{}, func(obj interface{}) { SetObjectDefaults_IPList(obj.(*corev1alpha1.IPList)) })
 	scheme.AddTypeDefaultingFunc(&corev1alpha1.LoadBalancer{}, func(obj interface{}) { SetObjectDefaults_LoadBalancer(obj.(*corev1alpha1.LoadBalancer)) })
 	scheme.AddTypeDefaultingFunc(&corev1alpha1.LoadBalancerList{}, func(obj interface{}) { SetObjectDefaults_LoadBalancerList(obj.(*corev1alpha1.LoadBalancerList)) })
+	scheme.AddTypeDefaultingFunc(&corev1alpha1.LoadBalancerRouting{}, func(obj interface{}) { SetObjectDefaults_LoadBalancerRouting(obj.(*corev1alpha1.LoadBalancerRouting)) })
+	scheme.AddTypeDefaultingFunc(&corev1alpha1.LoadBalancerRoutingList{}, func(obj interface{}) {
+		SetObjectDefaults_LoadBalancerRoutingList(obj.(*corev1alpha1.LoadBalancerRoutingList))
+	})
+	scheme.AddTypeDefaultingFunc(&corev1alpha1.NATGateway{}, func(obj interface{}) { SetObjectDefaults_NATGateway(obj.(*corev1alpha1.NATGateway)) })
+	scheme.AddTypeDefaultingFunc(&corev1alpha1.NATGatewayList{}, func(obj interface{}) { SetObjectDefaults_NATGatewayList(obj.(*corev1alpha1.NATGatewayList)) })
 	scheme.AddTypeDefaultingFunc(&corev1alpha1.NetworkInterface{}, func(obj interface{}) { SetObjectDefaults_NetworkInterface(obj.(*corev1alpha1.NetworkInterface)) })
 	scheme.AddTypeDefaultingFunc(&corev1alpha1.NetworkInterfaceList{}, func(obj interface{}) {
 		SetObjectDefaults_NetworkInterfaceList(obj.(*corev1alpha1.NetworkInterfaceList))
@@ -53,6 +59,33 @@ func SetObjectDefaults_LoadBalancerList(in *corev1alpha1.LoadBalancerList) {
 	}
 }
 
+func SetObjectDefaults_LoadBalancerRouting(in *corev1alpha1.LoadBalancerRouting) {
+	for i := range in.Destinations {
+		a := &in.Destinations[i]
+		SetDefaults_LoadBalancerDestination(a)
+	}
+}
+
+func SetObjectDefaults_LoadBalancerRoutingList(in *corev1alpha1.LoadBalancerRoutingList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_LoadBalancerRouting(a)
+	}
+}
+
+func SetObjectDefaults_NATGateway(in *corev1alpha1.NATGateway) {
+	if in.Spec.NAT64 != nil {
+		SetDefaults_NATGatewayNAT64(in.Spec.NAT64)
+	}
+}
+
+func SetObjectDefaults_NATGatewayList(in *corev1alpha1.NATGatewayList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_NATGateway(a)
+	}
+}
+
 func SetObjectDefaults_NetworkInterface(in *corev1alpha1.NetworkInterface) {
 	for i := range in.Spec.PublicIPs {
 		a := &in.Spec.PublicIPs[i]
@@ -6,6 +6,7 @@ package validation
 import (
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
 	"k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -36,6 +37,22 @@ func ValidateIPSpec(spec *core.IPSpec, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, ValidateIPMatchesFamily(spec.IP, spec.IPFamily, fldPath.Child("ip"))...)
 	}
 
+	if spec.PoolRef != nil {
+		if spec.PoolSelector != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("poolSelector"), "must not specify both poolRef and poolSelector"))
+		}
+		if spec.PoolRef.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("poolRef", "name"), "must specify pool ref name"))
+		} else {
+			for _, msg := range validation.NameIsDNSSubdomain(spec.PoolRef.Name, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("poolRef", "name"), spec.PoolRef.Name, msg))
+			}
+		}
+	}
+	if spec.PoolSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.PoolSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("poolSelector"))...)
+	}
+
 	return allErrs
 }
 
@@ -57,6 +74,8 @@ func ValidateIPSpecUpdate(newSpec, oldSpec *core.IPSpec, fldPath *field.Path) fi
 	if newSpec.IP != oldSpec.IP {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("ip"), newSpec.IP, validation.FieldImmutableErrorMsg))
 	}
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.PoolRef, oldSpec.PoolRef, fldPath.Child("poolRef"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.PoolSelector, oldSpec.PoolSelector, fldPath.Child("poolSelector"))...)
 
 	return allErrs
 }
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/netip"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateIPAddressHistory(ipAddressHistory *core.IPAddressHistory) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(ipAddressHistory, false, ValidateIPAddressName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateIPAddressHistorySpec(ipAddressHistory, &ipAddressHistory.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateIPAddressHistorySpec(ipAddressHistory *core.IPAddressHistory, spec *core.IPAddressHistorySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	ip, err := netip.ParseAddr(ipAddressHistory.Name)
+	if err == nil {
+		if spec.IP.Addr != ip {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ip"), spec.IP.Addr, "IP address must match name"))
+		}
+	}
+
+	for i := range spec.Leases {
+		lease := &spec.Leases[i]
+		fldPath := fldPath.Child("leases").Index(i)
+		allErrs = append(allErrs, validateIPAddressLease(lease, fldPath)...)
+
+		if lease.ReleasedTime == nil && i < len(spec.Leases)-1 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("releasedTime"), "only the last lease may be unreleased"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIPAddressLease(lease *core.IPAddressLease, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if lease.ClaimRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("claimRef", "name"), "must specify name"))
+	}
+	if lease.AllocatedTime.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("allocatedTime"), "must specify allocated time"))
+	}
+	if lease.ReleasedTime != nil && lease.ReleasedTime.Before(&lease.AllocatedTime) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("releasedTime"), lease.ReleasedTime, "must not be before allocated time"))
+	}
+
+	return allErrs
+}
+
+func ValidateIPAddressHistoryUpdate(newIPAddressHistory, oldIPAddressHistory *core.IPAddressHistory) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newIPAddressHistory, oldIPAddressHistory, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateIPAddressHistory(newIPAddressHistory)...)
+	allErrs = append(allErrs, ValidateIPAddressHistorySpecUpdate(&newIPAddressHistory.Spec, &oldIPAddressHistory.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateIPAddressHistorySpecUpdate(newSpec, oldSpec *core.IPAddressHistorySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if newSpec.IP != oldSpec.IP {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ip"), newSpec.IP, validation.FieldImmutableErrorMsg))
+	}
+	if !isIPAddressLeasesContinuation(newSpec.Leases, oldSpec.Leases) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("leases"), "leases may only be appended, released or pruned from the start"))
+	}
+
+	return allErrs
+}
+
+// isIPAddressLeasesContinuation reports whether newLeases result from oldLeases by pruning released
+// leases from the start, releasing leases and appending leases.
+func isIPAddressLeasesContinuation(newLeases, oldLeases []core.IPAddressLease) bool {
+	for pruned := 0; pruned <= len(oldLeases); pruned++ {
+		if pruned > 0 && oldLeases[pruned-1].ReleasedTime == nil {
+			// Unreleased leases must not be pruned.
+			return false
+		}
+
+		kept := oldLeases[pruned:]
+		if len(newLeases) < len(kept) {
+			continue
+		}
+
+		ok := true
+		for i := range kept {
+			if !isIPAddressLeaseContinuation(&newLeases[i], &kept[i]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isIPAddressLeaseContinuation(newLease, oldLease *core.IPAddressLease) bool {
+	if newLease.ClaimRef != oldLease.ClaimRef || !newLease.AllocatedTime.Equal(&oldLease.AllocatedTime) {
+		return false
+	}
+	if oldLease.ReleasedTime == nil {
+		return true
+	}
+	return newLease.ReleasedTime != nil && newLease.ReleasedTime.Equal(oldLease.ReleasedTime)
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("IPAddressHistory", func() {
+	var (
+		t0 = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		t1 = metav1.NewTime(t0.Add(time.Hour))
+		t2 = metav1.NewTime(t0.Add(2 * time.Hour))
+	)
+
+	lease := func(name string, allocated metav1.Time, released *metav1.Time) core.IPAddressLease {
+		return core.IPAddressLease{
+			ClaimRef:      core.IPAddressClaimRef{Group: "core.apinet.ironcore.dev", Resource: "ips", Namespace: "default", Name: name},
+			AllocatedTime: allocated,
+			ReleasedTime:  released,
+		}
+	}
+
+	DescribeTable("ValidateIPAddressHistory",
+		func(spec core.IPAddressHistorySpec, match types.GomegaMatcher) {
+			ipAddressHistory := &core.IPAddressHistory{
+				ObjectMeta: metav1.ObjectMeta{Name: "10.0.0.1"},
+				Spec:       spec,
+			}
+			allErrs := validation.ValidateIPAddressHistory(ipAddressHistory)
+			Expect(allErrs).To(match)
+		},
+		Entry("valid history",
+			core.IPAddressHistorySpec{
+				IP:     net.MustParseIP("10.0.0.1"),
+				Leases: []core.IPAddressLease{lease("foo", t0, &t1), lease("bar", t2, nil)},
+			},
+			BeEmpty(),
+		),
+		Entry("ip not matching the name",
+			core.IPAddressHistorySpec{IP: net.MustParseIP("10.0.0.2")},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ip"),
+			}))),
+		),
+		Entry("released before allocated",
+			core.IPAddressHistorySpec{
+				IP:     net.MustParseIP("10.0.0.1"),
+				Leases: []core.IPAddressLease{lease("foo", t1, &t0)},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.leases[0].releasedTime"),
+			}))),
+		),
+		Entry("unreleased lease that is not the last one",
+			core.IPAddressHistorySpec{
+				IP:     net.MustParseIP("10.0.0.1"),
+				Leases: []core.IPAddressLease{lease("foo", t0, nil), lease("bar", t1, nil)},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.leases[0].releasedTime"),
+			}))),
+		),
+	)
+
+	DescribeTable("ValidateIPAddressHistorySpecUpdate",
+		func(oldLeases, newLeases []core.IPAddressLease, match types.GomegaMatcher) {
+			oldSpec := &core.IPAddressHistorySpec{IP: net.MustParseIP("10.0.0.1"), Leases: oldLeases}
+			newSpec := &core.IPAddressHistorySpec{IP: net.MustParseIP("10.0.0.1"), Leases: newLeases}
+			allErrs := validation.ValidateIPAddressHistorySpecUpdate(newSpec, oldSpec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("releasing and appending a lease",
+			[]core.IPAddressLease{lease("foo", t0, nil)},
+			[]core.IPAddressLease{lease("foo", t0, &t1), lease("bar", t2, nil)},
+			BeEmpty(),
+		),
+		Entry("pruning released leases",
+			[]core.IPAddressLease{lease("foo", t0, &t1), lease("bar", t2, nil)},
+			[]core.IPAddressLease{lease("bar", t2, nil)},
+			BeEmpty(),
+		),
+		Entry("pruning an unreleased lease",
+			[]core.IPAddressLease{lease("foo", t0, nil)},
+			nil,
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.leases"),
+			}))),
+		),
+		Entry("rewriting an unreleased lease",
+			[]core.IPAddressLease{lease("foo", t0, nil)},
+			[]core.IPAddressLease{lease("bar", t0, nil)},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.leases"),
+			}))),
+		),
+		Entry("changing the released time of a kept lease",
+			[]core.IPAddressLease{lease("foo", t0, &t1), lease("bar", t2, nil)},
+			[]core.IPAddressLease{lease("foo", t0, &t2), lease("bar", t2, nil)},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.leases"),
+			}))),
+		),
+	)
+})
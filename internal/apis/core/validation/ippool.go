@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"go4.org/netipx"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateIPPool(ipPool *core.IPPool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(ipPool, false, validation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateIPPoolSpec(&ipPool.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateIPPoolSpec(spec *core.IPPoolSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateIPType(spec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, ValidateIPFamily(spec.IPFamily, fldPath.Child("ipFamily"))...)
+
+	if len(spec.Prefixes) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("prefixes"), "must specify at least one prefix"))
+	}
+
+	var bldr netipx.IPSetBuilder
+	for i, prefix := range spec.Prefixes {
+		fldPath := fldPath.Child("prefixes").Index(i)
+		if errs := validateIPPoolPrefix(prefix, spec.IPFamily, fldPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+
+		ipSet, _ := bldr.IPSet()
+		if ipSet.OverlapsPrefix(prefix.Prefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must not overlap with other prefixes"))
+		}
+		bldr.AddPrefix(prefix.Prefix)
+	}
+	poolSet, _ := bldr.IPSet()
+
+	var rangeBldr netipx.IPSetBuilder
+	validateRanges := func(ranges []net.IPPrefix, fldPath *field.Path) {
+		for i, prefix := range ranges {
+			fldPath := fldPath.Index(i)
+			if errs := validateIPPoolPrefix(prefix, spec.IPFamily, fldPath); len(errs) > 0 {
+				allErrs = append(allErrs, errs...)
+				continue
+			}
+			if !poolSet.ContainsPrefix(prefix.Prefix) {
+				allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must be contained in the pool prefixes"))
+				continue
+			}
+
+			rangeSet, _ := rangeBldr.IPSet()
+			if rangeSet.OverlapsPrefix(prefix.Prefix) {
+				allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must not overlap with other excluded or reserved ranges"))
+			}
+			rangeBldr.AddPrefix(prefix.Prefix)
+		}
+	}
+	validateRanges(spec.Excluded, fldPath.Child("excluded"))
+	validateRanges(spec.Reserved, fldPath.Child("reserved"))
+
+	if spec.AllocationStrategy != "" {
+		allErrs = append(allErrs, ValidateAllocationStrategy(spec.AllocationStrategy, fldPath.Child("allocationStrategy"))...)
+	}
+
+	if spec.Namespace != "" {
+		for _, msg := range validation.ValidateNamespaceName(spec.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), spec.Namespace, msg))
+		}
+	}
+
+	return allErrs
+}
+
+var AllocationStrategies = sets.New(
+	core.AllocationStrategyRandom,
+	core.AllocationStrategyLowestFree,
+	core.AllocationStrategySequentialAfterLast,
+	core.AllocationStrategyHashed,
+)
+
+func ValidateAllocationStrategy(strategy core.AllocationStrategy, fldPath *field.Path) field.ErrorList {
+	return ValidateEnum(AllocationStrategies, strategy, fldPath, "must specify allocation strategy")
+}
+
+func validateIPPoolPrefix(prefix net.IPPrefix, ipFamily corev1.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case !prefix.IsValid():
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify valid prefix"))
+	case prefix.Prefix != prefix.Masked():
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must specify masked prefix %s", prefix.Masked())))
+	case IPFamilies.Has(ipFamily) && prefix.Addr().Is4() != (ipFamily == corev1.IPv4Protocol):
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must match pool IP family %s", ipFamily)))
+	}
+
+	return allErrs
+}
+
+func ValidateIPPoolUpdate(newIPPool, oldIPPool *core.IPPool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newIPPool, oldIPPool, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateIPPool(newIPPool)...)
+	allErrs = append(allErrs, ValidateIPPoolSpecUpdate(&newIPPool.Spec, &oldIPPool.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateIPPoolSpecUpdate(newSpec, oldSpec *core.IPPoolSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.Type, oldSpec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.IPFamily, oldSpec.IPFamily, fldPath.Child("ipFamily"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.Namespace, oldSpec.Namespace, fldPath.Child("namespace"))...)
+
+	return allErrs
+}
+
+func ValidateIPPoolStatusUpdate(newIPPool, oldIPPool *core.IPPool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newIPPool, oldIPPool, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateIPPoolStatus(&newIPPool.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+func validateIPPoolStatus(status *core.IPPoolStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, prefix := range status.Prefixes {
+		fldPath := fldPath.Child("prefixes").Index(i)
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(prefix.Total, fldPath.Child("total"))...)
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(prefix.Used, fldPath.Child("used"))...)
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(prefix.Free, fldPath.Child("free"))...)
+	}
+
+	return allErrs
+}
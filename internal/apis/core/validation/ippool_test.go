@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("IPPool", func() {
+	DescribeTable("ValidateIPPoolSpec",
+		func(spec *core.IPPoolSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateIPPoolSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("valid pool",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{
+					net.MustParseIPPrefix("10.0.0.0/24"),
+					net.MustParseIPPrefix("10.0.1.0/24"),
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("no prefixes",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.prefixes"),
+			}))),
+		),
+		Entry("prefix of other IP family",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("2001:db8::/64")},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.prefixes[0]"),
+			}))),
+		),
+		Entry("overlapping prefixes",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{
+					net.MustParseIPPrefix("10.0.0.0/16"),
+					net.MustParseIPPrefix("10.0.1.0/24"),
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.prefixes[1]"),
+			}))),
+		),
+		Entry("valid excluded and reserved ranges",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				Excluded: []net.IPPrefix{
+					net.MustParseIPPrefix("10.0.0.0/32"),
+					net.MustParseIPPrefix("10.0.0.255/32"),
+				},
+				Reserved: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.16/28")},
+			},
+			BeEmpty(),
+		),
+		Entry("excluded range outside of prefixes",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				Excluded: []net.IPPrefix{net.MustParseIPPrefix("10.0.1.0/32")},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.excluded[0]"),
+			}))),
+		),
+		Entry("reserved range overlapping excluded range",
+			&core.IPPoolSpec{
+				Type:     core.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				Excluded: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/28")},
+				Reserved: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.8/29")},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.reserved[0]"),
+			}))),
+		),
+		Entry("valid allocation strategy",
+			&core.IPPoolSpec{
+				Type:               core.IPTypePublic,
+				IPFamily:           corev1.IPv4Protocol,
+				Prefixes:           []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				AllocationStrategy: core.AllocationStrategyLowestFree,
+			},
+			BeEmpty(),
+		),
+		Entry("unsupported allocation strategy",
+			&core.IPPoolSpec{
+				Type:               core.IPTypePublic,
+				IPFamily:           corev1.IPv4Protocol,
+				Prefixes:           []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				AllocationStrategy: "Compact",
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.allocationStrategy"),
+			}))),
+		),
+		Entry("valid namespace",
+			&core.IPPoolSpec{
+				Type:      core.IPTypePublic,
+				IPFamily:  corev1.IPv4Protocol,
+				Prefixes:  []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				Namespace: "tenant-a",
+			},
+			BeEmpty(),
+		),
+		Entry("invalid namespace",
+			&core.IPPoolSpec{
+				Type:      core.IPTypePublic,
+				IPFamily:  corev1.IPv4Protocol,
+				Prefixes:  []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				Namespace: "Tenant_A",
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.namespace"),
+			}))),
+		),
+		Entry("missing type",
+			&core.IPPoolSpec{
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.type"),
+			}))),
+		),
+	)
+
+	It("should forbid changing the namespace", func() {
+		oldIPPool := &core.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pool", ResourceVersion: "1"},
+			Spec: core.IPPoolSpec{
+				Type:      core.IPTypePublic,
+				IPFamily:  corev1.IPv4Protocol,
+				Prefixes:  []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				Namespace: "tenant-a",
+			},
+		}
+		newIPPool := oldIPPool.DeepCopy()
+		newIPPool.Spec.Namespace = "tenant-b"
+
+		Expect(validation.ValidateIPPoolUpdate(newIPPool, oldIPPool)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.namespace"),
+			})),
+		))
+	})
+
+	It("should forbid negative utilization counts on status update", func() {
+		oldIPPool := &core.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pool", ResourceVersion: "1"},
+		}
+		newIPPool := oldIPPool.DeepCopy()
+		newIPPool.Status.Prefixes = []core.IPPoolPrefixStatus{
+			{Prefix: net.MustParseIPPrefix("10.0.0.0/24"), Total: 256, Used: -1, Free: 257},
+		}
+
+		Expect(validation.ValidateIPPoolStatusUpdate(newIPPool, oldIPPool)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("status.prefixes[0].used"),
+			})),
+		))
+	})
+})
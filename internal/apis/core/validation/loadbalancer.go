@@ -4,7 +4,11 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -22,6 +26,67 @@ func ValidateLoadBalancerType(typ core.LoadBalancerType, fldPath *field.Path) fi
 	return ValidateEnum(LoadBalancerTypes, typ, fldPath, "must specify type")
 }
 
+var IPFamilyPolicies = sets.New(
+	corev1.IPFamilyPolicySingleStack,
+	corev1.IPFamilyPolicyPreferDualStack,
+	corev1.IPFamilyPolicyRequireDualStack,
+)
+
+func ValidateIPFamilyPolicy(ipFamilyPolicy corev1.IPFamilyPolicy, fldPath *field.Path) field.ErrorList {
+	return ValidateEnum(IPFamilyPolicies, ipFamilyPolicy, fldPath, "must specify IP family policy")
+}
+
+func validateLoadBalancerIPFamilies(spec *core.LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.IPFamilyPolicy != nil {
+		allErrs = append(allErrs, ValidateIPFamilyPolicy(*spec.IPFamilyPolicy, fldPath.Child("ipFamilyPolicy"))...)
+	}
+
+	seenIPFamilies := sets.New[corev1.IPFamily]()
+	for i, ipFamily := range spec.IPFamilies {
+		fldPath := fldPath.Child("ipFamilies").Index(i)
+		allErrs = append(allErrs, ValidateIPFamily(ipFamily, fldPath)...)
+		if seenIPFamilies.Has(ipFamily) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, ipFamily))
+		}
+		seenIPFamilies.Insert(ipFamily)
+	}
+
+	if spec.IPFamilyPolicy != nil {
+		switch *spec.IPFamilyPolicy {
+		case corev1.IPFamilyPolicySingleStack:
+			if len(spec.IPFamilies) > 1 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("ipFamilies"), spec.IPFamilies, "must specify at most one IP family for SingleStack"))
+			}
+		case corev1.IPFamilyPolicyRequireDualStack:
+			if len(spec.IPFamilies) != 2 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("ipFamilies"), spec.IPFamilies, "must specify two IP families for RequireDualStack"))
+			}
+		}
+	}
+
+	if len(spec.IPFamilies) == 0 {
+		return allErrs
+	}
+
+	coveredIPFamilies := sets.New[corev1.IPFamily]()
+	for i, ip := range spec.IPs {
+		if !slices.Contains(spec.IPFamilies, ip.IPFamily) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ips").Index(i).Child("ipFamily"), ip.IPFamily, fmt.Sprintf("IP family is not one of %v", spec.IPFamilies)))
+		}
+		coveredIPFamilies.Insert(ip.IPFamily)
+	}
+
+	for _, ipFamily := range spec.IPFamilies {
+		if !coveredIPFamilies.Has(ipFamily) {
+			allErrs = append(allErrs, field.Required(fldPath.Child("ips"), fmt.Sprintf("must specify an IP of family %s", ipFamily)))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateLoadBalancer(loadBalancer *core.LoadBalancer) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -44,6 +109,8 @@ func ValidateLoadBalancerSpec(spec *core.LoadBalancerSpec, fldPath *field.Path)
 		}
 	}
 
+	allErrs = append(allErrs, validateLoadBalancerIPFamilies(spec, fldPath)...)
+
 	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.Selector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector"))...)
 
 	if sel, err := metav1.LabelSelectorAsSelector(spec.Selector); err == nil {
@@ -72,6 +139,10 @@ func ValidateLoadBalancerSpecUpdate(newSpec, oldSpec *core.LoadBalancerSpec, fld
 	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.NetworkRef, oldSpec.NetworkRef, fldPath.Child("networkRef"))...)
 	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.Selector, oldSpec.Selector, fldPath.Child("selector"))...)
 
+	if len(newSpec.IPFamilies) > 0 && len(oldSpec.IPFamilies) > 0 {
+		allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.IPFamilies[0], oldSpec.IPFamilies[0], fldPath.Child("ipFamilies").Index(0))...)
+	}
+
 	return allErrs
 }
 
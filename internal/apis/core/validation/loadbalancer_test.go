@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("LoadBalancer", func() {
+	DescribeTable("ValidateLoadBalancerSpec",
+		func(spec *core.LoadBalancerSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateLoadBalancerSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("dual-stack load balancer",
+			&core.LoadBalancerSpec{
+				Type:           core.LoadBalancerTypePublic,
+				IPFamilyPolicy: new(corev1.IPFamilyPolicyRequireDualStack),
+				IPFamilies:     []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				IPs: []core.LoadBalancerIP{
+					{Name: "ipv4", IPFamily: corev1.IPv4Protocol, IP: net.MustParseIP("10.0.0.1")},
+					{Name: "ipv6", IPFamily: corev1.IPv6Protocol, IP: net.MustParseIP("2001:db8::1")},
+				},
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				Template: core.InstanceTemplate{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("invalid ip family policy",
+			&core.LoadBalancerSpec{
+				Type:           core.LoadBalancerTypeInternal,
+				IPFamilyPolicy: new(corev1.IPFamilyPolicy("Foo")),
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.ipFamilyPolicy"),
+			}))),
+		),
+		Entry("duplicate ip families",
+			&core.LoadBalancerSpec{
+				Type:       core.LoadBalancerTypeInternal,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv4Protocol},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.ipFamilies[1]"),
+			}))),
+		),
+		Entry("single-stack load balancer with two ip families",
+			&core.LoadBalancerSpec{
+				Type:           core.LoadBalancerTypeInternal,
+				IPFamilyPolicy: new(corev1.IPFamilyPolicySingleStack),
+				IPFamilies:     []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ipFamilies"),
+			}))),
+		),
+		Entry("require dual-stack load balancer with one ip family",
+			&core.LoadBalancerSpec{
+				Type:           core.LoadBalancerTypeInternal,
+				IPFamilyPolicy: new(corev1.IPFamilyPolicyRequireDualStack),
+				IPFamilies:     []corev1.IPFamily{corev1.IPv4Protocol},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ipFamilies"),
+			}))),
+		),
+		Entry("ip of family not in ip families",
+			&core.LoadBalancerSpec{
+				Type:       core.LoadBalancerTypeInternal,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				IPs: []core.LoadBalancerIP{
+					{Name: "ipv6", IPFamily: corev1.IPv6Protocol, IP: net.MustParseIP("2001:db8::1")},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ips[0].ipFamily"),
+			}))),
+		),
+		Entry("public load balancer missing ip of family",
+			&core.LoadBalancerSpec{
+				Type:       core.LoadBalancerTypePublic,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				IPs: []core.LoadBalancerIP{
+					{Name: "ipv4", IPFamily: corev1.IPv4Protocol, IP: net.MustParseIP("10.0.0.1")},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.ips"),
+			}))),
+		),
+		Entry("internal load balancer missing ip of family",
+			&core.LoadBalancerSpec{
+				Type:       core.LoadBalancerTypeInternal,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				IPs: []core.LoadBalancerIP{
+					{Name: "ipv4", IPFamily: corev1.IPv4Protocol, IP: net.MustParseIP("10.0.0.1")},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.ips"),
+			}))),
+		),
+	)
+
+	DescribeTable("ValidateLoadBalancerSpecUpdate",
+		func(oldSpec, newSpec *core.LoadBalancerSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateLoadBalancerSpecUpdate(newSpec, oldSpec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("adding a secondary ip family",
+			&core.LoadBalancerSpec{IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol}},
+			&core.LoadBalancerSpec{IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}},
+			BeEmpty(),
+		),
+		Entry("primary ip family update",
+			&core.LoadBalancerSpec{IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol}},
+			&core.LoadBalancerSpec{IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol}},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.ipFamilies[0]"),
+				"Detail": Equal(apivalidation.FieldImmutableErrorMsg),
+			}))),
+		),
+	)
+})
@@ -6,14 +6,50 @@ package validation
 import (
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
 	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+var LoadBalancerDestinationStates = sets.New(
+	core.LoadBalancerDestinationStateActive,
+	core.LoadBalancerDestinationStateDraining,
+)
+
+func ValidateLoadBalancerDestinationState(state core.LoadBalancerDestinationState, fldPath *field.Path) field.ErrorList {
+	return ValidateEnum(LoadBalancerDestinationStates, state, fldPath, "must specify state")
+}
+
 func ValidateLoadBalancerRouting(loadBalancerRouting *core.LoadBalancerRouting) field.ErrorList {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(loadBalancerRouting, true, validation.NameIsDNSLabel, field.NewPath("metadata"))...)
 
+	for i := range loadBalancerRouting.Destinations {
+		allErrs = append(allErrs, ValidateLoadBalancerDestination(&loadBalancerRouting.Destinations[i], field.NewPath("destinations").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func ValidateLoadBalancerDestination(dst *core.LoadBalancerDestination, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateLoadBalancerDestinationState(dst.State, fldPath.Child("state"))...)
+
+	if core.IsLoadBalancerDestinationDraining(dst) {
+		if dst.DrainingTimestamp == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("drainingTimestamp"), "must specify draining timestamp for draining destination"))
+		}
+	} else {
+		if dst.DrainingTimestamp != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("drainingTimestamp"), "must not specify draining timestamp for non-draining destination"))
+		}
+	}
+
+	if dst.DrainTimeout != nil && dst.DrainTimeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), dst.DrainTimeout.Duration.String(), "must not be negative"))
+	}
+
 	return allErrs
 }
 
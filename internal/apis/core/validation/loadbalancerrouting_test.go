@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("LoadBalancerRouting", func() {
+	DescribeTable("ValidateLoadBalancerDestination",
+		func(dst *core.LoadBalancerDestination, match types.GomegaMatcher) {
+			allErrs := validation.ValidateLoadBalancerDestination(dst, field.NewPath("destinations").Index(0))
+			Expect(allErrs).To(match)
+		},
+		Entry("active destination",
+			&core.LoadBalancerDestination{
+				IP:    net.MustParseIP("10.0.0.1"),
+				State: core.LoadBalancerDestinationStateActive,
+			},
+			BeEmpty(),
+		),
+		Entry("draining destination",
+			&core.LoadBalancerDestination{
+				IP:                net.MustParseIP("10.0.0.1"),
+				State:             core.LoadBalancerDestinationStateDraining,
+				DrainingTimestamp: &metav1.Time{Time: time.Unix(0, 0)},
+				DrainTimeout:      &metav1.Duration{Duration: time.Minute},
+			},
+			BeEmpty(),
+		),
+		Entry("draining destination without draining timestamp",
+			&core.LoadBalancerDestination{
+				IP:    net.MustParseIP("10.0.0.1"),
+				State: core.LoadBalancerDestinationStateDraining,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("destinations[0].drainingTimestamp"),
+			}))),
+		),
+		Entry("active destination with draining timestamp",
+			&core.LoadBalancerDestination{
+				IP:                net.MustParseIP("10.0.0.1"),
+				State:             core.LoadBalancerDestinationStateActive,
+				DrainingTimestamp: &metav1.Time{Time: time.Unix(0, 0)},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("destinations[0].drainingTimestamp"),
+			}))),
+		),
+		Entry("negative drain timeout",
+			&core.LoadBalancerDestination{
+				IP:           net.MustParseIP("10.0.0.1"),
+				State:        core.LoadBalancerDestinationStateActive,
+				DrainTimeout: &metav1.Duration{Duration: -time.Second},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("destinations[0].drainTimeout"),
+			}))),
+		),
+	)
+})
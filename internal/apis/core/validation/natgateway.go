@@ -4,8 +4,14 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -22,12 +28,128 @@ func ValidateNATGatewaySpec(spec *core.NATGatewaySpec, fldPath *field.Path) fiel
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, ValidateIPFamily(spec.IPFamily, fldPath.Child("ipFamily"))...)
+	allErrs = append(allErrs, validateNATGatewayIPFamilies(spec, fldPath)...)
 
 	for i, ip := range spec.IPs {
 		fldPath := fldPath.Child("ips").Index(i)
+		allErrs = append(allErrs, ValidateIPFamily(ip.IPFamily, fldPath.Child("ipFamily"))...)
+		if ip.IPFamily != "" && !slices.Contains(spec.IPFamilies, ip.IPFamily) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ipFamily"), ip.IPFamily, fmt.Sprintf("IP family is not one of %v", spec.IPFamilies)))
+		}
 		if ip.IP.IsValid() {
-			allErrs = append(allErrs, ValidateIPMatchesFamily(ip.IP, spec.IPFamily, fldPath.Child("ip"))...)
+			allErrs = append(allErrs, ValidateIPMatchesFamily(ip.IP, ip.IPFamily, fldPath.Child("ip"))...)
+		}
+	}
+
+	if spec.NetworkInterfaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.NetworkInterfaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("networkInterfaceSelector"))...)
+	}
+
+	for i, override := range spec.PortsPerNetworkInterfaceOverrides {
+		fldPath := fldPath.Child("portsPerNetworkInterfaceOverrides").Index(i)
+		allErrs = append(allErrs, validateNATGatewayPortsOverride(spec, &override, fldPath)...)
+	}
+
+	if expansion := spec.PortBlockExpansion; expansion != nil {
+		allErrs = append(allErrs, validateNATGatewayPortBlockExpansion(expansion, fldPath.Child("portBlockExpansion"))...)
+	}
+
+	if ipDrainPeriod := spec.IPDrainPeriod; ipDrainPeriod != nil && ipDrainPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipDrainPeriod"), ipDrainPeriod.Duration.String(), "must not be negative"))
+	}
+
+	if spec.NAT64 != nil {
+		allErrs = append(allErrs, validateNATGatewayNAT64(spec, fldPath)...)
+	}
+
+	if stickyReservations := spec.StickyReservations; stickyReservations != nil {
+		if retentionPeriod := stickyReservations.RetentionPeriod; retentionPeriod != nil && retentionPeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("stickyReservations", "retentionPeriod"), retentionPeriod.Duration.String(), "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// supportedNAT64Prefixes are the NAT64 prefixes the data plane translates. metalnet does not receive
+// the prefix of a NAT gateway, so only the prefix it uses is supported.
+var supportedNAT64Prefixes = sets.New(core.NATGatewayNAT64WellKnownPrefix)
+
+func validateNATGatewayNAT64(spec *core.NATGatewaySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !slices.Equal(spec.IPFamilies, []corev1.IPFamily{corev1.IPv4Protocol}) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipFamilies"), spec.IPFamilies, fmt.Sprintf("NAT64 NAT gateway must only serve %s", corev1.IPv4Protocol)))
+	}
+
+	fldPath = fldPath.Child("nat64")
+	prefix := spec.NAT64.Prefix
+	switch {
+	case !prefix.IsValid():
+		allErrs = append(allErrs, field.Required(fldPath.Child("prefix"), "must specify prefix"))
+	case !supportedNAT64Prefixes.Has(prefix.String()):
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("prefix"), prefix, sets.List(supportedNAT64Prefixes)))
+	}
+
+	return allErrs
+}
+
+func validateNATGatewayPortBlockExpansion(expansion *core.NATGatewayPortBlockExpansion, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateNonnegativeField(int64(expansion.MaxExtraPortBlocks), fldPath.Child("maxExtraPortBlocks"))...)
+	if coolDownPeriod := expansion.CoolDownPeriod; coolDownPeriod != nil && coolDownPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("coolDownPeriod"), coolDownPeriod.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+// maxNATGatewayPortsPerNetworkInterface is the number of ephemeral ports of a NAT IP.
+const maxNATGatewayPortsPerNetworkInterface = 65535 + 1 - 1024
+
+func validateNATGatewayPortsOverride(spec *core.NATGatewaySpec, override *core.NATGatewayPortsOverride, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if override.NetworkInterfaceSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkInterfaceSelector"), "must specify network interface selector"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(override.NetworkInterfaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("networkInterfaceSelector"))...)
+	}
+
+	ports := override.PortsPerNetworkInterface
+	switch {
+	case ports <= 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("portsPerNetworkInterface"), ports, "must be greater than zero"))
+	case ports > maxNATGatewayPortsPerNetworkInterface:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("portsPerNetworkInterface"), ports, fmt.Sprintf("must not exceed %d", maxNATGatewayPortsPerNetworkInterface)))
+	case spec.PortsPerNetworkInterface > 0 && ports%spec.PortsPerNetworkInterface != 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("portsPerNetworkInterface"), ports, fmt.Sprintf("must be a multiple of spec.portsPerNetworkInterface %d", spec.PortsPerNetworkInterface)))
+	}
+
+	return allErrs
+}
+
+func validateNATGatewayIPFamilies(spec *core.NATGatewaySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(spec.IPFamilies) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ipFamilies"), "must specify at least one IP family"))
+		return allErrs
+	}
+
+	seenIPFamilies := sets.New[corev1.IPFamily]()
+	for i, ipFamily := range spec.IPFamilies {
+		fldPath := fldPath.Child("ipFamilies").Index(i)
+		allErrs = append(allErrs, ValidateIPFamily(ipFamily, fldPath)...)
+		if seenIPFamilies.Has(ipFamily) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, ipFamily))
 		}
+		seenIPFamilies.Insert(ipFamily)
+	}
+
+	if spec.IPFamily != "" && spec.IPFamilies[0] != spec.IPFamily {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipFamilies").Index(0), spec.IPFamilies[0], fmt.Sprintf("must match the primary IP family %s", spec.IPFamily)))
 	}
 
 	return allErrs
@@ -48,6 +170,7 @@ func ValidateNATGatewaySpecUpdate(newSpec, oldSpec *core.NATGatewaySpec, fldPath
 
 	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.IPFamily, oldSpec.IPFamily, fldPath.Child("ipFamily"))...)
 	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.NetworkRef, oldSpec.NetworkRef, fldPath.Child("networkRef"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.NAT64, oldSpec.NAT64, fldPath.Child("nat64"))...)
 
 	return allErrs
 }
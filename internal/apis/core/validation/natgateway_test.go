@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NATGateway", func() {
+	DescribeTable("ValidateNATGatewaySpec",
+		func(spec *core.NATGatewaySpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateNATGatewaySpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("valid dual-stack NAT gateway",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				IPs: []core.NATGatewayIP{
+					{Name: "ip-v4", IPFamily: corev1.IPv4Protocol},
+					{Name: "ip-v6", IPFamily: corev1.IPv6Protocol, IP: net.MustParseIP("2001:db8::1")},
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("missing IP families",
+			&core.NATGatewaySpec{
+				IPFamily: corev1.IPv4Protocol,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.ipFamilies"),
+			}))),
+		),
+		Entry("duplicate IP families",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv4Protocol},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.ipFamilies[1]"),
+			}))),
+		),
+		Entry("first IP family not matching the primary IP family",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ipFamilies[0]"),
+			}))),
+		),
+		Entry("valid network interface selector",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NetworkInterfaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "partner"},
+				},
+				Priority: 10,
+			},
+			BeEmpty(),
+		),
+		Entry("invalid network interface selector",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NetworkInterfaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "invalid value!"},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networkInterfaceSelector.matchLabels"),
+			}))),
+		),
+		Entry("IP of an IP family not served by the NAT gateway",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				IPs: []core.NATGatewayIP{
+					{Name: "ip-v6", IPFamily: corev1.IPv6Protocol},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ips[0].ipFamily"),
+			}))),
+		),
+		Entry("IP not matching its IP family",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				IPs: []core.NATGatewayIP{
+					{Name: "ip-v6", IPFamily: corev1.IPv6Protocol, IP: net.MustParseIP("10.0.0.1")},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ips[0].ip"),
+			}))),
+		),
+		Entry("valid ports per network interface override",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				PortsPerNetworkInterfaceOverrides: []core.NATGatewayPortsOverride{
+					{
+						NetworkInterfaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "high"}},
+						PortsPerNetworkInterface: 256,
+					},
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("ports per network interface override not being a multiple of the ports per network interface",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				PortsPerNetworkInterfaceOverrides: []core.NATGatewayPortsOverride{
+					{
+						NetworkInterfaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "high"}},
+						PortsPerNetworkInterface: 100,
+					},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.portsPerNetworkInterfaceOverrides[0].portsPerNetworkInterface"),
+			}))),
+		),
+		Entry("ports per network interface override without network interface selector",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				PortsPerNetworkInterfaceOverrides: []core.NATGatewayPortsOverride{
+					{PortsPerNetworkInterface: 128},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.portsPerNetworkInterfaceOverrides[0].networkInterfaceSelector"),
+			}))),
+		),
+		Entry("negative max extra port blocks",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				PortBlockExpansion:       &core.NATGatewayPortBlockExpansion{MaxExtraPortBlocks: -1},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.portBlockExpansion.maxExtraPortBlocks"),
+			}))),
+		),
+		Entry("negative port block cool-down period",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				PortBlockExpansion: &core.NATGatewayPortBlockExpansion{
+					MaxExtraPortBlocks: 2,
+					CoolDownPeriod:     &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.portBlockExpansion.coolDownPeriod"),
+			}))),
+		),
+		Entry("negative IP drain period",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				IPDrainPeriod:            &metav1.Duration{Duration: -time.Minute},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ipDrainPeriod"),
+			}))),
+		),
+		Entry("negative sticky reservation retention period",
+			&core.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol},
+				PortsPerNetworkInterface: 64,
+				StickyReservations: &core.NATGatewayStickyReservations{
+					RetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.stickyReservations.retentionPeriod"),
+			}))),
+		),
+		Entry("valid NAT64 NAT gateway",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NAT64:      &core.NATGatewayNAT64{Prefix: net.MustParseIPPrefix("64:ff9b::/96")},
+			},
+			BeEmpty(),
+		),
+		Entry("NAT64 NAT gateway serving IPv6",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				NAT64:      &core.NATGatewayNAT64{Prefix: net.MustParseIPPrefix("64:ff9b::/96")},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ipFamilies"),
+			}))),
+		),
+		Entry("NAT64 NAT gateway without prefix",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NAT64:      &core.NATGatewayNAT64{},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.nat64.prefix"),
+			}))),
+		),
+		Entry("NAT64 prefix other than the well-known prefix",
+			&core.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				NAT64:      &core.NATGatewayNAT64{Prefix: net.MustParseIPPrefix("2001:db8:64::/96")},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.nat64.prefix"),
+			}))),
+		),
+	)
+})
@@ -32,6 +32,47 @@ func ValidateNATGatewayAutoscalerSpec(spec *core.NATGatewayAutoscalerSpec, fldPa
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPublicIPs"), maxPublicIPs, "must >= minPublicIPs"))
 	}
 
+	if targetUtilizationPercentage := spec.TargetUtilizationPercentage; targetUtilizationPercentage != nil {
+		if *targetUtilizationPercentage < 1 || *targetUtilizationPercentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetUtilizationPercentage"), *targetUtilizationPercentage, "must be between 1 and 100"))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateNonnegativeField(int64(generic.DerefZero(spec.SpareSlots)), fldPath.Child("spareSlots"))...)
+
+	if behavior := spec.Behavior; behavior != nil {
+		allErrs = append(allErrs, validateNATGatewayAutoscalerBehavior(behavior, fldPath.Child("behavior"))...)
+	}
+
+	return allErrs
+}
+
+func validateNATGatewayAutoscalerBehavior(behavior *core.NATGatewayAutoscalerBehavior, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if scaleUp := behavior.ScaleUp; scaleUp != nil {
+		allErrs = append(allErrs, validateNATGatewayAutoscalerScalingRules(scaleUp, fldPath.Child("scaleUp"))...)
+	}
+	if scaleDown := behavior.ScaleDown; scaleDown != nil {
+		allErrs = append(allErrs, validateNATGatewayAutoscalerScalingRules(scaleDown, fldPath.Child("scaleDown"))...)
+	}
+
+	return allErrs
+}
+
+func validateNATGatewayAutoscalerScalingRules(rules *core.NATGatewayAutoscalerScalingRules, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if stabilizationWindow := rules.StabilizationWindow; stabilizationWindow != nil && stabilizationWindow.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("stabilizationWindow"), stabilizationWindow.Duration.String(), "must not be negative"))
+	}
+	if maxIPsPerStep := rules.MaxIPsPerStep; maxIPsPerStep != nil && *maxIPsPerStep <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxIPsPerStep"), *maxIPsPerStep, "must be greater than 0"))
+	}
+	if stepPeriod := rules.StepPeriod; stepPeriod != nil && stepPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("stepPeriod"), stepPeriod.Duration.String(), "must not be negative"))
+	}
+
 	return allErrs
 }
 
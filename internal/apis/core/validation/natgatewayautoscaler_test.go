@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NATGatewayAutoscaler", func() {
+	DescribeTable("ValidateNATGatewayAutoscalerSpec",
+		func(spec *core.NATGatewayAutoscalerSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateNATGatewayAutoscalerSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("valid behavior",
+			&core.NATGatewayAutoscalerSpec{
+				NATGatewayRef:               corev1.LocalObjectReference{Name: "nat-gateway"},
+				MinPublicIPs:                new(int32(1)),
+				MaxPublicIPs:                new(int32(4)),
+				TargetUtilizationPercentage: new(int32(80)),
+				SpareSlots:                  new(int32(2)),
+				Behavior: &core.NATGatewayAutoscalerBehavior{
+					ScaleUp: &core.NATGatewayAutoscalerScalingRules{
+						MaxIPsPerStep: new(int32(1)),
+						StepPeriod:    &metav1.Duration{Duration: time.Minute},
+					},
+					ScaleDown: &core.NATGatewayAutoscalerScalingRules{
+						StabilizationWindow: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("target utilization percentage out of range",
+			&core.NATGatewayAutoscalerSpec{
+				NATGatewayRef:               corev1.LocalObjectReference{Name: "nat-gateway"},
+				TargetUtilizationPercentage: new(int32(120)),
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.targetUtilizationPercentage"),
+			}))),
+		),
+		Entry("negative spare slots",
+			&core.NATGatewayAutoscalerSpec{
+				NATGatewayRef: corev1.LocalObjectReference{Name: "nat-gateway"},
+				SpareSlots:    new(int32(-1)),
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.spareSlots"),
+			}))),
+		),
+		Entry("zero max IPs per step",
+			&core.NATGatewayAutoscalerSpec{
+				NATGatewayRef: corev1.LocalObjectReference{Name: "nat-gateway"},
+				Behavior: &core.NATGatewayAutoscalerBehavior{
+					ScaleUp: &core.NATGatewayAutoscalerScalingRules{
+						MaxIPsPerStep: new(int32(0)),
+					},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.behavior.scaleUp.maxIPsPerStep"),
+			}))),
+		),
+		Entry("negative stabilization window",
+			&core.NATGatewayAutoscalerSpec{
+				NATGatewayRef: corev1.LocalObjectReference{Name: "nat-gateway"},
+				Behavior: &core.NATGatewayAutoscalerBehavior{
+					ScaleDown: &core.NATGatewayAutoscalerScalingRules{
+						StabilizationWindow: &metav1.Duration{Duration: -time.Second},
+					},
+				},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.behavior.scaleDown.stabilizationWindow"),
+			}))),
+		),
+	)
+})
@@ -4,7 +4,10 @@
 package validation
 
 import (
+	"fmt"
+
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"go4.org/netipx"
 	"k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -21,8 +24,24 @@ func ValidateNetwork(network *core.Network) field.ErrorList {
 func ValidateNetworkSpec(spec *core.NetworkSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
-	_ = spec
-	_ = fldPath
+	var bldr netipx.IPSetBuilder
+	for i, prefix := range spec.Prefixes {
+		fldPath := fldPath.Child("prefixes").Index(i)
+		if !prefix.IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify valid prefix"))
+			continue
+		}
+		if prefix.Prefix != prefix.Masked() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must specify masked prefix %s", prefix.Masked())))
+			continue
+		}
+
+		ipSet, _ := bldr.IPSet()
+		if ipSet.OverlapsPrefix(prefix.Prefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must not overlap with other prefixes"))
+		}
+		bldr.AddPrefix(prefix.Prefix)
+	}
 
 	return allErrs
 }
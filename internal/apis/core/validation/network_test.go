@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Network", func() {
+	DescribeTable("ValidateNetworkSpec",
+		func(spec *core.NetworkSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateNetworkSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("valid prefixes",
+			&core.NetworkSpec{Prefixes: []net.IPPrefix{
+				net.MustParseIPPrefix("10.0.0.0/24"),
+				net.MustParseIPPrefix("10.0.1.0/24"),
+				net.MustParseIPPrefix("fd00::/64"),
+			}},
+			BeEmpty(),
+		),
+		Entry("unmasked prefix",
+			&core.NetworkSpec{Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.1/24")}},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.prefixes[0]"),
+			}))),
+		),
+		Entry("overlapping prefixes",
+			&core.NetworkSpec{Prefixes: []net.IPPrefix{
+				net.MustParseIPPrefix("10.0.0.0/16"),
+				net.MustParseIPPrefix("10.0.1.0/24"),
+			}},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.prefixes[1]"),
+			}))),
+		),
+	)
+})
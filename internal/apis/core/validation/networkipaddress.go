@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateNetworkIPAddressName(name string, prefix bool) []string {
+	networkName, addr, ok := strings.Cut(name, ".")
+	if !ok {
+		return []string{"must be of the form <network-name>.<ip>"}
+	}
+
+	errs := validation.NameIsDNSLabel(networkName, false)
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else if ip.String() != addr {
+		errs = append(errs, "not a valid IP address in canonical format")
+	}
+	return errs
+}
+
+func ValidateNetworkIPAddress(networkIPAddress *core.NetworkIPAddress) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(networkIPAddress, true, ValidateNetworkIPAddressName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateNetworkIPAddressSpec(networkIPAddress, &networkIPAddress.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateNetworkIPAddressSpec(networkIPAddress *core.NetworkIPAddress, spec *core.NetworkIPAddressSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.NetworkRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkRef", "name"), "must specify network ref"))
+	}
+
+	if !spec.IP.IsValid() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ip"), "must specify IP"))
+	} else if spec.NetworkRef.Name != "" && networkIPAddress.Name != spec.NetworkRef.Name+"."+spec.IP.String() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ip"), spec.IP, "network ref and IP address must match name"))
+	}
+
+	if spec.ClaimRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("claimRef", "name"), "must specify name"))
+	}
+	if spec.ClaimRef.UID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("claimRef", "uid"), "must specify uid"))
+	}
+
+	return allErrs
+}
+
+func ValidateNetworkIPAddressUpdate(newNetworkIPAddress, oldNetworkIPAddress *core.NetworkIPAddress) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newNetworkIPAddress, oldNetworkIPAddress, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateNetworkIPAddress(newNetworkIPAddress)...)
+	allErrs = append(allErrs, ValidateNetworkIPAddressSpecUpdate(&newNetworkIPAddress.Spec, &oldNetworkIPAddress.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateNetworkIPAddressSpecUpdate(newSpec, oldSpec *core.NetworkIPAddressSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.NetworkRef, oldSpec.NetworkRef, fldPath.Child("networkRef"))...)
+	if newSpec.IP != oldSpec.IP {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ip"), newSpec.IP, validation.FieldImmutableErrorMsg))
+	}
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.ClaimRef, oldSpec.ClaimRef, fldPath.Child("claimRef"))...)
+
+	return allErrs
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NetworkIPAddress", func() {
+	claimRef := core.IPAddressClaimRef{
+		Group:     "core.apinet.ironcore.dev",
+		Resource:  "networkinterfaces",
+		Namespace: "default",
+		Name:      "my-nic",
+		UID:       "my-uid",
+	}
+
+	DescribeTable("ValidateNetworkIPAddress",
+		func(name string, spec core.NetworkIPAddressSpec, match types.GomegaMatcher) {
+			networkIPAddress := &core.NetworkIPAddress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+				Spec:       spec,
+			}
+			allErrs := validation.ValidateNetworkIPAddress(networkIPAddress)
+			Expect(allErrs).To(match)
+		},
+		Entry("valid IPv4 address",
+			"my-network.10.0.0.1",
+			core.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IP:         net.MustParseIP("10.0.0.1"),
+				ClaimRef:   claimRef,
+			},
+			BeEmpty(),
+		),
+		Entry("valid IPv6 address",
+			"my-network.2001:db8::1",
+			core.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IP:         net.MustParseIP("2001:db8::1"),
+				ClaimRef:   claimRef,
+			},
+			BeEmpty(),
+		),
+		Entry("name without address",
+			"my-network",
+			core.NetworkIPAddressSpec{},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.name"),
+			}))),
+		),
+		Entry("non-canonical address in name",
+			"my-network.2001:0db8::1",
+			core.NetworkIPAddressSpec{},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.name"),
+			}))),
+		),
+		Entry("ip not matching the name",
+			"my-network.10.0.0.1",
+			core.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IP:         net.MustParseIP("10.0.0.2"),
+				ClaimRef:   claimRef,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ip"),
+			}))),
+		),
+		Entry("network not matching the name",
+			"my-network.10.0.0.1",
+			core.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "other-network"},
+				IP:         net.MustParseIP("10.0.0.1"),
+				ClaimRef:   claimRef,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ip"),
+			}))),
+		),
+		Entry("claim ref without uid",
+			"my-network.10.0.0.1",
+			core.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IP:         net.MustParseIP("10.0.0.1"),
+				ClaimRef:   core.IPAddressClaimRef{Name: "my-nic"},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.claimRef.uid"),
+			}))),
+		),
+	)
+
+	It("should forbid changing the claim ref", func() {
+		oldNetworkIPAddress := &core.NetworkIPAddress{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-network.10.0.0.1", ResourceVersion: "1"},
+			Spec: core.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IP:         net.MustParseIP("10.0.0.1"),
+				ClaimRef:   claimRef,
+			},
+		}
+		newNetworkIPAddress := oldNetworkIPAddress.DeepCopy()
+		newNetworkIPAddress.Spec.ClaimRef.UID = "other-uid"
+
+		Expect(validation.ValidateNetworkIPAddressUpdate(newNetworkIPAddress, oldNetworkIPAddress)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.claimRef"),
+		}))))
+	})
+})
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var NetworkQuotaResourceNames = sets.New(
+	core.NetworkQuotaResourceIPv4IPs,
+	core.NetworkQuotaResourceIPv6IPs,
+	core.NetworkQuotaResourceNetworks,
+	core.NetworkQuotaResourceLoadBalancers,
+	core.NetworkQuotaResourceNATGatewayIPs,
+	core.NetworkQuotaResourceNetworkInterfacePublicIPs,
+)
+
+func ValidateNetworkQuota(networkQuota *core.NetworkQuota) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(networkQuota, true, validation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateNetworkQuotaSpec(&networkQuota.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateNetworkQuotaSpec(spec *core.NetworkQuotaSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for name, limit := range spec.Hard {
+		fldPath := fldPath.Child("hard").Key(string(name))
+		allErrs = append(allErrs, ValidateEnum(NetworkQuotaResourceNames, name, fldPath, "must specify resource name")...)
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(limit, fldPath)...)
+	}
+
+	return allErrs
+}
+
+func ValidateNetworkQuotaUpdate(newNetworkQuota, oldNetworkQuota *core.NetworkQuota) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newNetworkQuota, oldNetworkQuota, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateNetworkQuota(newNetworkQuota)...)
+
+	return allErrs
+}
+
+func ValidateNetworkQuotaStatusUpdate(newNetworkQuota, oldNetworkQuota *core.NetworkQuota) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newNetworkQuota, oldNetworkQuota, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateNetworkQuotaStatus(&newNetworkQuota.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+func validateNetworkQuotaStatus(status *core.NetworkQuotaStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for name, used := range status.Used {
+		fldPath := fldPath.Child("used").Key(string(name))
+		allErrs = append(allErrs, ValidateEnum(NetworkQuotaResourceNames, name, fldPath, "must specify resource name")...)
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(used, fldPath)...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NetworkQuota", func() {
+	DescribeTable("ValidateNetworkQuotaSpec",
+		func(spec *core.NetworkQuotaSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidateNetworkQuotaSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("valid quota",
+			&core.NetworkQuotaSpec{
+				Hard: map[core.NetworkQuotaResourceName]int64{
+					core.NetworkQuotaResourceIPv4IPs:  10,
+					core.NetworkQuotaResourceNetworks: 0,
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("unknown resource",
+			&core.NetworkQuotaSpec{
+				Hard: map[core.NetworkQuotaResourceName]int64{"ips": 10},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.hard[ips]"),
+			}))),
+		),
+		Entry("negative limit",
+			&core.NetworkQuotaSpec{
+				Hard: map[core.NetworkQuotaResourceName]int64{core.NetworkQuotaResourceLoadBalancers: -1},
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.hard[loadbalancers]"),
+			}))),
+		),
+	)
+	It("should reject negative usage on status update", func() {
+		oldNetworkQuota := &core.NetworkQuota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "quota", ResourceVersion: "1"},
+		}
+		newNetworkQuota := oldNetworkQuota.DeepCopy()
+		newNetworkQuota.Status.Used = map[core.NetworkQuotaResourceName]int64{core.NetworkQuotaResourceIPv4IPs: -1}
+
+		Expect(validation.ValidateNetworkQuotaStatusUpdate(newNetworkQuota, oldNetworkQuota)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("status.used[ips.ipv4]"),
+			})),
+		))
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/api/validation"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxPortForwardingPorts is the maximum number of ports a port forwarding may forward.
+// Every forwarded port is programmed as a separate port of the data plane load balancer.
+const maxPortForwardingPorts = 1024
+
+func ValidatePortForwarding(portForwarding *core.PortForwarding) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(portForwarding, true, validation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidatePortForwardingSpec(&portForwarding.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validatePortNum(port int32, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, issue := range utilvalidation.IsValidPortNum(int(port)) {
+		allErrs = append(allErrs, field.Invalid(fldPath, port, issue))
+	}
+	return allErrs
+}
+
+func ValidatePortForwardingSpec(spec *core.PortForwardingSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateIPFamily(spec.IPFamily, fldPath.Child("ipFamily"))...)
+
+	if spec.IP.IsValid() {
+		allErrs = append(allErrs, ValidateIPMatchesFamily(spec.IP, spec.IPFamily, fldPath.Child("ip"))...)
+	}
+
+	if spec.NetworkInterfaceRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkInterfaceRef", "name"), "must specify network interface ref name"))
+	} else {
+		for _, msg := range validation.NameIsDNSLabel(spec.NetworkInterfaceRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("networkInterfaceRef", "name"), spec.NetworkInterfaceRef.Name, msg))
+		}
+	}
+
+	if !spec.TargetIP.IsValid() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targetIP"), "must specify target IP"))
+	} else {
+		allErrs = append(allErrs, ValidateIPMatchesFamily(spec.TargetIP, spec.IPFamily, fldPath.Child("targetIP"))...)
+	}
+
+	if protocol := spec.Protocol; protocol != nil {
+		allErrs = append(allErrs, ValidateProtocol(*protocol, fldPath.Child("protocol"))...)
+	}
+
+	allErrs = append(allErrs, validatePortNum(spec.Port, fldPath.Child("port"))...)
+
+	endPort := spec.Port
+	if spec.EndPort != nil {
+		endPort = *spec.EndPort
+		switch {
+		case endPort < spec.Port:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("endPort"), fmt.Sprintf("endPort %d must not be smaller than port %d", endPort, spec.Port)))
+		case endPort-spec.Port >= maxPortForwardingPorts:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("endPort"), fmt.Sprintf("must not forward more than %d ports", maxPortForwardingPorts)))
+		default:
+			allErrs = append(allErrs, validatePortNum(endPort, fldPath.Child("endPort"))...)
+		}
+	}
+
+	if targetPort := spec.TargetPort; targetPort != nil && *targetPort != spec.Port {
+		// The data plane forwards ports unchanged, there is no way to program a port translation.
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetPort"), fmt.Sprintf("targetPort %d must equal port %d, port translation is not supported", *targetPort, spec.Port)))
+	}
+
+	return allErrs
+}
+
+func ValidatePortForwardingUpdate(newPortForwarding, oldPortForwarding *core.PortForwarding) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newPortForwarding, oldPortForwarding, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidatePortForwarding(newPortForwarding)...)
+	allErrs = append(allErrs, ValidatePortForwardingSpecUpdate(&newPortForwarding.Spec, &oldPortForwarding.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidatePortForwardingSpecUpdate(newSpec, oldSpec *core.PortForwardingSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.IPFamily, oldSpec.IPFamily, fldPath.Child("ipFamily"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.NetworkInterfaceRef, oldSpec.NetworkInterfaceRef, fldPath.Child("networkInterfaceRef"))...)
+
+	if oldSpec.IP.IsValid() {
+		allErrs = append(allErrs, validation.ValidateImmutableField(newSpec.IP, oldSpec.IP, fldPath.Child("ip"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("PortForwarding", func() {
+	DescribeTable("ValidatePortForwardingSpec",
+		func(spec *core.PortForwardingSpec, match types.GomegaMatcher) {
+			allErrs := validation.ValidatePortForwardingSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(match)
+		},
+		Entry("valid port range forwarding",
+			&core.PortForwardingSpec{
+				IPFamily:            corev1.IPv4Protocol,
+				NetworkInterfaceRef: corev1.LocalObjectReference{Name: "nic"},
+				TargetIP:            net.MustParseIP("10.0.0.1"),
+				Port:                8080,
+				EndPort:             new(int32(8090)),
+				TargetPort:          new(int32(8080)),
+			},
+			BeEmpty(),
+		),
+		Entry("target IP of different family",
+			&core.PortForwardingSpec{
+				IPFamily:            corev1.IPv4Protocol,
+				NetworkInterfaceRef: corev1.LocalObjectReference{Name: "nic"},
+				TargetIP:            net.MustParseIP("2001:db8::1"),
+				Port:                22,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.targetIP"),
+			}))),
+		),
+		Entry("end port smaller than port",
+			&core.PortForwardingSpec{
+				IPFamily:            corev1.IPv4Protocol,
+				NetworkInterfaceRef: corev1.LocalObjectReference{Name: "nic"},
+				TargetIP:            net.MustParseIP("10.0.0.1"),
+				Port:                22,
+				EndPort:             new(int32(21)),
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.endPort"),
+			}))),
+		),
+		Entry("end port exceeding the maximum number of ports",
+			&core.PortForwardingSpec{
+				IPFamily:            corev1.IPv4Protocol,
+				NetworkInterfaceRef: corev1.LocalObjectReference{Name: "nic"},
+				TargetIP:            net.MustParseIP("10.0.0.1"),
+				Port:                1,
+				EndPort:             new(int32(65535)),
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.endPort"),
+			}))),
+		),
+		Entry("target port differing from port",
+			&core.PortForwardingSpec{
+				IPFamily:            corev1.IPv4Protocol,
+				NetworkInterfaceRef: corev1.LocalObjectReference{Name: "nic"},
+				TargetIP:            net.MustParseIP("10.0.0.1"),
+				Port:                8080,
+				TargetPort:          new(int32(80)),
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.targetPort"),
+			}))),
+		),
+		Entry("missing network interface ref",
+			&core.PortForwardingSpec{
+				IPFamily: corev1.IPv4Protocol,
+				TargetIP: net.MustParseIP("10.0.0.1"),
+				Port:     22,
+			},
+			ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.networkInterfaceRef.name"),
+			}))),
+		),
+	)
+})
@@ -48,6 +48,26 @@ func (in IPAddressClaimRef) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPAddressClaimRef"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressHistory) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPAddressHistory"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressHistoryList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPAddressHistoryList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressHistorySpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPAddressHistorySpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPAddressLease) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPAddressLease"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in IPAddressList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPAddressList"
@@ -73,6 +93,31 @@ func (in IPList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPool) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPool"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolPrefixStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolPrefixStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IPPoolStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.v1alpha1.IPPoolStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in IPSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.IPSpec"
@@ -268,6 +313,21 @@ func (in NetworkIDSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkIDSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkIPAddress) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkIPAddress"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkIPAddressList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkIPAddressList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkIPAddressSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkIPAddressSpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NetworkInterface) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkInterface"
@@ -363,6 +423,26 @@ func (in NetworkPolicySpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkPolicySpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuota) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkQuota"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuotaList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkQuotaList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuotaSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkQuotaSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NetworkQuotaStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkQuotaStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NetworkSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.NetworkSpec"
@@ -433,6 +513,21 @@ func (in PeeringPrefix) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.PeeringPrefix"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PortForwarding) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.PortForwarding"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PortForwardingList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.PortForwardingList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PortForwardingSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore-net.api.core.core.PortForwardingSpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Rule) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore-net.api.core.core.Rule"
@@ -5,7 +5,9 @@ package apiserver
 
 import (
 	"net/netip"
+	"time"
 
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/apimachinery/equality"
 	informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions"
 	v1alpha1client "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/typed/core/v1alpha1"
@@ -16,7 +18,9 @@ import (
 	"github.com/ironcore-dev/ironcore-net/internal/registry/ip"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/ip/ipaddressallocator"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/ipaddress"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/ipaddresshistory"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/ipallocator"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/ippool"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/loadbalancer"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/loadbalancerrouting"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/natgateway"
@@ -24,11 +28,15 @@ import (
 	"github.com/ironcore-dev/ironcore-net/internal/registry/nattable"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/network"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/network/networkidallocator"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/network/networkipallocator"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/networkid"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/networkinterface"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/networkipaddress"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/networkpolicy"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/networkpolicyrule"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/networkquota"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/node"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/portforwarding"
 	ironcoreserializer "github.com/ironcore-dev/ironcore-net/internal/serializer"
 	corev1 "k8s.io/api/core/v1"
 	apimachineryequality "k8s.io/apimachinery/pkg/api/equality"
@@ -62,13 +70,38 @@ func init() {
 	)
 }
 
+// DefaultAllocatorRepairInterval is the default interval the allocators are repaired at.
+const DefaultAllocatorRepairInterval = 3 * time.Minute
+
+// DefaultIPAddressHistoryRetention is the default duration released leases are kept in the IP address histories.
+const DefaultIPAddressHistoryRetention = 90 * 24 * time.Hour
+
 // ExtraConfig holds custom apiserver config
 type ExtraConfig struct {
 	MinVNI int32
 	MaxVNI int32
 
+	// PublicPrefix are static public prefixes to allocate from in addition to public IPPools.
+	//
+	// Deprecated: Use IPPools instead.
 	PublicPrefix []netip.Prefix
 
+	// IPQuarantinePeriod is the duration released IP addresses are held before they can be reused.
+	IPQuarantinePeriod time.Duration
+
+	// IPAddressHistoryRetention is the duration released leases are kept in the IP address histories.
+	// If zero, no history is recorded.
+	IPAddressHistoryRetention time.Duration
+
+	// IPAllocationStrategy is the default strategy to allocate IPs with if not set by the IP pool.
+	IPAllocationStrategy v1alpha1.AllocationStrategy
+	// VNIAllocationStrategy is the strategy to allocate VNIs with.
+	VNIAllocationStrategy v1alpha1.AllocationStrategy
+
+	// AllocatorRepairInterval is the interval the in-memory state of the IP address and
+	// network ID allocators is reconciled with the stored objects.
+	AllocatorRepairInterval time.Duration
+
 	VersionedInformers informers.SharedInformerFactory
 }
 
@@ -95,6 +128,16 @@ type CompletedConfig struct {
 
 // Complete fills in any fields not set that are required to have valid data. It's mutating the receiver.
 func (cfg *Config) Complete() CompletedConfig {
+	if cfg.ExtraConfig.IPAllocationStrategy == "" {
+		cfg.ExtraConfig.IPAllocationStrategy = v1alpha1.AllocationStrategyRandom
+	}
+	if cfg.ExtraConfig.VNIAllocationStrategy == "" {
+		cfg.ExtraConfig.VNIAllocationStrategy = v1alpha1.AllocationStrategyRandom
+	}
+	if cfg.ExtraConfig.AllocatorRepairInterval <= 0 {
+		cfg.ExtraConfig.AllocatorRepairInterval = DefaultAllocatorRepairInterval
+	}
+
 	c := completedConfig{
 		cfg.GenericConfig.Complete(),
 		&cfg.ExtraConfig,
@@ -127,14 +170,20 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 		prefixesByFamily[family] = append(prefixesByFamily[family], prefix)
 	}
 
+	ipAddrAllocs := make([]*ipaddressallocator.Allocator, 0, 2)
 	ipAddrAllocByFamily := make(map[corev1.IPFamily]ipaddressallocator.Interface)
 	ipAllocByFamily := make(map[corev1.IPFamily]ipallocator.Interface)
 
-	for family, prefixes := range prefixesByFamily {
+	for _, family := range []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol} {
 		ipAddrAlloc, err := ipaddressallocator.New(
-			prefixes,
+			family,
+			prefixesByFamily[family],
 			v1alpha1Client,
 			c.ExtraConfig.VersionedInformers.Core().V1alpha1().IPAddresses(),
+			c.ExtraConfig.VersionedInformers.Core().V1alpha1().IPPools(),
+			c.ExtraConfig.IPQuarantinePeriod,
+			c.ExtraConfig.IPAllocationStrategy,
+			c.ExtraConfig.IPAddressHistoryRetention,
 		)
 		if err != nil {
 			return nil, err
@@ -142,7 +191,7 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 
 		ipAlloc, err := ipallocator.New(
 			Scheme,
-			prefixes,
+			ipAddrAlloc,
 			v1alpha1Client,
 			c.ExtraConfig.VersionedInformers.Core().V1alpha1().IPs(),
 		)
@@ -150,20 +199,36 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 			return nil, err
 		}
 
+		ipAddrAllocs = append(ipAddrAllocs, ipAddrAlloc)
 		ipAddrAllocByFamily[family] = ipAddrAlloc
 		ipAllocByFamily[family] = ipAlloc
 	}
 
+	networkIPAllocator := networkipallocator.New(v1alpha1Client)
+
 	networkIDAllocator, err := networkidallocator.NewNetworkIDAllocator(
 		v1alpha1Client,
 		c.ExtraConfig.VersionedInformers.Core().V1alpha1().NetworkIDs(),
 		c.ExtraConfig.MinVNI,
 		c.ExtraConfig.MaxVNI,
+		c.ExtraConfig.VNIAllocationStrategy,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := genericServer.AddPostStartHook("start-ironcore-net-allocator-repair", func(hookContext genericapiserver.PostStartHookContext) error {
+		for _, ipAddrAlloc := range ipAddrAllocs {
+			go ipAddrAlloc.RunRepair(hookContext, c.ExtraConfig.AllocatorRepairInterval)
+			go ipAddrAlloc.RunHistoryGC(hookContext)
+		}
+		go networkIDAllocator.RunRepair(hookContext, c.ExtraConfig.AllocatorRepairInterval)
+		go networkIPAllocator.RunRepair(hookContext, c.ExtraConfig.AllocatorRepairInterval)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
 	s := &IronCoreServer{
 		GenericAPIServer: genericServer,
 	}
@@ -194,7 +259,7 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 	v1alpha1storage["instances"] = instanceStorage.Instance
 	v1alpha1storage["instances/status"] = instanceStorage.Status
 
-	ipStorage, err := ip.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, ipAddrAllocByFamily)
+	ipStorage, err := ip.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, ipAddrAllocByFamily, c.GenericConfig.Authorization.Authorizer)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +273,27 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 
 	v1alpha1storage["ipaddresses"] = ipAddressStorage.IPAddress
 
-	loadBalancerStorage, err := loadbalancer.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, ipAllocByFamily)
+	ipAddressHistoryStorage, err := ipaddresshistory.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	v1alpha1storage["ipaddresshistories"] = ipAddressHistoryStorage.IPAddressHistory
+
+	ipPoolStorage, err := ippool.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	v1alpha1storage["ippools"] = ipPoolStorage.IPPool
+	v1alpha1storage["ippools/status"] = ipPoolStorage.Status
+
+	loadBalancerStorage, err := loadbalancer.NewStorage(
+		Scheme,
+		c.GenericConfig.RESTOptionsGetter,
+		ipAllocByFamily,
+		networkIPAllocator,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +322,14 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 
 	v1alpha1storage["networkpolicyrules"] = networkPolicyRuleStorage.NetworkPolicyRule
 
+	networkQuotaStorage, err := networkquota.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	v1alpha1storage["networkquotas"] = networkQuotaStorage.NetworkQuota
+	v1alpha1storage["networkquotas/status"] = networkQuotaStorage.Status
+
 	natGatewayStorage, err := natgateway.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, ipAllocByFamily)
 	if err != nil {
 		return nil, err
@@ -275,10 +368,18 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 
 	v1alpha1storage["networkids"] = networkIDStorage.NetworkID
 
+	networkIPAddressStorage, err := networkipaddress.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	v1alpha1storage["networkipaddresses"] = networkIPAddressStorage.NetworkIPAddress
+
 	networkInterfaceStorage, err := networkinterface.NewStorage(
 		Scheme,
 		c.GenericConfig.RESTOptionsGetter,
 		ipAllocByFamily,
+		networkIPAllocator,
 	)
 	if err != nil {
 		return nil, err
@@ -295,6 +396,13 @@ func (c completedConfig) New() (*IronCoreServer, error) {
 	v1alpha1storage["nodes"] = nodeStorage.Node
 	v1alpha1storage["nodes/status"] = nodeStorage.Status
 
+	portForwardingStorage, err := portforwarding.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, ipAllocByFamily, v1alpha1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	v1alpha1storage["portforwardings"] = portForwardingStorage.PortForwarding
+
 	apiGroupInfo.VersionedResourcesStorageMap["v1alpha1"] = v1alpha1storage
 
 	if err := s.GenericAPIServer.InstallAPIGroups(&apiGroupInfo); err != nil {
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"time"
 
 	apinetopenapi "github.com/ironcore-dev/ironcore-net/client-go/openapi"
+	"github.com/ironcore-dev/ironcore-net/internal/admission/initializer"
 	"github.com/ironcore-dev/ironcore-net/internal/admission/plugin/ipaddressinuseprotection"
+	"github.com/ironcore-dev/ironcore-net/internal/admission/plugin/networkquota"
 	"k8s.io/apiserver/pkg/endpoints/openapi"
 	"k8s.io/component-base/compatibility"
 
@@ -18,6 +21,7 @@ import (
 	informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions"
 	clientset "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned"
 	"github.com/ironcore-dev/ironcore-net/internal/apiserver"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/allocationstrategy"
 	netflag "github.com/ironcore-dev/ironcore-net/utils/flag"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -39,18 +43,33 @@ const (
 )
 
 type IronCoreNetServerOptions struct {
-	RecommendedOptions    *options.RecommendedOptions
-	SharedInformerFactory informers.SharedInformerFactory
-	MinVNI                int32
-	MaxVNI                int32
-	PublicPrefix          []netip.Prefix
+	RecommendedOptions        *options.RecommendedOptions
+	SharedInformerFactory     informers.SharedInformerFactory
+	MinVNI                    int32
+	MaxVNI                    int32
+	PublicPrefix              []netip.Prefix
+	IPQuarantinePeriod        time.Duration
+	IPAddressHistoryRetention time.Duration
+	IPAllocationStrategy      string
+	VNIAllocationStrategy     string
+	AllocatorRepairInterval   time.Duration
 }
 
 func (o *IronCoreNetServerOptions) AddFlags(fs *pflag.FlagSet) {
 	o.RecommendedOptions.AddFlags(fs)
 	fs.Int32Var(&o.MinVNI, "min-vni", o.MinVNI, "Minimum VNI to allocate")
 	fs.Int32Var(&o.MaxVNI, "max-vni", o.MaxVNI, "Maximum VNI to allocate")
-	netflag.IPPrefixesVar(fs, &o.PublicPrefix, "public-prefix", o.PublicPrefix, "Public prefixes to allocate from")
+	netflag.IPPrefixesVar(fs, &o.PublicPrefix, "public-prefix", o.PublicPrefix, "Public prefixes to allocate from in addition to public IPPools")
+	_ = fs.MarkDeprecated("public-prefix", "create IPPools instead")
+	fs.DurationVar(&o.IPQuarantinePeriod, "ip-quarantine-period", o.IPQuarantinePeriod, "Duration released IPs are held before they can be allocated again. Zero releases IPs immediately.")
+	fs.DurationVar(&o.IPAddressHistoryRetention, "ip-address-history-retention", o.IPAddressHistoryRetention,
+		"Duration released leases are kept in the IP address histories. Zero disables recording the history.")
+	fs.StringVar(&o.IPAllocationStrategy, "ip-allocation-strategy", o.IPAllocationStrategy,
+		fmt.Sprintf("Default strategy to allocate IPs with if not set by the IP pool. One of %v.", allocationstrategy.Names()))
+	fs.StringVar(&o.VNIAllocationStrategy, "vni-allocation-strategy", o.VNIAllocationStrategy,
+		fmt.Sprintf("Strategy to allocate VNIs with. One of %v.", allocationstrategy.Names()))
+	fs.DurationVar(&o.AllocatorRepairInterval, "allocator-repair-interval", o.AllocatorRepairInterval,
+		"Interval to reconcile the in-memory state of the IP address and VNI allocators with the stored objects.")
 }
 
 func NewIronCoreNetServerOptions() *IronCoreNetServerOptions {
@@ -59,8 +78,12 @@ func NewIronCoreNetServerOptions() *IronCoreNetServerOptions {
 			defaultEtcdPathPrefix,
 			apiserver.Codecs.LegacyCodec(v1alpha1.SchemeGroupVersion),
 		),
-		MinVNI: defaultMinVNI,
-		MaxVNI: defaultMaxVNI,
+		MinVNI:                    defaultMinVNI,
+		MaxVNI:                    defaultMaxVNI,
+		IPAddressHistoryRetention: apiserver.DefaultIPAddressHistoryRetention,
+		IPAllocationStrategy:      string(v1alpha1.AllocationStrategyRandom),
+		VNIAllocationStrategy:     string(v1alpha1.AllocationStrategyRandom),
+		AllocatorRepairInterval:   apiserver.DefaultAllocatorRepairInterval,
 	}
 	o.RecommendedOptions.Etcd.StorageConfig.EncodeVersioner = runtime.NewMultiGroupVersioner(v1alpha1.SchemeGroupVersion, schema.GroupKind{Group: v1alpha1.GroupName})
 	return o
@@ -94,15 +117,33 @@ func NewCommandStartIronCoreNetServer(ctx context.Context, defaults *IronCoreNet
 func (o *IronCoreNetServerOptions) Validate(args []string) error {
 	var errs []error
 	errs = append(errs, o.RecommendedOptions.Validate()...)
+	if o.IPQuarantinePeriod < 0 {
+		errs = append(errs, fmt.Errorf("ip-quarantine-period must not be negative"))
+	}
+	if o.IPAddressHistoryRetention < 0 {
+		errs = append(errs, fmt.Errorf("ip-address-history-retention must not be negative"))
+	}
+	strategies := allocationstrategy.NewStrategies()
+	if _, err := strategies.Get(v1alpha1.AllocationStrategy(o.IPAllocationStrategy)); err != nil {
+		errs = append(errs, fmt.Errorf("invalid ip-allocation-strategy: %w", err))
+	}
+	if _, err := strategies.Get(v1alpha1.AllocationStrategy(o.VNIAllocationStrategy)); err != nil {
+		errs = append(errs, fmt.Errorf("invalid vni-allocation-strategy: %w", err))
+	}
+	if o.AllocatorRepairInterval <= 0 {
+		errs = append(errs, fmt.Errorf("allocator-repair-interval must be positive"))
+	}
 	return utilerrors.NewAggregate(errs)
 }
 
 func (o *IronCoreNetServerOptions) Complete() error {
 	ipaddressinuseprotection.Register(o.RecommendedOptions.Admission.Plugins)
+	networkquota.Register(o.RecommendedOptions.Admission.Plugins)
 
 	o.RecommendedOptions.Admission.RecommendedPluginOrder = append(
 		o.RecommendedOptions.Admission.RecommendedPluginOrder,
 		ipaddressinuseprotection.PluginName,
+		networkquota.PluginName,
 	)
 
 	return nil
@@ -122,7 +163,9 @@ func (o *IronCoreNetServerOptions) Config() (*apiserver.Config, error) {
 		informerFactory := informers.NewSharedInformerFactory(ironcoreAPINetClient, c.LoopbackClientConfig.Timeout)
 		o.SharedInformerFactory = informerFactory
 
-		return nil, nil
+		return []admission.PluginInitializer{
+			initializer.New(informerFactory, ironcoreAPINetClient),
+		}, nil
 	}
 
 	serverConfig := genericapiserver.NewRecommendedConfig(apiserver.Codecs)
@@ -144,10 +187,15 @@ func (o *IronCoreNetServerOptions) Config() (*apiserver.Config, error) {
 	config := &apiserver.Config{
 		GenericConfig: serverConfig,
 		ExtraConfig: apiserver.ExtraConfig{
-			MinVNI:             o.MinVNI,
-			MaxVNI:             o.MaxVNI,
-			PublicPrefix:       o.PublicPrefix,
-			VersionedInformers: o.SharedInformerFactory,
+			MinVNI:                    o.MinVNI,
+			MaxVNI:                    o.MaxVNI,
+			PublicPrefix:              o.PublicPrefix,
+			IPQuarantinePeriod:        o.IPQuarantinePeriod,
+			IPAddressHistoryRetention: o.IPAddressHistoryRetention,
+			IPAllocationStrategy:      v1alpha1.AllocationStrategy(o.IPAllocationStrategy),
+			VNIAllocationStrategy:     v1alpha1.AllocationStrategy(o.VNIAllocationStrategy),
+			AllocatorRepairInterval:   o.AllocatorRepairInterval,
+			VersionedInformers:        o.SharedInformerFactory,
 		},
 	}
 
@@ -6,6 +6,7 @@ package app_test
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	"github.com/ironcore-dev/ironcore-net/internal/ipaddress"
 	. "github.com/ironcore-dev/ironcore-net/utils/testing"
+	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
 	. "github.com/ironcore-dev/ironcore/utils/testing"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -196,6 +198,45 @@ var _ = Describe("Core", func() {
 			Expect(ipAddress.Finalizers).To(ConsistOf(ipaddress.ProtectionFinalizer))
 		})
 
+		It("should record the lease history of IP addresses", func(ctx SpecContext) {
+			By("creating an IP")
+			ip := &v1alpha1.IP{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "ip-",
+				},
+				Spec: v1alpha1.IPSpec{
+					Type:     v1alpha1.IPTypePublic,
+					IPFamily: corev1.IPv4Protocol,
+				},
+			}
+			Expect(k8sClient.Create(ctx, ip)).To(Succeed())
+
+			By("inspecting the IP address history")
+			history := &v1alpha1.IPAddressHistory{}
+			historyKey := client.ObjectKey{Name: ip.Spec.IP.String()}
+			Expect(k8sClient.Get(ctx, historyKey, history)).To(Succeed())
+			Expect(history.Spec.IP).To(Equal(ip.Spec.IP))
+			Expect(history.Spec.Leases).NotTo(BeEmpty())
+			lease := history.Spec.Leases[len(history.Spec.Leases)-1]
+			Expect(lease.ClaimRef).To(SatisfyAll(
+				HaveField("Resource", "ips"),
+				HaveField("Namespace", ip.Namespace),
+				HaveField("Name", ip.Name),
+				HaveField("UID", ip.UID),
+			))
+			Expect(lease.ReleasedTime).To(BeNil())
+
+			By("deleting the IP")
+			Expect(k8sClient.Delete(ctx, ip)).To(Succeed())
+
+			By("waiting for the lease to be released")
+			Eventually(Object(history)).Should(HaveField("Spec.Leases", ContainElement(SatisfyAll(
+				HaveField("ClaimRef.UID", ip.UID),
+				HaveField("ReleasedTime", Not(BeNil())),
+			))))
+		})
+
 		It("should not allocate the same IP twice", func(ctx context.Context) {
 			var (
 				noRequestIPs   = 10
@@ -399,6 +440,373 @@ var _ = Describe("Core", func() {
 				client.InNamespace(ns.Name),
 			)()).To(HaveField("Items", BeEmpty()))
 		})
+		It("should allocate IPs of both families for dual-stack load balancers", func(ctx SpecContext) {
+			By("creating a dual-stack load balancer")
+			loadBalancer := &v1alpha1.LoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "lb-",
+				},
+				Spec: v1alpha1.LoadBalancerSpec{
+					Type:           v1alpha1.LoadBalancerTypePublic,
+					NetworkRef:     corev1.LocalObjectReference{Name: "my-network"},
+					IPFamilyPolicy: new(corev1.IPFamilyPolicyRequireDualStack),
+					IPFamilies:     []corev1.IPFamily{corev1.IPv4Protocol},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Template: v1alpha1.InstanceTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, loadBalancer)
+
+			By("inspecting the load balancer for its IP families and IPs")
+			Expect(loadBalancer.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}))
+			Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+				SatisfyAll(
+					HaveField("Name", "ipv4"),
+					HaveField("IPFamily", corev1.IPv4Protocol),
+					HaveField("IP", AsRef(Satisfy((*net.IP).IsValid))),
+				),
+				SatisfyAll(
+					HaveField("Name", "ipv6"),
+					HaveField("IPFamily", corev1.IPv6Protocol),
+					HaveField("IP", AsRef(Satisfy((*net.IP).IsValid))),
+				),
+			))
+		})
+
+		It("should default the IP family policy and IP families of load balancers", func(ctx SpecContext) {
+			By("creating a load balancer without IP families")
+			loadBalancer := &v1alpha1.LoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "lb-",
+				},
+				Spec: v1alpha1.LoadBalancerSpec{
+					Type:       v1alpha1.LoadBalancerTypePublic,
+					NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Template: v1alpha1.InstanceTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, loadBalancer)
+
+			By("inspecting the load balancer for its IP families and IPs")
+			Expect(loadBalancer.Spec.IPFamilyPolicy).To(HaveValue(Equal(corev1.IPFamilyPolicySingleStack)))
+			Expect(loadBalancer.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv4Protocol}))
+			Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+				SatisfyAll(
+					HaveField("IPFamily", corev1.IPv4Protocol),
+					HaveField("IP", AsRef(Satisfy((*net.IP).IsValid))),
+				),
+			))
+		})
+
+		It("should prefer dual-stack for internal load balancers only if the network has prefixes of both IP families", func(ctx SpecContext) {
+			newNetwork := func(prefixes ...string) *v1alpha1.Network {
+				network := &v1alpha1.Network{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns.Name,
+						GenerateName: "network-",
+					},
+					Spec: v1alpha1.NetworkSpec{
+						Prefixes: utilslices.Map(prefixes, net.MustParseIPPrefix),
+					},
+				}
+				Expect(k8sClient.Create(ctx, network)).To(Succeed())
+				return network
+			}
+			newInternalLoadBalancer := func(network *v1alpha1.Network) *v1alpha1.LoadBalancer {
+				loadBalancer := &v1alpha1.LoadBalancer{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns.Name,
+						GenerateName: "lb-",
+					},
+					Spec: v1alpha1.LoadBalancerSpec{
+						Type:           v1alpha1.LoadBalancerTypeInternal,
+						NetworkRef:     corev1.LocalObjectReference{Name: network.Name},
+						IPFamilyPolicy: new(corev1.IPFamilyPolicyPreferDualStack),
+						IPFamilies:     []corev1.IPFamily{corev1.IPv4Protocol},
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+						Template: v1alpha1.InstanceTemplate{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+				DeferCleanup(k8sClient.Delete, loadBalancer)
+				return loadBalancer
+			}
+
+			By("creating an internal load balancer in a single-stack network")
+			singleStackLoadBalancer := newInternalLoadBalancer(newNetwork("10.0.0.0/24"))
+
+			By("inspecting the load balancer to be single-stack")
+			Expect(singleStackLoadBalancer.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv4Protocol}))
+			Expect(singleStackLoadBalancer.Spec.IPs).To(ConsistOf(
+				SatisfyAll(
+					HaveField("IPFamily", corev1.IPv4Protocol),
+					HaveField("IP", AsRef(Satisfy((*net.IP).IsValid))),
+				),
+			))
+
+			By("creating an internal load balancer in a dual-stack network")
+			dualStackLoadBalancer := newInternalLoadBalancer(newNetwork("10.0.0.0/24", "fd00::/64"))
+
+			By("inspecting the load balancer to be dual-stack")
+			Expect(dualStackLoadBalancer.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}))
+			Expect(dualStackLoadBalancer.Spec.IPs).To(ConsistOf(
+				SatisfyAll(
+					HaveField("IPFamily", corev1.IPv4Protocol),
+					HaveField("IP", AsRef(Satisfy((*net.IP).IsValid))),
+				),
+				SatisfyAll(
+					HaveField("IPFamily", corev1.IPv6Protocol),
+					HaveField("IP", AsRef(Satisfy((*net.IP).IsValid))),
+				),
+			))
+		})
+
+		It("should allocate internal load balancer IPs from the network prefixes", func(ctx SpecContext) {
+			By("creating a network with prefixes")
+			network := &v1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "network-",
+				},
+				Spec: v1alpha1.NetworkSpec{
+					Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/29")},
+				},
+			}
+			Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+			By("creating a network interface using the first usable IP of the network")
+			networkInterface := &v1alpha1.NetworkInterface{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "nic-",
+				},
+				Spec: v1alpha1.NetworkInterfaceSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+					NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+					IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+				},
+			}
+			Expect(k8sClient.Create(ctx, networkInterface)).To(Succeed())
+
+			newInternalLoadBalancer := func() *v1alpha1.LoadBalancer {
+				return &v1alpha1.LoadBalancer{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns.Name,
+						GenerateName: "lb-",
+					},
+					Spec: v1alpha1.LoadBalancerSpec{
+						Type:       v1alpha1.LoadBalancerTypeInternal,
+						NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+						IPs: []v1alpha1.LoadBalancerIP{
+							{Name: "ipv4", IPFamily: corev1.IPv4Protocol},
+						},
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+						Template: v1alpha1.InstanceTemplate{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+				}
+			}
+
+			By("creating an internal load balancer without IP")
+			loadBalancer := newInternalLoadBalancer()
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+			By("inspecting the load balancer IP to skip the network address and the network interface IP")
+			Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+				HaveField("IP", net.MustParseIP("10.0.0.2")),
+			))
+
+			By("updating the load balancer without specifying the IP")
+			Eventually(Update(loadBalancer, func() {
+				loadBalancer.Spec.IPs = []v1alpha1.LoadBalancerIP{
+					{Name: "ipv4", IPFamily: corev1.IPv4Protocol},
+				}
+			})).Should(Succeed())
+
+			By("inspecting the load balancer to retain its IP")
+			Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+				HaveField("IP", net.MustParseIP("10.0.0.2")),
+			))
+
+			By("creating more internal load balancers until the network is full")
+			loadBalancers := make([]*v1alpha1.LoadBalancer, 0, 4)
+			for _, ip := range []string{"10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"} {
+				loadBalancer := newInternalLoadBalancer()
+				Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+				Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+					HaveField("IP", net.MustParseIP(ip)),
+				))
+				loadBalancers = append(loadBalancers, loadBalancer)
+			}
+
+			By("creating an internal load balancer once the network is full, not allocating the broadcast address")
+			Expect(k8sClient.Create(ctx, newInternalLoadBalancer())).NotTo(Succeed())
+
+			By("deleting an internal load balancer")
+			Expect(k8sClient.Delete(ctx, loadBalancers[0])).To(Succeed())
+
+			By("creating an internal load balancer reusing the released IP")
+			reusingLoadBalancer := newInternalLoadBalancer()
+			Expect(k8sClient.Create(ctx, reusingLoadBalancer)).To(Succeed())
+			Expect(reusingLoadBalancer.Spec.IPs).To(ConsistOf(
+				HaveField("IP", net.MustParseIP("10.0.0.3")),
+			))
+		})
+
+		It("should reject conflicting IPs of internal load balancers and network interfaces", func(ctx SpecContext) {
+			By("creating a network with prefixes")
+			network := &v1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "network-",
+				},
+				Spec: v1alpha1.NetworkSpec{
+					Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				},
+			}
+			Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+			newNetworkInterface := func(ip string) *v1alpha1.NetworkInterface {
+				return &v1alpha1.NetworkInterface{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns.Name,
+						GenerateName: "nic-",
+					},
+					Spec: v1alpha1.NetworkInterfaceSpec{
+						NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+						NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+						IPs:        []net.IP{net.MustParseIP(ip)},
+					},
+				}
+			}
+			newInternalLoadBalancer := func(ip string) *v1alpha1.LoadBalancer {
+				return &v1alpha1.LoadBalancer{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns.Name,
+						GenerateName: "lb-",
+					},
+					Spec: v1alpha1.LoadBalancerSpec{
+						Type:       v1alpha1.LoadBalancerTypeInternal,
+						NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+						IPs: []v1alpha1.LoadBalancerIP{
+							{Name: "ipv4", IPFamily: corev1.IPv4Protocol, IP: net.MustParseIP(ip)},
+						},
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+						Template: v1alpha1.InstanceTemplate{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+				}
+			}
+
+			By("creating a network interface")
+			networkInterface := newNetworkInterface("10.0.0.10")
+			Expect(k8sClient.Create(ctx, networkInterface)).To(Succeed())
+
+			By("creating an internal load balancer with the IP of the network interface")
+			Expect(k8sClient.Create(ctx, newInternalLoadBalancer("10.0.0.10"))).To(Satisfy(apierrors.IsInvalid))
+
+			By("creating an internal load balancer")
+			loadBalancer := newInternalLoadBalancer("10.0.0.20")
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+			By("inspecting the network IP address claimed by the internal load balancer")
+			networkIPAddress := &v1alpha1.NetworkIPAddress{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: ns.Name,
+				Name:      v1alpha1.NetworkIPAddressName(network.Name, netip.MustParseAddr("10.0.0.20")),
+			}, networkIPAddress)).To(Succeed())
+			Expect(networkIPAddress.Spec.ClaimRef).To(HaveField("UID", loadBalancer.UID))
+
+			By("creating a network interface with the IP of the internal load balancer")
+			Expect(k8sClient.Create(ctx, newNetworkInterface("10.0.0.20"))).To(Satisfy(apierrors.IsInvalid))
+
+			By("updating the network interface to the IP of the internal load balancer")
+			Expect(Update(networkInterface, func() {
+				networkInterface.Spec.IPs = []net.IP{net.MustParseIP("10.0.0.20")}
+			})()).To(Satisfy(apierrors.IsInvalid))
+
+			By("deleting the internal load balancer")
+			Expect(k8sClient.Delete(ctx, loadBalancer)).To(Succeed())
+
+			By("asserting the network IP address is released")
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(networkIPAddress), networkIPAddress)).To(Satisfy(apierrors.IsNotFound))
+
+			By("creating a network interface with the released IP")
+			Expect(k8sClient.Create(ctx, newNetworkInterface("10.0.0.20"))).To(Succeed())
+		})
+
+		It("should leave the IPs of internal load balancers unset if the network has no prefixes", func(ctx SpecContext) {
+			By("creating a network without prefixes")
+			network := &v1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "network-",
+				},
+			}
+			Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+			By("creating an internal load balancer without IP")
+			loadBalancer := &v1alpha1.LoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "lb-",
+				},
+				Spec: v1alpha1.LoadBalancerSpec{
+					Type:       v1alpha1.LoadBalancerTypeInternal,
+					NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+					IPs: []v1alpha1.LoadBalancerIP{
+						{Name: "ipv4", IPFamily: corev1.IPv4Protocol},
+					},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Template: v1alpha1.InstanceTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+			By("inspecting the IPs of the internal load balancer")
+			Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+				HaveField("IP", net.IP{}),
+			))
+		})
 	})
 
 	Context("NATGateway", func() {
@@ -537,4 +945,204 @@ var _ = Describe("Core", func() {
 			)()).To(HaveField("Items", BeEmpty()))
 		})
 	})
+
+	Context("PortForwarding", func() {
+		It("should maintain IP allocations for port forwardings", func(ctx SpecContext) {
+			By("creating a port forwarding without IP")
+			portForwarding := &v1alpha1.PortForwarding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "pf-",
+				},
+				Spec: v1alpha1.PortForwardingSpec{
+					IPFamily:            corev1.IPv4Protocol,
+					NetworkInterfaceRef: corev1.LocalObjectReference{Name: "my-nic"},
+					TargetIP:            net.MustParseIP("192.168.0.1"),
+					Port:                8080,
+				},
+			}
+			Expect(k8sClient.Create(ctx, portForwarding)).To(Succeed())
+
+			By("inspecting the port forwarding for its IP")
+			Expect(&portForwarding.Spec.IP).To(Satisfy((*net.IP).IsValid))
+
+			By("retrieving the corresponding IP")
+			Eventually(ObjectList(&v1alpha1.IPList{},
+				client.InNamespace(ns.Name),
+			)).Should(HaveField("Items", ConsistOf(
+				HaveField("Spec.IP", portForwarding.Spec.IP),
+			)))
+
+			By("deleting the port forwarding")
+			Expect(k8sClient.Delete(ctx, portForwarding)).To(Succeed())
+
+			By("asserting there are no IPs")
+			Eventually(ObjectList(&v1alpha1.IPList{},
+				client.InNamespace(ns.Name),
+			)).Should(HaveField("Items", BeEmpty()))
+		})
+
+		It("should reject a port forwarding using the IP of a load balancer", func(ctx SpecContext) {
+			By("creating a load balancer")
+			loadBalancer := &v1alpha1.LoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "lb-",
+				},
+				Spec: v1alpha1.LoadBalancerSpec{
+					Type:       v1alpha1.LoadBalancerTypePublic,
+					NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+					IPs: []v1alpha1.LoadBalancerIP{
+						{
+							Name:     "ip-1",
+							IPFamily: corev1.IPv4Protocol,
+						},
+					},
+					Ports: []v1alpha1.LoadBalancerPort{
+						{Port: 80},
+					},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Template: v1alpha1.InstanceTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+			By("creating a port forwarding with the IP of the load balancer")
+			portForwarding := &v1alpha1.PortForwarding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "pf-",
+				},
+				Spec: v1alpha1.PortForwardingSpec{
+					IPFamily:            corev1.IPv4Protocol,
+					IP:                  loadBalancer.Spec.IPs[0].IP,
+					NetworkInterfaceRef: corev1.LocalObjectReference{Name: "my-nic"},
+					TargetIP:            net.MustParseIP("192.168.0.1"),
+					Port:                8080,
+				},
+			}
+			Expect(k8sClient.Create(ctx, portForwarding)).To(Satisfy(apierrors.IsInvalid))
+		})
+	})
+
+	Context("NetworkQuota", func() {
+		It("should charge the IPs of public load balancers and users to the quota of their family", func(ctx SpecContext) {
+			By("creating a network quota")
+			networkQuota := &v1alpha1.NetworkQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "network-quota-",
+				},
+				Spec: v1alpha1.NetworkQuotaSpec{
+					Hard: map[v1alpha1.NetworkQuotaResourceName]int64{
+						v1alpha1.NetworkQuotaResourceIPv4IPs:       2,
+						v1alpha1.NetworkQuotaResourceNATGatewayIPs: 2,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, networkQuota)).To(Succeed())
+
+			newIP := func(claimRef *v1alpha1.IPClaimRef) *v1alpha1.IP {
+				return &v1alpha1.IP{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns.Name,
+						GenerateName: "ip-",
+					},
+					Spec: v1alpha1.IPSpec{
+						Type:     v1alpha1.IPTypePublic,
+						IPFamily: corev1.IPv4Protocol,
+						ClaimRef: claimRef,
+					},
+				}
+			}
+
+			By("asserting IPs are rejected while the usage of the quota is unknown")
+			Expect(k8sClient.Create(ctx, newIP(nil))).To(Satisfy(apierrors.IsForbidden))
+
+			By("reporting the usage of the quota as the controller manager does")
+			networkQuota.Status.Used = map[v1alpha1.NetworkQuotaResourceName]int64{
+				v1alpha1.NetworkQuotaResourceIPv4IPs:       0,
+				v1alpha1.NetworkQuotaResourceNATGatewayIPs: 0,
+			}
+			Expect(k8sClient.Status().Update(ctx, networkQuota)).To(Succeed())
+
+			By("waiting for an IP claimed by a user to be charged")
+			Eventually(func() error {
+				return k8sClient.Create(ctx, newIP(&v1alpha1.IPClaimRef{
+					Group:    v1alpha1.SchemeGroupVersion.Group,
+					Resource: "natgateways",
+					Name:     "foo",
+					UID:      "foo-uid",
+				}))
+			}).Should(Succeed())
+			Expect(Object(networkQuota)()).To(HaveField("Status.Used", HaveKeyWithValue(v1alpha1.NetworkQuotaResourceIPv4IPs, int64(1))))
+
+			By("creating a load balancer with more IPs than the IPv4 quota allows")
+			loadBalancer := &v1alpha1.LoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "lb-",
+				},
+				Spec: v1alpha1.LoadBalancerSpec{
+					Type:       v1alpha1.LoadBalancerTypePublic,
+					NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+					IPs: []v1alpha1.LoadBalancerIP{
+						{Name: "ip-1", IPFamily: corev1.IPv4Protocol},
+						{Name: "ip-2", IPFamily: corev1.IPv4Protocol},
+					},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Template: v1alpha1.InstanceTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Satisfy(apierrors.IsForbidden))
+
+			By("creating a load balancer with as many IPs as the IPv4 quota allows")
+			loadBalancer.Spec.IPs = loadBalancer.Spec.IPs[:1]
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+			Expect(loadBalancer.Spec.IPs).To(HaveEach(HaveField("IP", AsRef(Satisfy((*net.IP).IsValid)))))
+			Expect(Object(networkQuota)()).To(HaveField("Status.Used", HaveKeyWithValue(v1alpha1.NetworkQuotaResourceIPv4IPs, int64(2))))
+
+			By("creating a NAT gateway with more IPs than the NAT gateway IP quota allows")
+			natGateway := &v1alpha1.NATGateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "nat-gateway-",
+				},
+				Spec: v1alpha1.NATGatewaySpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+					IPFamily:   corev1.IPv4Protocol,
+					IPs: []v1alpha1.NATGatewayIP{
+						{Name: "ip-1"},
+						{Name: "ip-2"},
+						{Name: "ip-3"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, natGateway)).To(Satisfy(apierrors.IsForbidden))
+
+			By("creating a NAT gateway with as many IPs as the NAT gateway IP quota allows")
+			natGateway.Spec.IPs = natGateway.Spec.IPs[:2]
+			Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+			By("asserting the IPs of the NAT gateway were allocated although the IPv4 quota is used up")
+			Expect(ObjectList(&v1alpha1.IPList{},
+				client.InNamespace(ns.Name),
+			)()).To(HaveField("Items", HaveLen(4)))
+
+			By("deleting the load balancer")
+			Expect(k8sClient.Delete(ctx, loadBalancer)).To(Succeed())
+		})
+	})
 })
@@ -17,7 +17,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const NetworkInterfaceSpecNetworkRefNameField = "spec.networkRef.name"
+const (
+	NetworkInterfaceSpecNetworkRefNameField = "spec.networkRef.name"
+	NetworkInterfaceSpecNodeRefNameField    = "spec.nodeRef.name"
+)
 
 func ClaimNetworkInterfaceNAT(
 	ctx context.Context,
@@ -135,3 +138,10 @@ func SetupNetworkInterfaceNetworkNameFieldIndexer(ctx context.Context, indexer c
 		return []string{nic.Spec.NetworkRef.Name}
 	})
 }
+
+func SetupNetworkInterfaceNodeNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &v1alpha1.NetworkInterface{}, NetworkInterfaceSpecNodeRefNameField, func(obj client.Object) []string {
+		nic := obj.(*v1alpha1.NetworkInterface)
+		return []string{nic.Spec.NodeRef.Name}
+	})
+}
@@ -71,6 +71,10 @@ func PrefixV4() netip.Prefix {
 	return netip.MustParsePrefix("10.0.0.0/24")
 }
 
+func PrefixV6() netip.Prefix {
+	return netip.MustParsePrefix("2001:db8::/120")
+}
+
 var _ = BeforeSuite(func() {
 	logf.SetLogger(GinkgoLogr)
 
@@ -115,7 +119,7 @@ var _ = BeforeSuite(func() {
 		Port:         testEnvExt.APIServiceInstallOptions.LocalServingPort,
 		CertDir:      testEnvExt.APIServiceInstallOptions.LocalServingCertDir,
 		Args: apiserver.ProcessArgs{
-			"public-prefix": []string{PrefixV4().String()},
+			"public-prefix": []string{PrefixV4().String(), PrefixV6().String()},
 		},
 	})
 	Expect(err).NotTo(HaveOccurred())
@@ -153,6 +157,12 @@ var _ = BeforeSuite(func() {
 		AbsenceCache: lru.New(100),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	Expect((&NetworkIPAddressGCReconciler{
+		Client:       k8sManager.GetClient(),
+		APIReader:    k8sManager.GetAPIReader(),
+		AbsenceCache: lru.New(100),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	Expect((&NATGatewayReconciler{
 		Client:        k8sManager.GetClient(),
 		EventRecorder: &events.FakeRecorder{},
@@ -162,6 +172,16 @@ var _ = BeforeSuite(func() {
 		Client: k8sManager.GetClient(),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	Expect((&IPPoolReconciler{
+		Client:                                k8sManager.GetClient(),
+		EventRecorder:                         &events.FakeRecorder{},
+		UtilizationWarningThresholdPercentage: DefaultIPPoolUtilizationWarningThresholdPercentage,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&NetworkQuotaReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	Expect((&CertificateApprovalReconciler{
 		Client:      k8sManager.GetClient(),
 		Recognizers: ironcorenet.Recognizers,
@@ -195,6 +215,7 @@ var _ = BeforeSuite(func() {
 	DeferCleanup(cancel)
 
 	Expect(apinetclient.SetupNetworkInterfaceNetworkNameFieldIndexer(mgrCtx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(apinetclient.SetupNetworkInterfaceNodeNameFieldIndexer(mgrCtx, k8sManager.GetFieldIndexer())).To(Succeed())
 
 	go func() {
 		defer GinkgoRecover()
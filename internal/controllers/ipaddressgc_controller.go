@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -45,6 +46,10 @@ func (r *IPAddressGCReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	if quarantinedUntil, ok := addr.Annotations[v1alpha1.IPAddressQuarantinedUntilAnnotation]; ok {
+		return r.reconcileTombstone(ctx, log, addr, quarantinedUntil)
+	}
+
 	if r.GracePeriod > 0 {
 		now := time.Now()
 		if diff := now.Sub(addr.CreationTimestamp.Time); diff <= r.GracePeriod {
@@ -71,6 +76,22 @@ func (r *IPAddressGCReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
+func (r *IPAddressGCReconciler) reconcileTombstone(ctx context.Context, log logr.Logger, addr *v1alpha1.IPAddress, quarantinedUntil string) (ctrl.Result, error) {
+	until, err := time.Parse(time.RFC3339, quarantinedUntil)
+	if err != nil {
+		log.Error(err, "Invalid quarantine timestamp, releasing IP address", "QuarantinedUntil", quarantinedUntil)
+	} else if remaining := time.Until(until); remaining > 0 {
+		log.V(1).Info("IP address is quarantined", "QuarantinedUntil", until)
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Info("IP address quarantine expired, releasing IP address")
+	if err := r.Delete(ctx, addr); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting IP address: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
 func (r *IPAddressGCReconciler) ipAddressClaimerExists(ctx context.Context, addr *v1alpha1.IPAddress) (bool, error) {
 	claimRef := addr.Spec.ClaimRef
 	if _, ok := r.AbsenceCache.Get(claimRef.UID); ok {
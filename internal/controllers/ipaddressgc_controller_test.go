@@ -4,6 +4,8 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
@@ -43,15 +45,15 @@ var _ = Describe("IPAddressGarbageCollectorController", func() {
 		Consistently(Get(ipAddress)).Should(Succeed())
 	})
 
-	It("should release a IP address with a non-existent claimer", func(ctx SpecContext) {
-		By("creating a IP address")
+	createIPAddressWithoutClaimer := func(ctx SpecContext, annotations map[string]string) *v1alpha1.IPAddress {
 		var addr *v1alpha1.IPAddress
 		// We have to create the IP address in a loop since we can *not* know the free IP addresses.
 		// This is 'ugly', however, it's the best we can do with the current implementation.
 		for ip := PrefixV4().Masked().Addr(); PrefixV4().Contains(ip); ip = ip.Next() {
 			addr = &v1alpha1.IPAddress{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: ip.String(),
+					Name:        ip.String(),
+					Annotations: annotations,
 				},
 				Spec: v1alpha1.IPAddressSpec{
 					IP: net.NewIP(ip),
@@ -65,11 +67,35 @@ var _ = Describe("IPAddressGarbageCollectorController", func() {
 			}
 			err := k8sClient.Create(ctx, addr)
 			if err == nil {
-				break
+				return addr
 			}
 			Expect(err).To(Satisfy(apierrors.IsAlreadyExists))
 		}
-		Expect(addr).NotTo(BeNil(), "no free IP address could be found / created")
+		Fail("no free IP address could be found / created")
+		return nil
+	}
+
+	It("should release a IP address with a non-existent claimer", func(ctx SpecContext) {
+		By("creating a IP address")
+		addr := createIPAddressWithoutClaimer(ctx, nil)
+
+		By("waiting for the IP address to be deleted")
+		Eventually(Get(addr)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should hold a quarantined IP address until the quarantine expired", func(ctx SpecContext) {
+		By("creating a quarantined IP address")
+		addr := createIPAddressWithoutClaimer(ctx, map[string]string{
+			v1alpha1.IPAddressQuarantinedUntilAnnotation: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+
+		By("asserting the IP address stays present")
+		Consistently(Get(addr)).Should(Succeed())
+
+		By("expiring the quarantine")
+		Eventually(Update(addr, func() {
+			addr.Annotations[v1alpha1.IPAddressQuarantinedUntilAnnotation] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+		})).Should(Succeed())
 
 		By("waiting for the IP address to be deleted")
 		Eventually(Get(addr)).Should(Satisfy(apierrors.IsNotFound))
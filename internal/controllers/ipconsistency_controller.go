@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/ironcore-net/internal/ipconsistency"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	DefaultIPConsistencyCheckInterval = 10 * time.Minute
+	DefaultIPConsistencyGracePeriod   = 2 * time.Minute
+)
+
+var ipConsistencyIssues = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "apinet_ip_consistency_issues",
+		Help: "Number of inconsistencies between IPs, IP addresses and their claimers found by the last check.",
+	},
+	[]string{"type"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(ipConsistencyIssues)
+}
+
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=ips,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=ipaddresses,verbs=get;list;watch;delete
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgateways,verbs=get;list;watch
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// IPConsistencyController periodically checks the consistency between IPs, IP addresses
+// and their claimers. It reports every issue found as warning event and metric and
+// optionally fixes it.
+type IPConsistencyController struct {
+	Checker *ipconsistency.Checker
+	events.EventRecorder
+
+	// Interval is the interval between two checks.
+	Interval time.Duration
+	// Fix controls whether fixable issues are fixed.
+	Fix bool
+
+	log logr.Logger
+}
+
+func (c *IPConsistencyController) Start(ctx context.Context) error {
+	ctx = ctrl.LoggerInto(ctx, c.log)
+	wait.UntilWithContext(ctx, c.check, c.Interval)
+	return nil
+}
+
+func (c *IPConsistencyController) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *IPConsistencyController) check(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.V(1).Info("Checking IP consistency")
+	issues, err := c.Checker.Check(ctx)
+	if err != nil {
+		log.Error(err, "Error checking IP consistency")
+		return
+	}
+
+	counts := make(map[ipconsistency.IssueType]int)
+	for _, issue := range issues {
+		counts[issue.Type]++
+		log.Info("Found IP consistency issue", "Type", issue.Type, "Object", klog.KObj(issue.Object), "Address", issue.Address, "Message", issue.Message)
+		c.Eventf(issue.Object, nil, corev1.EventTypeWarning, string(issue.Type), "CheckConsistency", "%s", issue.Message)
+
+		if !c.Fix || !issue.Fixable() {
+			continue
+		}
+		if _, err := c.Checker.Fix(ctx, issue); err != nil {
+			log.Error(err, "Error fixing IP consistency issue", "Type", issue.Type, "Object", klog.KObj(issue.Object))
+			continue
+		}
+		log.Info("Fixed IP consistency issue", "Type", issue.Type, "Object", klog.KObj(issue.Object))
+	}
+
+	for _, issueType := range ipconsistency.IssueTypes {
+		ipConsistencyIssues.WithLabelValues(string(issueType)).Set(float64(counts[issueType]))
+	}
+	log.V(1).Info("Checked IP consistency", "Issues", len(issues))
+}
+
+func (c *IPConsistencyController) SetupWithManager(mgr ctrl.Manager) error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	c.log = mgr.GetLogger().WithName("ipconsistency")
+	return mgr.Add(c)
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/google/uuid"
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/ipconsistency"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("IPConsistencyController", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	It("should report and fix IPs with a dangling claim reference", func(ctx SpecContext) {
+		By("creating an IP claimed by a non-existent network interface")
+		ip := &v1alpha1.IP{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "ip-",
+			},
+			Spec: v1alpha1.IPSpec{
+				Type:     v1alpha1.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: &v1alpha1.IPClaimRef{
+					Group:    v1alpha1.GroupName,
+					Resource: "networkinterfaces",
+					Name:     "should-not-exist",
+					UID:      types.UID(uuid.NewString()),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ip)).To(Succeed())
+
+		checker := &ipconsistency.Checker{
+			Client: k8sClient,
+			Reader: k8sClient,
+		}
+
+		By("checking the consistency")
+		issues, err := checker.Check(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		var issue *ipconsistency.Issue
+		for i := range issues {
+			if issues[i].Object.GetUID() == ip.UID {
+				issue = &issues[i]
+			}
+		}
+		Expect(issue).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(ipconsistency.IssueTypeDanglingIPClaimRef),
+			"Address": Equal(ip.Spec.IP.Addr),
+		})))
+
+		By("fixing the issue")
+		Expect(checker.Fix(ctx, *issue)).To(BeTrue())
+
+		By("asserting the claim reference is removed")
+		Eventually(Object(ip)).Should(HaveField("Spec.ClaimRef", BeNil()))
+	})
+
+	It("should not report internal load balancer IPs of different networks as duplicate claims", func(ctx SpecContext) {
+		newNetwork := func() *v1alpha1.Network {
+			network := &v1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "network-",
+				},
+				Spec: v1alpha1.NetworkSpec{
+					Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+				},
+			}
+			Expect(k8sClient.Create(ctx, network)).To(Succeed())
+			return network
+		}
+		newInternalLoadBalancer := func(network *v1alpha1.Network) *v1alpha1.LoadBalancer {
+			loadBalancer := &v1alpha1.LoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "lb-",
+				},
+				Spec: v1alpha1.LoadBalancerSpec{
+					Type:       v1alpha1.LoadBalancerTypeInternal,
+					NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+					IPs: []v1alpha1.LoadBalancerIP{
+						{Name: "ipv4", IPFamily: corev1.IPv4Protocol, IP: net.MustParseIP("10.0.0.5")},
+					},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Template: v1alpha1.InstanceTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+			return loadBalancer
+		}
+
+		By("creating internal load balancers with the same IP in different networks")
+		loadBalancer1 := newInternalLoadBalancer(newNetwork())
+		loadBalancer2 := newInternalLoadBalancer(newNetwork())
+
+		checker := &ipconsistency.Checker{
+			Client: k8sClient,
+			Reader: k8sClient,
+		}
+
+		By("checking the consistency")
+		issues, err := checker.Check(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issues).NotTo(ContainElement(HaveField("Object.GetUID()", BeElementOf(loadBalancer1.UID, loadBalancer2.UID))))
+	})
+})
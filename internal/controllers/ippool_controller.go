@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	netiputils "github.com/ironcore-dev/ironcore-net/utils/netip"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	DefaultIPPoolUtilizationWarningThresholdPercentage = 80
+
+	ipPoolHighUtilizationReason = "HighUtilization"
+)
+
+var (
+	ipPoolPrefixTotalIPs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "apinet_ippool_prefix_ips_total",
+			Help: "Total number of IPs of an IP pool prefix.",
+		},
+		[]string{"pool", "prefix"},
+	)
+	ipPoolPrefixUsedIPs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "apinet_ippool_prefix_ips_used",
+			Help: "Number of allocated IPs of an IP pool prefix.",
+		},
+		[]string{"pool", "prefix"},
+	)
+	ipPoolPrefixFreeIPs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "apinet_ippool_prefix_ips_free",
+			Help: "Number of unallocated IPs of an IP pool prefix.",
+		},
+		[]string{"pool", "prefix"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ipPoolPrefixTotalIPs,
+		ipPoolPrefixUsedIPs,
+		ipPoolPrefixFreeIPs,
+	)
+}
+
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=ippools,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=ippools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=ipaddresses,verbs=get;list;watch
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// IPPoolReconciler reports the utilization of the prefixes of IP pools.
+type IPPoolReconciler struct {
+	client.Client
+	events.EventRecorder
+
+	// UtilizationWarningThresholdPercentage is the utilization of an IP pool in percent
+	// at which a warning event is emitted.
+	UtilizationWarningThresholdPercentage int32
+}
+
+func (r *IPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ipPool := &v1alpha1.IPPool{}
+	if err := r.Get(ctx, req.NamespacedName, ipPool); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.deleteMetrics(req.Name)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !ipPool.DeletionTimestamp.IsZero() {
+		r.deleteMetrics(ipPool.Name)
+		return ctrl.Result{}, nil
+	}
+	return r.reconcile(ctx, log, ipPool)
+}
+
+func (r *IPPoolReconciler) deleteMetrics(name string) {
+	labels := map[string]string{"pool": name}
+	ipPoolPrefixTotalIPs.DeletePartialMatch(labels)
+	ipPoolPrefixUsedIPs.DeletePartialMatch(labels)
+	ipPoolPrefixFreeIPs.DeletePartialMatch(labels)
+}
+
+func (r *IPPoolReconciler) reconcile(ctx context.Context, log logr.Logger, ipPool *v1alpha1.IPPool) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Listing IP addresses")
+	ipAddressList := &v1alpha1.IPAddressList{}
+	if err := r.List(ctx, ipAddressList); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing IP addresses: %w", err)
+	}
+
+	prefixStatuses := ipPoolPrefixStatuses(ipPool, ipAddressList.Items)
+	r.deleteMetrics(ipPool.Name)
+	for _, prefixStatus := range prefixStatuses {
+		prefix := prefixStatus.Prefix.String()
+		ipPoolPrefixTotalIPs.WithLabelValues(ipPool.Name, prefix).Set(float64(prefixStatus.Total))
+		ipPoolPrefixUsedIPs.WithLabelValues(ipPool.Name, prefix).Set(float64(prefixStatus.Used))
+		ipPoolPrefixFreeIPs.WithLabelValues(ipPool.Name, prefix).Set(float64(prefixStatus.Free))
+	}
+
+	oldUtilization := ipPoolUtilizationPercentage(ipPool.Status.Prefixes)
+	newUtilization := ipPoolUtilizationPercentage(prefixStatuses)
+	threshold := float64(r.UtilizationWarningThresholdPercentage)
+	if oldUtilization < threshold && newUtilization >= threshold {
+		log.V(1).Info("IP pool utilization crossed threshold", "Utilization", newUtilization)
+		r.Eventf(ipPool, nil, corev1.EventTypeWarning, ipPoolHighUtilizationReason, "Allocating",
+			"IP pool utilization is at %.1f%%, exceeding the threshold of %d%%",
+			newUtilization, r.UtilizationWarningThresholdPercentage,
+		)
+	}
+
+	log.V(1).Info("Updating status")
+	if err := r.updateStatus(ctx, ipPool, prefixStatuses); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+// ipPoolPrefixStatuses computes the utilization of each prefix of the IP pool from the given IP addresses.
+func ipPoolPrefixStatuses(ipPool *v1alpha1.IPPool, ipAddresses []v1alpha1.IPAddress) []v1alpha1.IPPoolPrefixStatus {
+	if len(ipPool.Spec.Prefixes) == 0 {
+		return nil
+	}
+
+	statuses := make([]v1alpha1.IPPoolPrefixStatus, len(ipPool.Spec.Prefixes))
+	for i, prefix := range ipPool.Spec.Prefixes {
+		statuses[i] = v1alpha1.IPPoolPrefixStatus{
+			Prefix: prefix,
+			Total:  netiputils.PrefixSize(prefix.Prefix),
+		}
+	}
+
+	for _, ipAddress := range ipAddresses {
+		addr := ipAddress.Spec.IP.Addr
+		for i, prefix := range ipPool.Spec.Prefixes {
+			if prefix.Contains(addr) {
+				statuses[i].Used++
+				break
+			}
+		}
+	}
+
+	for i, prefix := range ipPool.Spec.Prefixes {
+		excluded := ipPoolExcludedSize(prefix, ipPool.Spec.Excluded)
+		statuses[i].Free = max(statuses[i].Total-statuses[i].Used-excluded, 0)
+	}
+	return statuses
+}
+
+// ipPoolExcludedSize returns the number of IPs of the prefix that are excluded from allocation.
+func ipPoolExcludedSize(prefix net.IPPrefix, excluded []net.IPPrefix) int64 {
+	var size int64
+	for _, r := range excluded {
+		switch {
+		case prefix.Overlaps(r.Prefix) && prefix.Bits() <= r.Bits():
+			size += netiputils.PrefixSize(r.Prefix)
+		case prefix.Overlaps(r.Prefix):
+			size += netiputils.PrefixSize(prefix.Prefix)
+		}
+	}
+	return size
+}
+
+// ipPoolUtilizationPercentage returns the share of used IPs of all prefixes in percent.
+func ipPoolUtilizationPercentage(prefixStatuses []v1alpha1.IPPoolPrefixStatus) float64 {
+	var total, used float64
+	for _, prefixStatus := range prefixStatuses {
+		total += float64(prefixStatus.Total)
+		used += float64(prefixStatus.Used)
+	}
+	if total == 0 {
+		return 0
+	}
+	return used / total * 100
+}
+
+func (r *IPPoolReconciler) updateStatus(ctx context.Context, ipPool *v1alpha1.IPPool, prefixStatuses []v1alpha1.IPPoolPrefixStatus) error {
+	base := ipPool.DeepCopy()
+	ipPool.Status.Prefixes = prefixStatuses
+	if equality.Semantic.DeepEqual(base.Status, ipPool.Status) {
+		return nil
+	}
+	return r.Status().Patch(ctx, ipPool, client.MergeFrom(base))
+}
+
+func (r *IPPoolReconciler) enqueueByIPAddress() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		ipAddress := obj.(*v1alpha1.IPAddress)
+		log := ctrl.LoggerFrom(ctx)
+
+		ipPoolList := &v1alpha1.IPPoolList{}
+		if err := r.List(ctx, ipPoolList); err != nil {
+			log.Error(err, "Error listing IP pools")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, ipPool := range ipPoolList.Items {
+			for _, prefix := range ipPool.Spec.Prefixes {
+				if prefix.Contains(ipAddress.Spec.IP.Addr) {
+					reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&ipPool)})
+					break
+				}
+			}
+		}
+		return reqs
+	})
+}
+
+func (r *IPPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.IPPool{}).
+		Watches(
+			&v1alpha1.IPAddress{},
+			r.enqueueByIPAddress(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("IPPoolController", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	It("should report the utilization of the pool prefixes", func(ctx SpecContext) {
+		By("creating an IP pool")
+		ipPool := &v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "ip-pool-",
+			},
+			Spec: v1alpha1.IPPoolSpec{
+				Type:     v1alpha1.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("192.168.100.0/29")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ipPool)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ipPool)
+
+		By("creating an IP allocated from the pool")
+		ip := &v1alpha1.IP{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "ip-",
+			},
+			Spec: v1alpha1.IPSpec{
+				Type:     v1alpha1.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+				PoolRef:  &corev1.LocalObjectReference{Name: ipPool.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ip)).To(Succeed())
+
+		By("waiting for the pool status to report the allocated IP")
+		Eventually(Object(ipPool)).Should(HaveField("Status.Prefixes", ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Prefix": Equal(net.MustParseIPPrefix("192.168.100.0/29")),
+			"Total":  BeEquivalentTo(8),
+			"Used":   BeEquivalentTo(1),
+			"Free":   BeEquivalentTo(7),
+		}))))
+
+		By("deleting the IP")
+		Expect(k8sClient.Delete(ctx, ip)).To(Succeed())
+
+		By("waiting for the pool status to report the released IP")
+		Eventually(Object(ipPool)).Should(HaveField("Status.Prefixes", ConsistOf(HaveField("Used", BeEquivalentTo(0)))))
+	})
+})
@@ -24,7 +24,7 @@ type LoadBalancerReconciler struct {
 
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancers,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancers/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancerroutings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancerroutings,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=daemonsets,verbs=get;list;watch;create;update;patch
 
 func (r *LoadBalancerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -44,6 +44,12 @@ func (r *LoadBalancerReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		if err := r.Delete(ctx, loadBalancerRouting); client.IgnoreNotFound(err) != nil {
 			return ctrl.Result{}, fmt.Errorf("error deleting load balancer routing: %w", err)
 		}
+		if err := r.DeleteAllOf(ctx, &v1alpha1.LoadBalancerRouting{},
+			client.InNamespace(req.Namespace),
+			client.MatchingLabels{v1alpha1.LoadBalancerNameLabel: req.Name},
+		); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting load balancer routing slices: %w", err)
+		}
 		return ctrl.Result{}, nil
 	}
 
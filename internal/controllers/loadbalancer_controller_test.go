@@ -5,6 +5,7 @@ package controllers
 
 import (
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -139,4 +140,60 @@ var _ = Describe("LoadBalancerController", func() {
 			},
 		}))
 	})
+
+	It("should reconcile an internal load balancer with an IP allocated from its network", func(ctx SpecContext) {
+		By("creating a network with prefixes")
+		network := &v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "network-",
+			},
+			Spec: v1alpha1.NetworkSpec{
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+		By("creating an internal load balancer")
+		loadBalancer := &v1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "load-balancer-",
+			},
+			Spec: v1alpha1.LoadBalancerSpec{
+				Type:       v1alpha1.LoadBalancerTypeInternal,
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs: []v1alpha1.LoadBalancerIP{
+					{
+						Name:     "ip-1",
+						IPFamily: corev1.IPv4Protocol,
+					},
+				},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"foo": "bar"},
+				},
+				Template: v1alpha1.InstanceTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"foo": "bar"},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+		By("inspecting the IP allocated from the network")
+		Expect(loadBalancer.Spec.IPs).To(ConsistOf(
+			HaveField("IP", net.MustParseIP("10.0.0.1")),
+		))
+		ips := v1alpha1.GetLoadBalancerIPs(loadBalancer)
+
+		By("waiting for the internal load balancer to create a daemon set with the allocated IP")
+		daemonSet := &v1alpha1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      v1alpha1.LoadBalancerDaemonSetName(loadBalancer.Name),
+			},
+		}
+		Eventually(Object(daemonSet)).Should(HaveField("Spec.Template.Spec.IPs", ips))
+	})
 })
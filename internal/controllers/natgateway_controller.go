@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
@@ -15,17 +17,40 @@ import (
 	corev1alpha1apply "github.com/ironcore-dev/ironcore-net/client-go/applyconfigurations/core/v1alpha1"
 	apinetclient "github.com/ironcore-dev/ironcore-net/internal/client"
 	"github.com/ironcore-dev/ironcore-net/internal/natgateway"
+	"github.com/ironcore-dev/ironcore-net/utils/hash"
 	"github.com/ironcore-dev/ironcore-net/utils/maps"
 	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
 	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// defaultNATPortBlockCoolDownPeriod is the default period a network interface has to be free of
+	// NAT port pressure before its additional port blocks are reclaimed.
+	defaultNATPortBlockCoolDownPeriod = 5 * time.Minute
+
+	// defaultNATGatewayIPDrainPeriod is the default period a draining IP is retained after all
+	// network interfaces have been migrated off it. Until then, the migrated network interfaces keep
+	// their sections on the draining IP alongside their new ones.
+	defaultNATGatewayIPDrainPeriod = 2 * time.Minute
+
+	// defaultNATGatewayReservationRetentionPeriod is the default period the port range of a network
+	// interface stays reserved after the network interface is gone.
+	defaultNATGatewayReservationRetentionPeriod = 24 * time.Hour
 )
 
 type NATGatewayReconciler struct {
@@ -35,7 +60,8 @@ type NATGatewayReconciler struct {
 
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgateways,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgateways/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=nattables,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=nattables,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch;patch;update
 
 func (r *NATGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -55,6 +81,12 @@ func (r *NATGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if err := r.Delete(ctx, natTable); client.IgnoreNotFound(err) != nil {
 			return ctrl.Result{}, fmt.Errorf("error deleting NAT table: %w", err)
 		}
+		if err := r.DeleteAllOf(ctx, &v1alpha1.NATTable{},
+			client.InNamespace(req.Namespace),
+			client.MatchingLabels{v1alpha1.NATGatewayNameLabel: req.Name},
+		); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting NAT table slices: %w", err)
+		}
 		return ctrl.Result{}, nil
 	}
 	return r.reconcileExists(ctx, log, natGateway)
@@ -73,21 +105,63 @@ func (r *NATGatewayReconciler) delete(ctx context.Context, log logr.Logger, natG
 	return ctrl.Result{}, nil
 }
 
-func (r *NATGatewayReconciler) updateNATGatewayUsedRequests(
+func (r *NATGatewayReconciler) updateNATGatewayStatus(
 	ctx context.Context,
 	natGateway *v1alpha1.NATGateway,
-	usedNATIPs int64,
-	requests int64,
+	status v1alpha1.NATGatewayStatus,
 ) error {
 	base := natGateway.DeepCopy()
-	natGateway.Status.UsedNATIPs = usedNATIPs
-	natGateway.Status.RequestedNATIPs = requests
+	natGateway.Status = status
 	if err := r.Status().Patch(ctx, natGateway, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("error patching nat gateway status: %w", err)
 	}
 	return nil
 }
 
+func sumNATGatewayIPFamilyStatuses(ipFamilyStatuses []v1alpha1.NATGatewayIPFamilyStatus) (used, requests int64) {
+	for _, ipFamilyStatus := range ipFamilyStatuses {
+		used += ipFamilyStatus.UsedNATIPs
+		requests += ipFamilyStatus.RequestedNATIPs
+	}
+	return used, requests
+}
+
+// natGatewayFullCondition returns the Full condition of the NAT gateway for the given IP families
+// without a free port range for all of their network interfaces.
+func natGatewayFullCondition(natGateway *v1alpha1.NATGateway, fullIPFamilies []corev1.IPFamily) metav1.Condition {
+	if len(fullIPFamilies) == 0 {
+		return metav1.Condition{
+			Type:               v1alpha1.NATGatewayFull,
+			Status:             metav1.ConditionFalse,
+			Reason:             "PortRangesAvailable",
+			Message:            "all network interfaces have been assigned a port range",
+			ObservedGeneration: natGateway.Generation,
+		}
+	}
+
+	ipFamilies := make([]string, 0, len(fullIPFamilies))
+	for _, ipFamily := range fullIPFamilies {
+		ipFamilies = append(ipFamilies, string(ipFamily))
+	}
+	return metav1.Condition{
+		Type:               v1alpha1.NATGatewayFull,
+		Status:             metav1.ConditionTrue,
+		Reason:             "NoFreePortRange",
+		Message:            fmt.Sprintf("no free port range for all network interfaces of IP families %s", strings.Join(ipFamilies, ", ")),
+		ObservedGeneration: natGateway.Generation,
+	}
+}
+
+// natTableStatus is the status of the NAT table of a NAT gateway.
+type natTableStatus struct {
+	ipFamilies     []v1alpha1.NATGatewayIPFamilyStatus
+	drainingIPs    []v1alpha1.NATGatewayDrainingIPStatus
+	ips            []v1alpha1.NATGatewayIPStatus
+	fullIPFamilies []corev1.IPFamily
+	reservations   []v1alpha1.NATGatewayReservation
+	requeueAfter   time.Duration
+}
+
 // natIPAllocation bundles a NAT IP with a target.
 type natIPAllocation struct {
 	// IP is the NATed IP.
@@ -96,57 +170,401 @@ type natIPAllocation struct {
 	v1alpha1.NATIPSection
 }
 
-func (r *NATGatewayReconciler) getExistingAllocations(ctx context.Context, natGateway *v1alpha1.NATGateway, ips []net.IP) (map[types.UID]natIPAllocation, error) {
-	routing := &v1alpha1.NATTable{}
-	if err := r.Get(ctx, client.ObjectKeyFromObject(natGateway), routing); err != nil {
+// natTableName returns the name of the NAT table slice of the NAT gateway holding the sections
+// of the network interfaces on the node with the given name.
+func natTableName(natGatewayName, nodeName string) string {
+	return fmt.Sprintf("%s-%s", natGatewayName, hash.ComputeWithCollisionCount(nil, []byte(nodeName)))
+}
+
+// listNATTables lists all NAT table slices of the NAT gateway.
+func (r *NATGatewayReconciler) listNATTables(ctx context.Context, natGateway *v1alpha1.NATGateway) ([]v1alpha1.NATTable, error) {
+	natTableList := &v1alpha1.NATTableList{}
+	if err := r.List(ctx, natTableList,
+		client.InNamespace(natGateway.Namespace),
+		client.MatchingLabels{v1alpha1.NATGatewayNameLabel: natGateway.Name},
+	); err != nil {
+		return nil, fmt.Errorf("error listing NAT tables: %w", err)
+	}
+
+	// NAT tables created before slicing are not labeled, get them by their name.
+	natTable := &v1alpha1.NATTable{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(natGateway), natTable); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return nil, fmt.Errorf("error getting NAT gateway routing: %w", err)
+			return nil, fmt.Errorf("error getting NAT table: %w", err)
 		}
-
-		return nil, nil
+		return natTableList.Items, nil
 	}
+	return append(natTableList.Items, *natTable), nil
+}
 
+func (r *NATGatewayReconciler) getExistingAllocations(
+	natGateway *v1alpha1.NATGateway,
+	ips []net.IP,
+	natTables []v1alpha1.NATTable,
+) map[corev1.IPFamily]map[types.UID][]natIPAllocation {
 	var (
-		mgr       = natgateway.NewAllocationManager(natGateway.Spec.PortsPerNetworkInterface, ips)
-		allocByID = make(map[types.UID]natIPAllocation)
+		mgr                  = natgateway.NewAllocationManager(natGateway.Spec.PortsPerNetworkInterface, ips)
+		allocByIPFamilyAndID = make(map[corev1.IPFamily]map[types.UID][]natIPAllocation)
 	)
 
-	for _, ip := range routing.IPs {
-		if !mgr.HasIP(ip.IP) {
-			// IP has been removed - short circuit iteration and continue.
-			continue
+	for _, natTable := range natTables {
+		for _, ip := range natTable.IPs {
+			if !mgr.HasIP(ip.IP) {
+				// IP has been removed - short circuit iteration and continue.
+				continue
+			}
+
+			ipFamily := ip.IP.Family()
+			for _, tgt := range ip.Sections {
+				ref := tgt.TargetRef
+				if ref == nil {
+					// TODO: When IPs are finally unique, we don't need the TargetRef anymore.
+					continue
+				}
+				if mgr.Use(ip.IP, tgt.Port, tgt.EndPort) {
+					allocs := allocByIPFamilyAndID[ipFamily][ref.UID]
+					allocByIPFamilyAndID[ipFamily] = maps.Append(allocByIPFamilyAndID[ipFamily], ref.UID, append(allocs, natIPAllocation{ip.IP, tgt}))
+				}
+			}
 		}
+	}
+	return allocByIPFamilyAndID
+}
+
+// splitNATIPAllocations splits the allocations of a network interface into its base allocation
+// spanning the given number of ports and its additional port blocks.
+func splitNATIPAllocations(
+	mgr *natgateway.AllocationManager,
+	allocs []natIPAllocation,
+	ports int32,
+) (base natIPAllocation, extras []natIPAllocation, ok bool) {
+	slots := mgr.SlotsForPorts(ports)
+	for i, alloc := range allocs {
+		if mgr.SlotsForPorts(alloc.EndPort+1-alloc.Port) == slots {
+			return alloc, slices.Delete(slices.Clone(allocs), i, i+1), true
+		}
+	}
+	return natIPAllocation{}, nil, false
+}
+
+// manageExtraPortBlocks manages the additional port blocks of a network interface. Additional port blocks
+// are adjacent to the base allocation so that all ports of the network interface form a single port range.
+// An additional port block is granted whenever the network interface reports NAT port pressure for its
+// current ports, up to the configured maximum. Once the network interface has been free of NAT port pressure
+// for the cool-down period, all additional port blocks are reclaimed.
+// It returns the sections of the additional port blocks and when to re-check them.
+func (r *NATGatewayReconciler) manageExtraPortBlocks(
+	natGateway *v1alpha1.NATGateway,
+	nic *v1alpha1.NetworkInterface,
+	ipFamily corev1.IPFamily,
+	mgr *natgateway.AllocationManager,
+	base natIPAllocation,
+	extras []natIPAllocation,
+) (sections []v1alpha1.NATIPSection, requeueAfter time.Duration) {
+	expansion := natGateway.Spec.PortBlockExpansion
+	if expansion == nil || expansion.MaxExtraPortBlocks == 0 {
+		// Not using the additional port blocks frees them.
+		return nil, 0
+	}
 
-		for _, tgt := range ip.Sections {
-			ref := tgt.TargetRef
-			if ref == nil {
-				// TODO: When IPs are finally unique, we don't need the TargetRef anymore.
+	// Keep the additional port blocks that extend the port range of the base allocation.
+	port, endPort := base.Port, base.EndPort
+	for kept := true; kept && len(sections) < int(expansion.MaxExtraPortBlocks); {
+		kept = false
+		for i, extra := range extras {
+			if extra.ip != base.ip || (extra.EndPort+1 != port && extra.Port != endPort+1) {
 				continue
 			}
-			if mgr.Use(ip.IP, tgt.Port, tgt.EndPort) {
-				allocByID[ref.UID] = natIPAllocation{ip.IP, tgt}
+			if !mgr.Use(extra.ip, extra.Port, extra.EndPort) {
+				continue
 			}
+
+			sections = append(sections, extra.NATIPSection)
+			port, endPort = min(port, extra.Port), max(endPort, extra.EndPort)
+			extras = slices.Delete(extras, i, i+1)
+			kept = true
+			break
+		}
+	}
+
+	natStatus := v1alpha1.GetNetworkInterfaceNATStatus(nic, ipFamily)
+	if natStatus != nil && natStatus.PortPressure {
+		if natStatus.Ports != endPort+1-port || len(sections) >= int(expansion.MaxExtraPortBlocks) {
+			// Port pressure was not reported for the current ports or no more port blocks may be granted.
+			return sections, 0
+		}
+
+		adjacentPort, adjacentEndPort, ok := mgr.UseAdjacent(base.ip, port, endPort)
+		if !ok {
+			// No adjacent port block is free.
+			return sections, 0
+		}
+
+		section := base.NATIPSection
+		section.Port, section.EndPort = adjacentPort, adjacentEndPort
+		return append(sections, section), 0
+	}
+
+	if len(sections) == 0 {
+		return nil, 0
+	}
+
+	coolDownPeriod := defaultNATPortBlockCoolDownPeriod
+	if expansion.CoolDownPeriod != nil {
+		coolDownPeriod = expansion.CoolDownPeriod.Duration
+	}
+	if natStatus != nil && natStatus.LastTransitionTime != nil {
+		if remaining := coolDownPeriod - time.Since(natStatus.LastTransitionTime.Time); remaining > 0 {
+			return sections, remaining
+		}
+	}
+
+	// The network interface has been free of NAT port pressure for the cool-down period - reclaim the
+	// additional port blocks.
+	for _, section := range sections {
+		mgr.Release(base.ip, section.Port, section.EndPort)
+	}
+	return nil, 0
+}
+
+// natReservationKey returns the key the sticky port range reservation of the network interface is remembered by.
+func natReservationKey(nic *v1alpha1.NetworkInterface) string {
+	if key := nic.Labels[v1alpha1.NATReservationKeyLabel]; key != "" {
+		return key
+	}
+	return string(nic.UID)
+}
+
+// natReservations tracks the sticky port range reservations of an IP family of a NAT gateway.
+// A nil *natReservations does not reserve any port range.
+type natReservations struct {
+	mgr             *natgateway.AllocationManager
+	retentionPeriod time.Duration
+	// existing are the reservations reported by the NAT gateway status by key.
+	existing map[string]v1alpha1.NATGatewayReservation
+	// held are the reservations of network interfaces without an allocation by key.
+	held map[string]v1alpha1.NATGatewayReservation
+	// allocated are the reservations of the network interfaces with an allocation.
+	allocated     []v1alpha1.NATGatewayReservation
+	allocatedKeys sets.Set[string]
+}
+
+// newNATReservations returns the sticky port range reservations of the given IP family of the NAT gateway.
+// If the NAT gateway has no sticky reservations, nil is returned.
+func newNATReservations(natGateway *v1alpha1.NATGateway, ipFamily corev1.IPFamily, mgr *natgateway.AllocationManager) *natReservations {
+	stickyReservations := natGateway.Spec.StickyReservations
+	if stickyReservations == nil {
+		return nil
+	}
+
+	retentionPeriod := defaultNATGatewayReservationRetentionPeriod
+	if stickyReservations.RetentionPeriod != nil {
+		retentionPeriod = stickyReservations.RetentionPeriod.Duration
+	}
+
+	existing := make(map[string]v1alpha1.NATGatewayReservation)
+	for _, reservation := range natGateway.Status.Reservations {
+		if reservation.IP.Family() == ipFamily {
+			existing[reservation.Key] = reservation
+		}
+	}
+	return &natReservations{
+		mgr:             mgr,
+		retentionPeriod: retentionPeriod,
+		existing:        existing,
+		held:            make(map[string]v1alpha1.NATGatewayReservation),
+		allocatedKeys:   sets.New[string](),
+	}
+}
+
+// hold uses the port ranges of all unexpired reservations except the ones of the given keys, whose network
+// interfaces keep their existing allocation. Reservations that cannot be held anymore are dropped.
+func (r *natReservations) hold(keepKeys sets.Set[string]) {
+	if r == nil {
+		return
+	}
+
+	for _, key := range sets.List(sets.KeySet(r.existing)) {
+		if keepKeys.Has(key) {
+			continue
 		}
+
+		reservation := r.existing[key]
+		if reservation.ReleasedTime != nil && time.Since(reservation.ReleasedTime.Time) >= r.retentionPeriod {
+			// The reservation expired.
+			continue
+		}
+		if r.mgr.IsDraining(reservation.IP) || !r.mgr.Use(reservation.IP, reservation.Port, reservation.EndPort) {
+			// The IP is draining or gone, or the port range has been allocated otherwise.
+			continue
+		}
+		r.held[key] = reservation
 	}
-	return allocByID, nil
 }
 
-func (r *NATGatewayReconciler) natGatewayNetworkInterfaceSelector(natGateway *v1alpha1.NATGateway) func(*v1alpha1.NetworkInterface) bool {
+// take returns the held port range of the network interface if it spans the given number of ports.
+// A held port range of a different number of ports is released.
+func (r *natReservations) take(nic *v1alpha1.NetworkInterface, ports int32) (ip net.IP, port, endPort int32, ok bool) {
+	if r == nil {
+		return net.IP{}, 0, 0, false
+	}
+
+	key := natReservationKey(nic)
+	reservation, ok := r.held[key]
+	if !ok {
+		return net.IP{}, 0, 0, false
+	}
+	if reservation.EndPort+1-reservation.Port != ports {
+		// The ports of the network interface changed - a new port range has to be allocated.
+		r.mgr.Release(reservation.IP, reservation.Port, reservation.EndPort)
+		delete(r.held, key)
+		return net.IP{}, 0, 0, false
+	}
+	return reservation.IP, reservation.Port, reservation.EndPort, true
+}
+
+// record remembers the port range allocated for the network interface.
+func (r *natReservations) record(nic *v1alpha1.NetworkInterface, ip net.IP, port, endPort int32) {
+	if r == nil {
+		return
+	}
+
+	key := natReservationKey(nic)
+	delete(r.held, key)
+	if r.allocatedKeys.Has(key) {
+		// The key is not unique - only the first network interface is remembered.
+		return
+	}
+	r.allocatedKeys.Insert(key)
+	r.allocated = append(r.allocated, v1alpha1.NATGatewayReservation{
+		Key:     key,
+		IP:      ip,
+		Port:    port,
+		EndPort: endPort,
+	})
+}
+
+// reservations returns the reservations of the allocated network interfaces and the still held reservations
+// of gone network interfaces. The returned duration reports when the next held reservation expires.
+func (r *natReservations) reservations() (reservations []v1alpha1.NATGatewayReservation, requeueAfter time.Duration) {
+	if r == nil {
+		return nil, 0
+	}
+
+	reservations = append(reservations, r.allocated...)
+	for _, key := range sets.List(sets.KeySet(r.held)) {
+		reservation := r.held[key]
+		if reservation.ReleasedTime == nil {
+			releasedTime := metav1.Now()
+			reservation.ReleasedTime = &releasedTime
+		}
+		if remaining := r.retentionPeriod - time.Since(reservation.ReleasedTime.Time); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+		reservations = append(reservations, reservation)
+	}
+	slices.SortFunc(reservations, func(a, b v1alpha1.NATGatewayReservation) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return reservations, requeueAfter
+}
+
+// natGatewayLabelSelector returns the selector of the network interfaces of the network selected by the NAT gateway.
+func natGatewayLabelSelector(natGateway *v1alpha1.NATGateway) labels.Selector {
+	if natGateway.Spec.NetworkInterfaceSelector == nil {
+		return labels.Everything()
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(natGateway.Spec.NetworkInterfaceSelector)
+	if err != nil {
+		// Selectors are validated by the API - don't select anything using an invalid one.
+		return labels.Nothing()
+	}
+	return sel
+}
+
+// natGatewayPrecedes reports whether NAT gateway a takes precedence over NAT gateway b when both
+// select the same network interface.
+func natGatewayPrecedes(a, b *v1alpha1.NATGateway) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// listNetworkNATGateways lists all other NAT gateways of the network of the NAT gateway that are not being deleted.
+func (r *NATGatewayReconciler) listNetworkNATGateways(ctx context.Context, natGateway *v1alpha1.NATGateway) ([]v1alpha1.NATGateway, error) {
+	natGatewayList := &v1alpha1.NATGatewayList{}
+	if err := r.List(ctx, natGatewayList,
+		client.InNamespace(natGateway.Namespace),
+	); err != nil {
+		return nil, fmt.Errorf("error listing NAT gateways: %w", err)
+	}
+
+	var res []v1alpha1.NATGateway
+	for _, other := range natGatewayList.Items {
+		if other.UID == natGateway.UID ||
+			other.Spec.NetworkRef.Name != natGateway.Spec.NetworkRef.Name ||
+			!other.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		res = append(res, other)
+	}
+	return res, nil
+}
+
+// natGatewayNetworkInterfaceSelector returns a function reporting whether the NAT gateway NATs a network interface
+// for the given IP family. A network interface selected by multiple NAT gateways serving the IP family is only NATed
+// by the NAT gateway taking precedence. A NAT64 NAT gateway only NATs IPv6-only network interfaces.
+func (r *NATGatewayReconciler) natGatewayNetworkInterfaceSelector(
+	natGateway *v1alpha1.NATGateway,
+	networkNATGateways []v1alpha1.NATGateway,
+	ipFamily corev1.IPFamily,
+) func(*v1alpha1.NetworkInterface) bool {
+	var (
+		sel           = natGatewayLabelSelector(natGateway)
+		nicIPFamily   = v1alpha1.GetNATGatewayNetworkInterfaceIPFamily(natGateway, ipFamily)
+		precedingSels []labels.Selector
+	)
+	for i := range networkNATGateways {
+		other := &networkNATGateways[i]
+		if slices.Contains(v1alpha1.GetNATGatewayIPFamilies(other), ipFamily) &&
+			v1alpha1.GetNATGatewayNetworkInterfaceIPFamily(other, ipFamily) == nicIPFamily &&
+			natGatewayPrecedes(other, natGateway) {
+			precedingSels = append(precedingSels, natGatewayLabelSelector(other))
+		}
+	}
+
 	return func(nic *v1alpha1.NetworkInterface) bool {
-		var found bool
-		for _, ip := range nic.Spec.IPs {
-			if ip.Family() == natGateway.Spec.IPFamily {
-				found = true
-				break
+		if !sel.Matches(labels.Set(nic.Labels)) {
+			return false
+		}
+
+		for _, precedingSel := range precedingSels {
+			if precedingSel.Matches(labels.Set(nic.Labels)) {
+				// Another NAT gateway takes precedence for the network interface.
+				return false
 			}
 		}
-		if !found {
+
+		nicIPFamilies := utilslices.ToSetFunc(nic.Spec.IPs, net.IP.Family)
+		if !nicIPFamilies.Has(nicIPFamily) {
 			// Network interface does not support the NAT ip family.
 			return false
 		}
+		if nicIPFamily != ipFamily && nicIPFamilies.Has(ipFamily) {
+			// Network interface is not IPv6-only and is not NAT64-translated.
+			return false
+		}
 
 		for _, publicIP := range nic.Spec.PublicIPs {
-			if publicIP.IPFamily == natGateway.Spec.IPFamily {
+			if publicIP.IPFamily == ipFamily {
 				// Network interface already has a public IP.
 				return false
 			}
@@ -156,65 +574,366 @@ func (r *NATGatewayReconciler) natGatewayNetworkInterfaceSelector(natGateway *v1
 	}
 }
 
+// natGatewayNetworkInterfacePorts returns a function reporting the number of ports to allocate for a network interface.
+// The first matching override of the NAT gateway applies, otherwise its PortsPerNetworkInterface.
+func (r *NATGatewayReconciler) natGatewayNetworkInterfacePorts(natGateway *v1alpha1.NATGateway) func(*v1alpha1.NetworkInterface) int32 {
+	type portsOverride struct {
+		sel   labels.Selector
+		ports int32
+	}
+
+	var overrides []portsOverride
+	for _, override := range natGateway.Spec.PortsPerNetworkInterfaceOverrides {
+		sel, err := metav1.LabelSelectorAsSelector(override.NetworkInterfaceSelector)
+		if err != nil {
+			// Selectors are validated by the API - skip invalid ones.
+			continue
+		}
+
+		overrides = append(overrides, portsOverride{sel: sel, ports: override.PortsPerNetworkInterface})
+	}
+
+	return func(nic *v1alpha1.NetworkInterface) int32 {
+		for _, override := range overrides {
+			if override.sel.Matches(labels.Set(nic.Labels)) {
+				return override.ports
+			}
+		}
+		return natGateway.Spec.PortsPerNetworkInterface
+	}
+}
+
 func (r *NATGatewayReconciler) manageNATTable(
 	ctx context.Context,
 	natGateway *v1alpha1.NATGateway,
 	ips []net.IP,
-	existingAllocByNicID map[types.UID]natIPAllocation,
-) (used, requests int64, err error) {
+	natTables []v1alpha1.NATTable,
+	existingAllocsByIPFamily map[corev1.IPFamily]map[types.UID][]natIPAllocation,
+) (*natTableStatus, error) {
 	nicList := &v1alpha1.NetworkInterfaceList{}
 	if err := r.List(ctx, nicList,
 		client.InNamespace(natGateway.Namespace),
 		client.MatchingFields{apinetclient.NetworkInterfaceSpecNetworkRefNameField: natGateway.Spec.NetworkRef.Name},
 	); err != nil {
-		return 0, 0, fmt.Errorf("error listing network interfaces: %w", err)
+		return nil, fmt.Errorf("error listing network interfaces: %w", err)
+	}
+
+	networkNATGateways, err := r.listNetworkNATGateways(ctx, natGateway)
+	if err != nil {
+		return nil, err
 	}
 
 	var (
 		mgr            = natgateway.NewAllocationManager(natGateway.Spec.PortsPerNetworkInterface, ips)
-		sel            = r.natGatewayNetworkInterfaceSelector(natGateway)
-		ipToAllocation = make(map[net.IP]map[types.UID]v1alpha1.NATIPSection)
-		addAlloc       = func(ip net.IP, target v1alpha1.NATIPSection) {
-			ipToAllocation[ip] = maps.Append(ipToAllocation[ip], target.TargetRef.UID, target)
+		ipFamilies     = v1alpha1.GetNATGatewayIPFamilies(natGateway)
+		nicPorts       = r.natGatewayNetworkInterfacePorts(natGateway)
+		ipToAllocation = make(map[net.IP][]v1alpha1.NATIPSection)
+		drain          = newNATIPDrain(natGateway, time.Now())
+		status         = &natTableStatus{}
+		errs           []error
+	)
+	for _, ip := range natGateway.Spec.IPs {
+		if ip.Draining {
+			mgr.Drain(ip.IP)
 		}
-		getNicIP = func(nic *v1alpha1.NetworkInterface) net.IP {
+	}
+
+	for _, ipFamily := range ipFamilies {
+		sel := r.natGatewayNetworkInterfaceSelector(natGateway, networkNATGateways, ipFamily)
+		reservations := newNATReservations(natGateway, ipFamily, mgr)
+		requests, full, ipFamilyRequeueAfter, err := r.manageIPFamilyNATTable(ctx, natGateway, ipFamily, nicList.Items, sel, nicPorts, mgr, reservations, drain, existingAllocsByIPFamily[ipFamily], ipToAllocation)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if full {
+			status.fullIPFamilies = append(status.fullIPFamilies, ipFamily)
+		}
+		if ipFamilyRequeueAfter > 0 && (status.requeueAfter == 0 || ipFamilyRequeueAfter < status.requeueAfter) {
+			status.requeueAfter = ipFamilyRequeueAfter
+		}
+
+		ipFamilyReservations, reservationRequeueAfter := reservations.reservations()
+		status.reservations = append(status.reservations, ipFamilyReservations...)
+		if reservationRequeueAfter > 0 && (status.requeueAfter == 0 || reservationRequeueAfter < status.requeueAfter) {
+			status.requeueAfter = reservationRequeueAfter
+		}
+
+		status.ipFamilies = append(status.ipFamilies, v1alpha1.NATGatewayIPFamilyStatus{
+			IPFamily:        ipFamily,
+			UsedNATIPs:      mgr.Used(ipFamily),
+			RequestedNATIPs: requests,
+		})
+	}
+
+	// Release network interface NATs of IP families the NAT gateway does not serve anymore.
+	for i := range nicList.Items {
+		nic := &nicList.Items[i]
+
+		var releaseIPFamilies []corev1.IPFamily
+		for _, nicNAT := range nic.Spec.NATs {
+			if nicNAT.ClaimRef.UID == natGateway.UID && !slices.Contains(ipFamilies, nicNAT.IPFamily) {
+				releaseIPFamilies = append(releaseIPFamilies, nicNAT.IPFamily)
+			}
+		}
+
+		for _, ipFamily := range releaseIPFamilies {
+			if err := apinetclient.ReleaseNetworkInterfaceNAT(ctx, r.Client, nic, ipFamily); client.IgnoreNotFound(err) != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	natTableData := make(map[net.IP][]v1alpha1.NATIPSection, len(ipToAllocation))
+	for ip, sections := range ipToAllocation {
+		natTableData[ip] = sections
+	}
+	for ip, sections := range drain.retained {
+		natTableData[ip] = append(slices.Clone(natTableData[ip]), sections...)
+	}
+	if err := r.applyNATTables(ctx, natGateway, natTables, natTableData); err != nil {
+		errs = append(errs, err)
+	}
+
+	drainingIPStatuses, drainRequeueAfter := drain.statuses(ipToAllocation)
+	status.drainingIPs = drainingIPStatuses
+	if drainRequeueAfter > 0 && (status.requeueAfter == 0 || drainRequeueAfter < status.requeueAfter) {
+		status.requeueAfter = drainRequeueAfter
+	}
+
+	status.ips = r.ipStatuses(natGateway, mgr, ipToAllocation)
+	return status, errors.Join(errs...)
+}
+
+// ipStatuses reports the port range usage of the allocated IPs of the NAT gateway.
+func (r *NATGatewayReconciler) ipStatuses(
+	natGateway *v1alpha1.NATGateway,
+	mgr *natgateway.AllocationManager,
+	ipToAllocation map[net.IP][]v1alpha1.NATIPSection,
+) []v1alpha1.NATGatewayIPStatus {
+	var (
+		totalSlots = natgateway.SlotsPerIP(natGateway.Spec.PortsPerNetworkInterface)
+		statuses   []v1alpha1.NATGatewayIPStatus
+	)
+	for _, ip := range natGateway.Spec.IPs {
+		if !ip.IP.IsValid() {
+			// The IP has not been allocated yet.
+			continue
+		}
+
+		nicUIDs := sets.New[types.UID]()
+		for _, section := range ipToAllocation[ip.IP] {
+			if section.TargetRef != nil {
+				nicUIDs.Insert(section.TargetRef.UID)
+			}
+		}
+
+		statuses = append(statuses, v1alpha1.NATGatewayIPStatus{
+			Name:              ip.Name,
+			IP:                ip.IP,
+			TotalSlots:        totalSlots,
+			UsedSlots:         int32(mgr.UsedByIP(ip.IP)),
+			NetworkInterfaces: int32(nicUIDs.Len()),
+		})
+	}
+	return statuses
+}
+
+// natIPDrain drains the draining IPs of a NAT gateway. Network interfaces migrated off a draining IP
+// keep their sections on it alongside their new ones until the IP drain period elapsed, so that
+// the port ranges are not handed out to other network interfaces while existing flows finish.
+type natIPDrain struct {
+	natGateway *v1alpha1.NATGateway
+	period     time.Duration
+	now        time.Time
+	// retained are the sections of migrated network interfaces kept on the draining IPs.
+	retained map[net.IP][]v1alpha1.NATIPSection
+}
+
+func newNATIPDrain(natGateway *v1alpha1.NATGateway, now time.Time) *natIPDrain {
+	period := defaultNATGatewayIPDrainPeriod
+	if natGateway.Spec.IPDrainPeriod != nil {
+		period = natGateway.Spec.IPDrainPeriod.Duration
+	}
+	return &natIPDrain{
+		natGateway: natGateway,
+		period:     period,
+		now:        now,
+		retained:   make(map[net.IP][]v1alpha1.NATIPSection),
+	}
+}
+
+// remaining returns the remaining drain period of the draining IP with the given name.
+// It reports false if network interfaces have not been migrated off the IP yet.
+func (d *natIPDrain) remaining(name string) (time.Duration, bool) {
+	existing := v1alpha1.GetNATGatewayDrainingIPStatus(d.natGateway, name)
+	if existing == nil || existing.MigratedTime == nil {
+		return 0, false
+	}
+	return d.period - d.now.Sub(existing.MigratedTime.Time), true
+}
+
+// drained reports whether the drain period of the draining IP elapsed.
+func (d *natIPDrain) drained(ip net.IP) bool {
+	for _, natGatewayIP := range d.natGateway.Spec.IPs {
+		if natGatewayIP.Draining && natGatewayIP.IP == ip {
+			remaining, ok := d.remaining(natGatewayIP.Name)
+			return ok && remaining <= 0
+		}
+	}
+	return false
+}
+
+// retain keeps the given allocations of a migrated network interface on draining IPs that have not been drained yet.
+func (d *natIPDrain) retain(mgr *natgateway.AllocationManager, allocs []natIPAllocation) {
+	for _, alloc := range allocs {
+		if !mgr.IsDraining(alloc.ip) || d.drained(alloc.ip) {
+			continue
+		}
+		if mgr.Use(alloc.ip, alloc.Port, alloc.EndPort) {
+			d.retained[alloc.ip] = append(d.retained[alloc.ip], alloc.NATIPSection)
+		}
+	}
+}
+
+// statuses reports the drain state of the draining IPs of the NAT gateway.
+// A draining IP is drained once all network interfaces have been migrated off it for the IP drain period.
+// The returned duration reports when the drain state has to be re-checked.
+func (d *natIPDrain) statuses(
+	ipToAllocation map[net.IP][]v1alpha1.NATIPSection,
+) (statuses []v1alpha1.NATGatewayDrainingIPStatus, requeueAfter time.Duration) {
+	for _, ip := range d.natGateway.Spec.IPs {
+		if !ip.Draining {
+			continue
+		}
+
+		status := v1alpha1.NATGatewayDrainingIPStatus{Name: ip.Name}
+		if len(ipToAllocation[ip.IP]) > 0 {
+			// Network interfaces are still allocated on the IP.
+			statuses = append(statuses, status)
+			continue
+		}
+
+		remaining, ok := d.remaining(ip.Name)
+		if ok {
+			status.MigratedTime = v1alpha1.GetNATGatewayDrainingIPStatus(d.natGateway, ip.Name).MigratedTime
+		} else {
+			status.MigratedTime = &metav1.Time{Time: d.now}
+			remaining = d.period
+		}
+
+		if remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		} else {
+			status.Drained = true
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, requeueAfter
+}
+
+// splitDrainingNATIPAllocations splits the allocations of a network interface into the ones on
+// IPs that are not draining and the ones on draining IPs.
+func splitDrainingNATIPAllocations(
+	mgr *natgateway.AllocationManager,
+	allocs []natIPAllocation,
+) (active, draining []natIPAllocation) {
+	for _, alloc := range allocs {
+		if mgr.IsDraining(alloc.ip) {
+			draining = append(draining, alloc)
+		} else {
+			active = append(active, alloc)
+		}
+	}
+	return active, draining
+}
+
+// manageIPFamilyNATTable claims the network interfaces of the given IP family matching sel and allocates NAT IP sections for them.
+// Allocations are added to ipToAllocation and remembered in reservations. Network interfaces without an allocation are
+// allocated their reserved port range, if any. Requests are reported in port ranges of PortsPerNetworkInterface ports.
+// It reports whether a network interface could not be assigned a port range because no free port range was left.
+// The returned duration reports when additional port blocks have to be re-checked.
+func (r *NATGatewayReconciler) manageIPFamilyNATTable(
+	ctx context.Context,
+	natGateway *v1alpha1.NATGateway,
+	ipFamily corev1.IPFamily,
+	nics []v1alpha1.NetworkInterface,
+	sel func(*v1alpha1.NetworkInterface) bool,
+	nicPorts func(*v1alpha1.NetworkInterface) int32,
+	mgr *natgateway.AllocationManager,
+	reservations *natReservations,
+	drain *natIPDrain,
+	existingAllocByNicID map[types.UID][]natIPAllocation,
+	ipToAllocation map[net.IP][]v1alpha1.NATIPSection,
+) (requests int64, full bool, requeueAfter time.Duration, err error) {
+	var (
+		addAlloc = func(ip net.IP, target v1alpha1.NATIPSection) {
+			ipToAllocation[ip] = append(ipToAllocation[ip], target)
+		}
+		nicIPFamily = v1alpha1.GetNATGatewayNetworkInterfaceIPFamily(natGateway, ipFamily)
+		getNicIP    = func(nic *v1alpha1.NetworkInterface) net.IP {
 			for _, ip := range nic.Spec.IPs {
-				if ip.Family() == natGateway.Spec.IPFamily {
+				if ip.Family() == nicIPFamily {
 					return ip
 				}
 			}
 			return net.IP{}
 		}
+		keepKeys       = sets.New[string]()
+		processMigrate []int
 		processClaimed []int
 		processFree    []int
 		errs           []error
 	)
-	for i, nic := range nicList.Items {
-		claimer := v1alpha1.GetNetworkInterfaceNATClaimer(&nic, natGateway.Spec.IPFamily)
+	for i := range nics {
+		nic := &nics[i]
+		claimer := v1alpha1.GetNetworkInterfaceNATClaimer(nic, ipFamily)
 		if claimer != nil {
 			if claimer.UID != natGateway.UID {
 				// Claimed by someone else, ignore.
 				continue
 			}
 
-			if sel(&nic) {
+			if sel(nic) {
 				// We claim it and match it.
-				requests++
-				existing, ok := existingAllocByNicID[nic.UID]
-				if ok {
-					// Re-use existing allocation.
-					mgr.Use(existing.ip, existing.Port, existing.EndPort)
-					addAlloc(existing.ip, existing.NATIPSection)
+				ports := nicPorts(nic)
+				requests += mgr.SlotsForPorts(ports)
+				allocs, drainingAllocs := splitDrainingNATIPAllocations(mgr, existingAllocByNicID[nic.UID])
+				base, extras, ok := splitNATIPAllocations(mgr, allocs, ports)
+				if !ok {
+					if _, _, ok := splitNATIPAllocations(mgr, drainingAllocs, ports); ok {
+						// The allocation is on a draining IP - migrate it once all other allocations are in use.
+						keepKeys.Insert(natReservationKey(nic))
+						processMigrate = append(processMigrate, i)
+						continue
+					}
+				}
+				if ok && mgr.Use(base.ip, base.Port, base.EndPort) {
+					// Re-use existing allocation and keep the sections it has been migrated off until they are drained.
+					keepKeys.Insert(natReservationKey(nic))
+					addAlloc(base.ip, base.NATIPSection)
+					reservations.record(nic, base.ip, base.Port, base.EndPort)
+					drain.retain(mgr, drainingAllocs)
+
+					sections, nicRequeueAfter := r.manageExtraPortBlocks(natGateway, nic, ipFamily, mgr, base, extras)
+					for _, section := range sections {
+						addAlloc(base.ip, section)
+					}
+					requests += int64(len(sections))
+					if nicRequeueAfter > 0 && (requeueAfter == 0 || nicRequeueAfter < requeueAfter) {
+						requeueAfter = nicRequeueAfter
+					}
 					continue
 				}
 
-				// We claim it and match it, however there's no allocation - process to see if we can allocate it.
+				// We claim it and match it, however there's no allocation of the desired size - process to see if we can allocate it.
 				processClaimed = append(processClaimed, i)
 				continue
 			}
 
 			// We don't match it - release it if possible.
-			if err := apinetclient.ReleaseNetworkInterfaceNAT(ctx, r.Client, &nic, natGateway.Spec.IPFamily); client.IgnoreNotFound(err) != nil {
+			if err := apinetclient.ReleaseNetworkInterfaceNAT(ctx, r.Client, nic, ipFamily); client.IgnoreNotFound(err) != nil {
 				errs = append(errs, err)
 			}
 			continue
@@ -222,106 +941,195 @@ func (r *NATGatewayReconciler) manageNATTable(
 
 		// It's not being claimed at the moment.
 
-		if !sel(&nic) || !nic.DeletionTimestamp.IsZero() {
+		if !sel(nic) || !nic.DeletionTimestamp.IsZero() {
 			// We don't want to claim it - skip it.
 			continue
 		}
 
 		// Mark to be processed.
-		requests++
+		requests += mgr.SlotsForPorts(nicPorts(nic))
 		processFree = append(processFree, i)
 	}
 
-	var full bool
+	// Hold the reserved port ranges of the network interfaces without an allocation before allocating free ones.
+	reservations.hold(keepKeys)
+
+	newSection := func(nic *v1alpha1.NetworkInterface, port, endPort int32) v1alpha1.NATIPSection {
+		return v1alpha1.NATIPSection{
+			IP:      getNicIP(nic),
+			Port:    port,
+			EndPort: endPort,
+			TargetRef: &v1alpha1.NATTableIPTargetRef{
+				UID:     nic.UID,
+				Name:    nic.Name,
+				NodeRef: nic.Spec.NodeRef,
+			},
+		}
+	}
+
+	for _, i := range processMigrate {
+		nic := &nics[i]
+		ports := nicPorts(nic)
+		_, drainingAllocs := splitDrainingNATIPAllocations(mgr, existingAllocByNicID[nic.UID])
+
+		if ip, port, endPort, ok := mgr.UseNextFree(ipFamily, ports); ok {
+			// Migrate the network interface off the draining IP, keeping its old sections until they are drained.
+			addAlloc(ip, newSection(nic, port, endPort))
+			reservations.record(nic, ip, port, endPort)
+			drain.retain(mgr, drainingAllocs)
+			continue
+		}
+
+		full = true
+		base, _, _ := splitNATIPAllocations(mgr, drainingAllocs, ports)
+		if mgr.Use(base.ip, base.Port, base.EndPort) {
+			// No free port range on any other IP - keep the allocation on the draining IP for now.
+			addAlloc(base.ip, base.NATIPSection)
+			reservations.record(nic, base.ip, base.Port, base.EndPort)
+			continue
+		}
+
+		processClaimed = append(processClaimed, i)
+	}
+
 	for _, i := range processClaimed {
-		nic := nicList.Items[i]
-
-		if !full {
-			ip, port, endPort, ok := mgr.UseNextFree()
-			if ok {
-				// Already claimed - just add the allocation and proceed.
-				addAlloc(ip, v1alpha1.NATIPSection{
-					IP:      getNicIP(&nic),
-					Port:    port,
-					EndPort: endPort,
-					TargetRef: &v1alpha1.NATTableIPTargetRef{
-						UID:     nic.UID,
-						Name:    nic.Name,
-						NodeRef: nic.Spec.NodeRef,
-					},
-				})
-				continue
-			}
+		nic := &nics[i]
+		ports := nicPorts(nic)
 
-			// Mark as full
-			full = true
+		ip, port, endPort, ok := reservations.take(nic, ports)
+		if !ok {
+			ip, port, endPort, ok = mgr.UseNextFree(ipFamily, ports)
+		}
+		if ok {
+			// Already claimed - just add the allocation and proceed.
+			addAlloc(ip, newSection(nic, port, endPort))
+			reservations.record(nic, ip, port, endPort)
+			_, drainingAllocs := splitDrainingNATIPAllocations(mgr, existingAllocByNicID[nic.UID])
+			drain.retain(mgr, drainingAllocs)
+			continue
 		}
 
-		if err := apinetclient.ReleaseNetworkInterfaceNAT(ctx, r.Client, &nic, natGateway.Spec.IPFamily); client.IgnoreNotFound(err) != nil {
+		// No free port range of the desired size - release the network interface.
+		full = true
+		if err := apinetclient.ReleaseNetworkInterfaceNAT(ctx, r.Client, nic, ipFamily); client.IgnoreNotFound(err) != nil {
 			errs = append(errs, err)
 			continue
 		}
 	}
 
-	if !full {
-		// Initialize IP and ports here to re-use in case we cannot claim a network interface.
-		var (
-			ip                net.IP
-			port, endPort     int32
-			shouldUseNextFree = true
-			claimRef          = v1alpha1.NetworkInterfaceNATClaimRef{
-				Name: natGateway.Name,
-				UID:  natGateway.UID,
-			}
-		)
-		for _, i := range processFree {
-			nic := nicList.Items[i]
+	claimRef := v1alpha1.NetworkInterfaceNATClaimRef{
+		Name: natGateway.Name,
+		UID:  natGateway.UID,
+	}
+	for _, i := range processFree {
+		nic := &nics[i]
+		ports := nicPorts(nic)
 
-			if shouldUseNextFree {
-				var ok bool
-				ip, port, endPort, ok = mgr.UseNextFree()
-				if !ok {
-					break
-				}
+		ip, port, endPort, reserved := reservations.take(nic, ports)
+		ok := reserved
+		if !ok {
+			ip, port, endPort, ok = mgr.UseNextFree(ipFamily, ports)
+		}
+		if !ok {
+			// No free port range of the desired size - don't claim the network interface.
+			full = true
+			continue
+		}
 
-				shouldUseNextFree = false
+		if err := apinetclient.ClaimNetworkInterfaceNAT(ctx, r.Client, nic, ipFamily, claimRef); err != nil {
+			if !reserved {
+				// Release the port range to re-use it for the next network interface.
+				mgr.Release(ip, port, endPort)
 			}
-
-			if err := apinetclient.ClaimNetworkInterfaceNAT(ctx, r.Client, &nic, natGateway.Spec.IPFamily, claimRef); err != nil {
-				if !apierrors.IsNotFound(err) {
-					// We only care about non-not-found errors - if it doesn't exist, simply don't allocate.
-					errs = append(errs, err)
-				}
-				continue
+			if !apierrors.IsNotFound(err) {
+				// We only care about non-not-found errors - if it doesn't exist, simply don't allocate.
+				errs = append(errs, err)
 			}
+			continue
+		}
+
+		addAlloc(ip, newSection(nic, port, endPort))
+		reservations.record(nic, ip, port, endPort)
+	}
+
+	return requests, full, requeueAfter, errors.Join(errs...)
+}
+
+// getNodePartition returns the partition of the node with the given name, if any.
+func (r *NATGatewayReconciler) getNodePartition(ctx context.Context, nodeName string) (string, error) {
+	if nodeName == "" {
+		return "", nil
+	}
 
-			addAlloc(ip, v1alpha1.NATIPSection{
-				IP:      getNicIP(&nic),
-				Port:    port,
-				EndPort: endPort,
-				TargetRef: &v1alpha1.NATTableIPTargetRef{
-					UID:     nic.UID,
-					Name:    nic.Name,
-					NodeRef: nic.Spec.NodeRef,
-				},
-			})
-			shouldUseNextFree = true // set shouldUseNextFree to true in order to issue using next IP again.
+	node := &v1alpha1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("error getting node %s: %w", nodeName, err)
 		}
+		return "", nil
 	}
+	return node.Labels[v1alpha1.TopologyPartitionLabel], nil
+}
 
-	if err := r.applyNATTable(ctx, natGateway, ipToAllocation); err != nil {
-		errs = append(errs, err)
+// applyNATTables applies a NAT table slice per node the sections of the NAT gateway target
+// and deletes all NAT table slices not targeted anymore.
+func (r *NATGatewayReconciler) applyNATTables(
+	ctx context.Context,
+	natGateway *v1alpha1.NATGateway,
+	natTables []v1alpha1.NATTable,
+	natTableData map[net.IP][]v1alpha1.NATIPSection,
+) error {
+	natTableDataByNodeName := make(map[string]map[net.IP][]v1alpha1.NATIPSection)
+	for ip, allocs := range natTableData {
+		for _, alloc := range allocs {
+			var nodeName string
+			if alloc.TargetRef != nil {
+				nodeName = alloc.TargetRef.NodeRef.Name
+			}
+			natTableDataByNodeName[nodeName] = maps.Append(natTableDataByNodeName[nodeName], ip, append(natTableDataByNodeName[nodeName][ip], alloc))
+		}
+	}
+
+	names := sets.New[string]()
+	for nodeName, nodeNATTableData := range natTableDataByNodeName {
+		name := natTableName(natGateway.Name, nodeName)
+		if err := r.applyNATTable(ctx, natGateway, name, nodeName, nodeNATTableData); err != nil {
+			return err
+		}
+		names.Insert(name)
 	}
 
-	return mgr.Used(), requests, errors.Join(errs...)
+	for _, natTable := range natTables {
+		if names.Has(natTable.Name) {
+			continue
+		}
+
+		if err := r.Delete(ctx, &natTable); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting NAT table %s: %w", natTable.Name, err)
+		}
+	}
+	return nil
 }
 
 func (r *NATGatewayReconciler) applyNATTable(
 	ctx context.Context,
 	natGateway *v1alpha1.NATGateway,
-	natTableData map[net.IP]map[types.UID]v1alpha1.NATIPSection,
+	name string,
+	nodeName string,
+	natTableData map[net.IP][]v1alpha1.NATIPSection,
 ) error {
-	natTableApplyConfig := corev1alpha1apply.NATTable(natGateway.Name, natGateway.Namespace).
+	partition, err := r.getNodePartition(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	natTableLabels := map[string]string{v1alpha1.NATGatewayNameLabel: natGateway.Name}
+	if partition != "" {
+		natTableLabels[v1alpha1.TopologyPartitionLabel] = partition
+	}
+
+	natTableApplyConfig := corev1alpha1apply.NATTable(name, natGateway.Namespace).
+		WithLabels(natTableLabels).
 		WithOwnerReferences(v1.OwnerReference().
 			WithAPIVersion(v1alpha1.SchemeGroupVersion.String()).
 			WithKind("NATGateway").
@@ -385,7 +1193,7 @@ func (r *NATGatewayReconciler) applyNATTable(
 	}
 
 	if err := r.Apply(ctx, natTableApplyConfig, fieldOwner, client.ForceOwnership); err != nil {
-		return fmt.Errorf("error applying NAT table: %w", err)
+		return fmt.Errorf("error applying NAT table %s: %w", name, err)
 	}
 	return nil
 }
@@ -395,26 +1203,34 @@ func (r *NATGatewayReconciler) reconcile(ctx context.Context, log logr.Logger, n
 
 	ips := v1alpha1.GetNATGatewayIPs(natGateway)
 
-	existingAllocs, err := r.getExistingAllocations(ctx, natGateway, ips)
+	natTables, err := r.listNATTables(ctx, natGateway)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error getting existing allocations: %w", err)
 	}
+	existingAllocs := r.getExistingAllocations(natGateway, ips, natTables)
 
 	log.V(1).Info("Managing NAT Table")
-	used, requests, err := r.manageNATTable(ctx, natGateway, ips, existingAllocs)
+	natTableStatus, err := r.manageNATTable(ctx, natGateway, ips, natTables, existingAllocs)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error managing NAT IPs: %w", err)
 	}
 
-	if used != natGateway.Status.UsedNATIPs || requests != natGateway.Status.RequestedNATIPs {
-		log.V(1).Info("Updating NAT Gateway status used NAT IPs", "Used", used, "Requests", requests)
-		if err := r.updateNATGatewayUsedRequests(ctx, natGateway, used, requests); err != nil {
-			return ctrl.Result{}, fmt.Errorf("error updating NAT gateway used / requested NAT IPs: %w", err)
+	status := natGateway.Status.DeepCopy()
+	status.UsedNATIPs, status.RequestedNATIPs = sumNATGatewayIPFamilyStatuses(natTableStatus.ipFamilies)
+	status.IPFamilies = natTableStatus.ipFamilies
+	status.DrainingIPs = natTableStatus.drainingIPs
+	status.IPs = natTableStatus.ips
+	status.Reservations = natTableStatus.reservations
+	meta.SetStatusCondition(&status.Conditions, natGatewayFullCondition(natGateway, natTableStatus.fullIPFamilies))
+	if !equality.Semantic.DeepEqual(status, &natGateway.Status) {
+		log.V(1).Info("Updating NAT Gateway status", "Used", status.UsedNATIPs, "Requests", status.RequestedNATIPs)
+		if err := r.updateNATGatewayStatus(ctx, natGateway, *status); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error updating NAT gateway status: %w", err)
 		}
 	}
 
 	log.V(1).Info("Reconciled")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: natTableStatus.requeueAfter}, nil
 }
 
 func (r *NATGatewayReconciler) enqueueByNetworkInterfaceNAT() handler.EventHandler {
@@ -437,7 +1253,12 @@ func (r *NATGatewayReconciler) enqueueByNATGatewayNetworkInterfaceSelection() ha
 		nic := obj.(*v1alpha1.NetworkInterface)
 		log := ctrl.LoggerFrom(ctx)
 
-		freeNicNATIPFamilies := utilslices.ToSetFunc(nic.Spec.IPs, net.IP.Family)
+		nicIPFamilies := utilslices.ToSetFunc(nic.Spec.IPs, net.IP.Family)
+		freeNicNATIPFamilies := nicIPFamilies.Clone()
+		if nicIPFamilies.Has(corev1.IPv6Protocol) && !nicIPFamilies.Has(corev1.IPv4Protocol) {
+			// IPv6-only network interfaces may be NATed by NAT64 NAT gateways.
+			freeNicNATIPFamilies.Insert(corev1.IPv4Protocol)
+		}
 		for _, publicIP := range nic.Spec.PublicIPs {
 			freeNicNATIPFamilies.Delete(publicIP.IPFamily)
 			if freeNicNATIPFamilies.Len() == 0 {
@@ -465,18 +1286,79 @@ func (r *NATGatewayReconciler) enqueueByNATGatewayNetworkInterfaceSelection() ha
 				continue
 			}
 
-			if freeNicNATIPFamilies.Has(natGateway.Spec.IPFamily) {
-				reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&natGateway)})
+			for _, ipFamily := range v1alpha1.GetNATGatewayIPFamilies(&natGateway) {
+				if freeNicNATIPFamilies.Has(ipFamily) && nicIPFamilies.Has(v1alpha1.GetNATGatewayNetworkInterfaceIPFamily(&natGateway, ipFamily)) {
+					reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&natGateway)})
+					break
+				}
 			}
 		}
 		return reqs
 	})
 }
 
+// enqueueByNetworkNATGateway enqueues all other NAT gateways of the network of a NAT gateway,
+// as a change of its selector or priority may change the network interfaces they NAT.
+func (r *NATGatewayReconciler) enqueueByNetworkNATGateway() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		natGateway := obj.(*v1alpha1.NATGateway)
+		log := ctrl.LoggerFrom(ctx)
+
+		natGatewayList := &v1alpha1.NATGatewayList{}
+		if err := r.List(ctx, natGatewayList,
+			client.InNamespace(natGateway.Namespace),
+		); err != nil {
+			log.Error(err, "Error listing NAT gateways")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, other := range natGatewayList.Items {
+			if other.UID == natGateway.UID || other.Spec.NetworkRef.Name != natGateway.Spec.NetworkRef.Name {
+				continue
+			}
+
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&other)})
+		}
+		return reqs
+	})
+}
+
+func (r *NATGatewayReconciler) enqueueByNode() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		node := obj.(*v1alpha1.Node)
+		log := ctrl.LoggerFrom(ctx)
+
+		nicList := &v1alpha1.NetworkInterfaceList{}
+		if err := r.List(ctx, nicList,
+			client.MatchingFields{apinetclient.NetworkInterfaceSpecNodeRefNameField: node.Name},
+		); err != nil {
+			log.Error(err, "Error listing network interfaces")
+			return nil
+		}
+
+		reqs := sets.New[ctrl.Request]()
+		for _, nic := range nicList.Items {
+			for _, nicNAT := range nic.Spec.NATs {
+				reqs.Insert(ctrl.Request{NamespacedName: client.ObjectKey{
+					Namespace: nic.Namespace,
+					Name:      nicNAT.ClaimRef.Name,
+				}})
+			}
+		}
+		return reqs.UnsortedList()
+	})
+}
+
 func (r *NATGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.NATGateway{}).
 		Owns(&v1alpha1.NATTable{}).
+		Watches(
+			&v1alpha1.NATGateway{},
+			r.enqueueByNetworkNATGateway(),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Watches(
 			&v1alpha1.NetworkInterface{},
 			r.enqueueByNetworkInterfaceNAT(),
@@ -485,5 +1367,9 @@ func (r *NATGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&v1alpha1.NetworkInterface{},
 			r.enqueueByNATGatewayNetworkInterfaceSelection(),
 		).
+		Watches(
+			&v1alpha1.Node{},
+			r.enqueueByNode(),
+		).
 		Complete(r)
 }
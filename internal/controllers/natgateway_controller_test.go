@@ -4,6 +4,8 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 
@@ -14,6 +16,8 @@ import (
 	. "github.com/ironcore-dev/ironcore/utils/testing"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
 
@@ -21,6 +25,16 @@ var _ = Describe("NATGatewayController", func() {
 	ns := SetupNamespace(&k8sClient)
 	network := SetupNetwork(ns)
 	networkWithoutNAT := SetupNetwork(ns)
+	dualStackNetwork := SetupNetwork(ns)
+	overrideNetwork := SetupNetwork(ns)
+	expansionNetwork := SetupNetwork(ns)
+	drainNetwork := SetupNetwork(ns)
+	fullNetwork := SetupNetwork(ns)
+	shardNetwork := SetupNetwork(ns)
+	selectorNetwork := SetupNetwork(ns)
+	nat64Network := SetupNetwork(ns)
+	stickyNetwork := SetupNetwork(ns)
+	partitionNode := SetupNodeWithLabels(map[string]string{v1alpha1.TopologyPartitionLabel: "my-partition"})
 
 	It("should correctly reconcile the NAT gateway", func(ctx SpecContext) {
 		By("creating a NAT gateway")
@@ -83,7 +97,7 @@ var _ = Describe("NATGatewayController", func() {
 		natTable := &v1alpha1.NATTable{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: ns.Name,
-				Name:      natGateway.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
 			},
 		}
 		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
@@ -104,4 +118,804 @@ var _ = Describe("NATGatewayController", func() {
 			},
 		)))
 	})
+
+	It("should allocate NAT IP sections of every IP family of a dual-stack NAT gateway", func(ctx SpecContext) {
+		By("creating a dual-stack NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				NetworkRef:               corev1.LocalObjectReference{Name: dualStackNetwork.Name},
+				PortsPerNetworkInterface: 64,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-v4", IPFamily: corev1.IPv4Protocol},
+					{Name: "ip-v6", IPFamily: corev1.IPv6Protocol},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+		Expect(natGateway.Spec.IPFamily).To(Equal(corev1.IPv4Protocol))
+		natGatewayIPV4 := natGateway.Spec.IPs[0].IP
+		natGatewayIPV6 := natGateway.Spec.IPs[1].IP
+		Expect(natGatewayIPV4.Family()).To(Equal(corev1.IPv4Protocol))
+		Expect(natGatewayIPV6.Family()).To(Equal(corev1.IPv6Protocol))
+
+		By("creating a dual-stack network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: dualStackNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.3"), net.MustParseIP("fd00::3")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("creating an IPv4-only network interface")
+		nicV4 := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: dualStackNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.4")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nicV4)).To(Succeed())
+
+		By("waiting for the network interfaces to be claimed for their IP families")
+		Eventually(Object(nic)).Should(HaveField("Spec.NATs", ConsistOf(
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: natGateway.Name, UID: natGateway.UID},
+			},
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv6Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: natGateway.Name, UID: natGateway.UID},
+			},
+		)))
+		Eventually(Object(nicV4)).Should(HaveField("Spec.NATs", ConsistOf(
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: natGateway.Name, UID: natGateway.UID},
+			},
+		)))
+
+		By("waiting for the NAT table to contain the sections of both IP families")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
+			},
+		}
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			SatisfyAll(
+				HaveField("IP", natGatewayIPV4),
+				HaveField("Sections", ConsistOf(
+					HaveField("IP", net.MustParseIP("10.0.0.3")),
+					HaveField("IP", net.MustParseIP("10.0.0.4")),
+				)),
+			),
+			v1alpha1.NATIP{
+				IP: natGatewayIPV6,
+				Sections: []v1alpha1.NATIPSection{
+					{
+						IP:      net.MustParseIP("fd00::3"),
+						Port:    1024,
+						EndPort: 1087,
+						TargetRef: &v1alpha1.NATTableIPTargetRef{
+							UID:     nic.UID,
+							Name:    nic.Name,
+							NodeRef: nic.Spec.NodeRef,
+						},
+					},
+				},
+			},
+		)))
+
+		By("waiting for the NAT gateway status to report the requests per IP family")
+		Eventually(Object(natGateway)).Should(SatisfyAll(
+			HaveField("Status.RequestedNATIPs", BeEquivalentTo(3)),
+			HaveField("Status.IPFamilies", ConsistOf(
+				v1alpha1.NATGatewayIPFamilyStatus{IPFamily: corev1.IPv4Protocol, UsedNATIPs: 2, RequestedNATIPs: 2},
+				v1alpha1.NATGatewayIPFamilyStatus{IPFamily: corev1.IPv6Protocol, UsedNATIPs: 1, RequestedNATIPs: 1},
+			)),
+		))
+	})
+
+	It("should allocate the ports of a matching override for a network interface", func(ctx SpecContext) {
+		By("creating a NAT gateway with a ports per network interface override")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: overrideNetwork.Name},
+				PortsPerNetworkInterface: 64,
+				PortsPerNetworkInterfaceOverrides: []v1alpha1.NATGatewayPortsOverride{
+					{
+						NetworkInterfaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"tier": "high"},
+						},
+						PortsPerNetworkInterface: 256,
+					},
+				},
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("creating a network interface matching the override")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+				Labels:       map[string]string{"tier": "high"},
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: overrideNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.5")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("creating a network interface not matching the override")
+		nic2 := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: overrideNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.6")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic2)).To(Succeed())
+
+		sectionOfSize := func(ip string, ports int32) gomegatypes.GomegaMatcher {
+			return SatisfyAll(
+				HaveField("IP", net.MustParseIP(ip)),
+				WithTransform(func(section v1alpha1.NATIPSection) int32 {
+					return section.EndPort + 1 - section.Port
+				}, Equal(ports)),
+			)
+		}
+
+		By("waiting for the NAT table to contain sections of the desired sizes")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
+			},
+		}
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			HaveField("Sections", ConsistOf(
+				sectionOfSize("10.0.0.5", 256),
+				sectionOfSize("10.0.0.6", 64),
+			)),
+		)))
+
+		By("waiting for the NAT gateway status to report the requested port ranges")
+		Eventually(Object(natGateway)).Should(SatisfyAll(
+			HaveField("Status.UsedNATIPs", BeEquivalentTo(5)),
+			HaveField("Status.RequestedNATIPs", BeEquivalentTo(5)),
+		))
+
+		By("removing the override label from the network interface")
+		Eventually(Update(nic, func() {
+			nic.Labels = nil
+		})).Should(Succeed())
+
+		By("waiting for the NAT table section of the network interface to shrink")
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			HaveField("Sections", ConsistOf(
+				sectionOfSize("10.0.0.5", 64),
+				sectionOfSize("10.0.0.6", 64),
+			)),
+		)))
+	})
+
+	It("should grant and reclaim additional port blocks for a network interface under port pressure", func(ctx SpecContext) {
+		By("creating a NAT gateway with port block expansion")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: expansionNetwork.Name},
+				PortsPerNetworkInterface: 64,
+				PortBlockExpansion: &v1alpha1.NATGatewayPortBlockExpansion{
+					MaxExtraPortBlocks: 1,
+					CoolDownPeriod:     &metav1.Duration{Duration: time.Second},
+				},
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("creating a network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: expansionNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.7")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the NAT table to contain a single section")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
+			},
+		}
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			HaveField("Sections", ConsistOf(
+				HaveField("IP", net.MustParseIP("10.0.0.7")),
+			)),
+		)))
+
+		By("reporting NAT port pressure for the network interface")
+		Eventually(UpdateStatus(nic, func() {
+			now := metav1.Now()
+			nic.Status.NATs = []v1alpha1.NetworkInterfaceNATStatus{
+				{IPFamily: corev1.IPv4Protocol, Ports: 64, PortPressure: true, LastTransitionTime: &now},
+			}
+		})).Should(Succeed())
+
+		By("waiting for the NAT table to contain an adjacent additional port block")
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			HaveField("Sections", SatisfyAll(
+				HaveLen(2),
+				WithTransform(func(sections []v1alpha1.NATIPSection) bool {
+					return sections[0].EndPort+1 == sections[1].Port
+				}, BeTrue()),
+			)),
+		)))
+
+		By("reporting no NAT port pressure for the network interface")
+		Eventually(UpdateStatus(nic, func() {
+			now := metav1.Now()
+			nic.Status.NATs = []v1alpha1.NetworkInterfaceNATStatus{
+				{IPFamily: corev1.IPv4Protocol, Ports: 128, LastTransitionTime: &now},
+			}
+		})).Should(Succeed())
+
+		By("waiting for the additional port block to be reclaimed after the cool-down period")
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			HaveField("Sections", ConsistOf(
+				HaveField("IP", net.MustParseIP("10.0.0.7")),
+			)),
+		)))
+	})
+
+	It("should migrate network interfaces off draining IPs", func(ctx SpecContext) {
+		By("creating a NAT gateway with two IPs of a single port range each")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: drainNetwork.Name},
+				PortsPerNetworkInterface: 64512,
+				IPDrainPeriod:            &metav1.Duration{Duration: time.Second},
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+					{Name: "ip-2"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("creating a network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: drainNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.8")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the network interface to be allocated on one of the IPs")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
+			},
+		}
+		Eventually(Object(natTable)).Should(HaveField("IPs", HaveLen(1)))
+		usedIP := natTable.IPs[0].IP
+
+		By("marking the used IP as draining")
+		Eventually(Update(natGateway, func() {
+			for i := range natGateway.Spec.IPs {
+				if natGateway.Spec.IPs[i].IP == usedIP {
+					natGateway.Spec.IPs[i].Draining = true
+				}
+			}
+		})).Should(Succeed())
+
+		By("waiting for the network interface to be migrated to the other IP while keeping its old section")
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			SatisfyAll(
+				HaveField("IP", Not(Equal(usedIP))),
+				HaveField("Sections", ConsistOf(HaveField("IP", net.MustParseIP("10.0.0.8")))),
+			),
+			SatisfyAll(
+				HaveField("IP", usedIP),
+				HaveField("Sections", ConsistOf(HaveField("IP", net.MustParseIP("10.0.0.8")))),
+			),
+		)))
+
+		By("waiting for the draining IP to be reported as drained")
+		Eventually(Object(natGateway)).Should(HaveField("Status.DrainingIPs", ConsistOf(
+			SatisfyAll(
+				HaveField("MigratedTime", Not(BeNil())),
+				HaveField("Drained", BeTrue()),
+			),
+		)))
+
+		By("asserting the old section has been removed")
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			HaveField("IP", Not(Equal(usedIP))),
+		)))
+	})
+
+	It("should report the IP utilization and whether the NAT gateway is full", func(ctx SpecContext) {
+		By("creating a NAT gateway with a single port range")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: fullNetwork.Name},
+				PortsPerNetworkInterface: 64512,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+		natGatewayIP := natGateway.Spec.IPs[0].IP
+
+		By("creating a network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: fullNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the NAT gateway to report the IP utilization")
+		Eventually(Object(natGateway)).Should(SatisfyAll(
+			HaveField("Status.IPs", ConsistOf(v1alpha1.NATGatewayIPStatus{
+				Name:              "ip-1",
+				IP:                natGatewayIP,
+				TotalSlots:        1,
+				UsedSlots:         1,
+				NetworkInterfaces: 1,
+			})),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", v1alpha1.NATGatewayFull),
+				HaveField("Status", metav1.ConditionFalse),
+			))),
+		))
+
+		By("creating another network interface")
+		nic2 := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: fullNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.2")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic2)).To(Succeed())
+
+		By("waiting for the NAT gateway to report being full")
+		Eventually(Object(natGateway)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", v1alpha1.NATGatewayFull),
+			HaveField("Status", metav1.ConditionTrue),
+			HaveField("Reason", "NoFreePortRange"),
+		))))
+	})
+
+	It("should split the NAT table into a slice per node", func(ctx SpecContext) {
+		By("creating a NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: shardNetwork.Name},
+				PortsPerNetworkInterface: 64,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+		natGatewayIP := natGateway.Spec.IPs[0].IP
+
+		By("creating a network interface on a node without partition")
+		nic1 := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: shardNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic1)).To(Succeed())
+
+		By("creating a network interface on a node of a partition")
+		nic2 := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: partitionNode.Name},
+				NetworkRef: corev1.LocalObjectReference{Name: shardNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.2")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic2)).To(Succeed())
+
+		By("waiting for the NAT table slices to be applied")
+		natTableList := &v1alpha1.NATTableList{}
+		Eventually(ObjectList(natTableList,
+			client.InNamespace(ns.Name),
+			client.MatchingLabels{v1alpha1.NATGatewayNameLabel: natGateway.Name},
+		)).Should(HaveField("Items", ConsistOf(
+			SatisfyAll(
+				HaveField("Name", natTableName(natGateway.Name, "my-node")),
+				HaveField("Labels", Not(HaveKey(v1alpha1.TopologyPartitionLabel))),
+				HaveField("IPs", ConsistOf(SatisfyAll(
+					HaveField("IP", natGatewayIP),
+					HaveField("Sections", ConsistOf(HaveField("TargetRef.UID", nic1.UID))),
+				))),
+			),
+			SatisfyAll(
+				HaveField("Name", natTableName(natGateway.Name, partitionNode.Name)),
+				HaveField("Labels", HaveKeyWithValue(v1alpha1.TopologyPartitionLabel, "my-partition")),
+				HaveField("IPs", ConsistOf(SatisfyAll(
+					HaveField("IP", natGatewayIP),
+					HaveField("Sections", ConsistOf(HaveField("TargetRef.UID", nic2.UID))),
+				))),
+			),
+		)))
+
+		By("deleting the network interface on the node of the partition")
+		Expect(k8sClient.Delete(ctx, nic2)).To(Succeed())
+
+		By("waiting for the NAT table slice of the node to be deleted")
+		Eventually(ObjectList(natTableList,
+			client.InNamespace(ns.Name),
+			client.MatchingLabels{v1alpha1.NATGatewayNameLabel: natGateway.Name},
+		)).Should(HaveField("Items", ConsistOf(
+			HaveField("Name", natTableName(natGateway.Name, "my-node")),
+		)))
+	})
+
+	It("should assign network interfaces to the selecting NAT gateway with the highest priority", func(ctx SpecContext) {
+		By("creating a NAT gateway for all network interfaces")
+		defaultNATGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: selectorNetwork.Name},
+				PortsPerNetworkInterface: 64,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, defaultNATGateway)).To(Succeed())
+
+		By("creating a NAT gateway with a higher priority for partner network interfaces")
+		partnerNATGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				NetworkRef: corev1.LocalObjectReference{Name: selectorNetwork.Name},
+				NetworkInterfaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "partner"},
+				},
+				Priority:                 10,
+				PortsPerNetworkInterface: 64,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, partnerNATGateway)).To(Succeed())
+
+		By("creating a partner network interface")
+		partnerNic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+				Labels:       map[string]string{"app": "partner"},
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: selectorNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, partnerNic)).To(Succeed())
+
+		By("creating another network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: selectorNetwork.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.2")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the network interfaces to be claimed by their NAT gateways")
+		Eventually(Object(partnerNic)).Should(HaveField("Spec.NATs", ConsistOf(
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: partnerNATGateway.Name, UID: partnerNATGateway.UID},
+			},
+		)))
+		Eventually(Object(nic)).Should(HaveField("Spec.NATs", ConsistOf(
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: defaultNATGateway.Name, UID: defaultNATGateway.UID},
+			},
+		)))
+
+		By("raising the priority of the NAT gateway for all network interfaces")
+		Eventually(Update(defaultNATGateway, func() {
+			defaultNATGateway.Spec.Priority = 20
+		})).Should(Succeed())
+
+		By("waiting for the partner network interface to be claimed by the NAT gateway for all network interfaces")
+		Eventually(Object(partnerNic)).Should(HaveField("Spec.NATs", ConsistOf(
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: defaultNATGateway.Name, UID: defaultNATGateway.UID},
+			},
+		)))
+	})
+
+	It("should NAT IPv6-only network interfaces using the IPv4 IPs of a NAT64 NAT gateway", func(ctx SpecContext) {
+		By("creating a NAT64 NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: nat64Network.Name},
+				PortsPerNetworkInterface: 64,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+				NAT64: &v1alpha1.NATGatewayNAT64{},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+		Expect(natGateway.Spec.NAT64.Prefix).To(Equal(net.MustParseIPPrefix(v1alpha1.NATGatewayNAT64WellKnownPrefix)))
+		natGatewayIP := natGateway.Spec.IPs[0].IP
+		Expect(natGatewayIP.Family()).To(Equal(corev1.IPv4Protocol))
+
+		By("creating an IPv6-only network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: nat64Network.Name},
+				IPs:        []net.IP{net.MustParseIP("fd00::1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("creating an IPv4 network interface")
+		nicV4 := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: nat64Network.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nicV4)).To(Succeed())
+
+		By("waiting for the IPv6-only network interface to be claimed for IPv4")
+		Eventually(Object(nic)).Should(HaveField("Spec.NATs", ConsistOf(
+			v1alpha1.NetworkInterfaceNAT{
+				IPFamily: corev1.IPv4Protocol,
+				ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{Name: natGateway.Name, UID: natGateway.UID},
+			},
+		)))
+
+		By("waiting for the NAT table to translate the IPv6 IP of the network interface")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
+			},
+		}
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(
+			v1alpha1.NATIP{
+				IP: natGatewayIP,
+				Sections: []v1alpha1.NATIPSection{
+					{
+						IP:      net.MustParseIP("fd00::1"),
+						Port:    1024,
+						EndPort: 1087,
+						TargetRef: &v1alpha1.NATTableIPTargetRef{
+							UID:     nic.UID,
+							Name:    nic.Name,
+							NodeRef: nic.Spec.NodeRef,
+						},
+					},
+				},
+			},
+		)))
+
+		By("asserting the IPv4 network interface is not claimed")
+		Consistently(Object(nicV4)).Should(HaveField("Spec.NATs", BeEmpty()))
+	})
+
+	It("should restore the port range of a recreated network interface with a reservation key", func(ctx SpecContext) {
+		By("creating a NAT gateway with sticky reservations")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: stickyNetwork.Name},
+				PortsPerNetworkInterface: 64,
+				IPs: []v1alpha1.NATGatewayIP{
+					{Name: "ip-1"},
+				},
+				StickyReservations: &v1alpha1.NATGatewayStickyReservations{},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+		natGatewayIP := natGateway.Spec.IPs[0].IP
+
+		newNic := func(ip string, labels map[string]string) *v1alpha1.NetworkInterface {
+			return &v1alpha1.NetworkInterface{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "nic-",
+					Labels:       labels,
+				},
+				Spec: v1alpha1.NetworkInterfaceSpec{
+					NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+					NetworkRef: corev1.LocalObjectReference{Name: stickyNetwork.Name},
+					IPs:        []net.IP{net.MustParseIP(ip)},
+				},
+			}
+		}
+
+		By("creating a network interface with a reservation key")
+		nic := newNic("10.0.0.1", map[string]string{v1alpha1.NATReservationKeyLabel: "my-key"})
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the NAT gateway to remember the port range of the network interface")
+		Eventually(Object(natGateway)).Should(HaveField("Status.Reservations", ConsistOf(
+			v1alpha1.NATGatewayReservation{Key: "my-key", IP: natGatewayIP, Port: 1024, EndPort: 1087},
+		)))
+
+		By("deleting the network interface")
+		Expect(k8sClient.Delete(ctx, nic)).To(Succeed())
+
+		By("waiting for the reservation to be released")
+		Eventually(Object(natGateway)).Should(HaveField("Status.Reservations", ConsistOf(SatisfyAll(
+			HaveField("Key", "my-key"),
+			HaveField("Port", BeEquivalentTo(1024)),
+			HaveField("ReleasedTime", Not(BeNil())),
+		))))
+
+		By("creating another network interface")
+		otherNic := newNic("10.0.0.2", nil)
+		Expect(k8sClient.Create(ctx, otherNic)).To(Succeed())
+
+		By("recreating the network interface with the reservation key")
+		recreatedNic := newNic("10.0.0.1", map[string]string{v1alpha1.NATReservationKeyLabel: "my-key"})
+		Expect(k8sClient.Create(ctx, recreatedNic)).To(Succeed())
+
+		By("waiting for the recreated network interface to be allocated its reserved port range")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natTableName(natGateway.Name, "my-node"),
+			},
+		}
+		Eventually(Object(natTable)).Should(HaveField("IPs", ConsistOf(SatisfyAll(
+			HaveField("IP", natGatewayIP),
+			HaveField("Sections", ConsistOf(
+				SatisfyAll(
+					HaveField("TargetRef.UID", recreatedNic.UID),
+					HaveField("Port", BeEquivalentTo(1024)),
+					HaveField("EndPort", BeEquivalentTo(1087)),
+				),
+				SatisfyAll(
+					HaveField("TargetRef.UID", otherNic.UID),
+					HaveField("Port", BeEquivalentTo(1088)),
+					HaveField("EndPort", BeEquivalentTo(1151)),
+				),
+			)),
+		))))
+
+		By("waiting for the reservations to be in use")
+		Eventually(Object(natGateway)).Should(HaveField("Status.Reservations", ConsistOf(
+			v1alpha1.NATGatewayReservation{Key: "my-key", IP: natGatewayIP, Port: 1024, EndPort: 1087},
+			v1alpha1.NATGatewayReservation{Key: string(otherNic.UID), IP: natGatewayIP, Port: 1088, EndPort: 1151},
+		)))
+	})
 })
@@ -6,12 +6,18 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/internal/natgateway"
+	"github.com/ironcore-dev/ironcore/utils/generic"
 	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -21,6 +27,8 @@ import (
 
 const (
 	noOfNATIPGenerateNameChars = 10
+
+	defaultNATGatewayAutoscalerTargetUtilizationPercentage = 100
 )
 
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgatewayautoscalers,verbs=get;list;watch
@@ -31,6 +39,32 @@ type NATGatewayAutoscalerReconciler struct {
 	client.Client
 }
 
+// natGatewayAutoscalerScaleResult is the outcome of a single scaling step of a NAT gateway autoscaler.
+type natGatewayAutoscalerScaleResult struct {
+	currentIPs int32
+	desiredIPs int32
+	scaled     bool
+	ipFamilies []v1alpha1.NATGatewayAutoscalerIPFamilyStatus
+
+	limitedReason  string
+	limitedMessage string
+
+	requeueAfter time.Duration
+}
+
+func (res *natGatewayAutoscalerScaleResult) limit(reason, message string) {
+	if res.limitedReason == "" {
+		res.limitedReason = reason
+		res.limitedMessage = message
+	}
+}
+
+func (res *natGatewayAutoscalerScaleResult) requeue(after time.Duration) {
+	if after > 0 && (res.requeueAfter == 0 || after < res.requeueAfter) {
+		res.requeueAfter = after
+	}
+}
+
 func (r *NATGatewayAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	natGatewayAutoscaler := &v1alpha1.NATGatewayAutoscaler{}
@@ -62,11 +96,15 @@ func (r *NATGatewayAutoscalerReconciler) reconcile(ctx context.Context, log logr
 			return ctrl.Result{}, fmt.Errorf("error getting NAT gateway %s: %w", natGatewayKey.Name, err)
 		}
 		log.V(1).Info("Scale target not found")
+		if err := r.updateStatus(ctx, natGatewayAutoscaler, nil); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
+		}
 		return ctrl.Result{}, nil
 	}
 
 	log.V(1).Info("Managing public IPs")
-	if err := r.manageNATGatewayIPs(ctx, natGatewayAutoscaler, natGateway); err != nil {
+	res, err := r.manageNATGatewayIPs(ctx, natGatewayAutoscaler, natGateway)
+	if err != nil {
 		if !apierrors.IsConflict(err) {
 			return ctrl.Result{}, fmt.Errorf("error managing public IPs: %w", err)
 		}
@@ -75,44 +113,189 @@ func (r *NATGatewayAutoscalerReconciler) reconcile(ctx context.Context, log logr
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	log.V(1).Info("Updating status")
+	if err := r.updateStatus(ctx, natGatewayAutoscaler, res); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
+	}
+
 	log.V(1).Info("Reconciled")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: res.requeueAfter}, nil
+}
+
+func (r *NATGatewayAutoscalerReconciler) updateStatus(
+	ctx context.Context,
+	natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler,
+	res *natGatewayAutoscalerScaleResult,
+) error {
+	base := natGatewayAutoscaler.DeepCopy()
+	status := &natGatewayAutoscaler.Status
+	generation := natGatewayAutoscaler.Generation
+
+	if res == nil {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               v1alpha1.NATGatewayAutoscalerScalingActive,
+			Status:             metav1.ConditionFalse,
+			Reason:             "NATGatewayNotFound",
+			Message:            "the targeted NAT gateway does not exist",
+			ObservedGeneration: generation,
+		})
+	} else {
+		status.CurrentIPs = res.currentIPs
+		status.DesiredIPs = res.desiredIPs
+		status.IPFamilies = res.ipFamilies
+		if res.scaled {
+			now := metav1.Now()
+			status.LastScaleTime = &now
+		}
+
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               v1alpha1.NATGatewayAutoscalerScalingActive,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ValidNATGateway",
+			Message:            "the autoscaler is able to scale the NAT gateway",
+			ObservedGeneration: generation,
+		})
+
+		limited := metav1.Condition{
+			Type:               v1alpha1.NATGatewayAutoscalerScalingLimited,
+			Status:             metav1.ConditionFalse,
+			Reason:             "DesiredWithinRange",
+			Message:            "the desired number of IPs is within the acceptable range",
+			ObservedGeneration: generation,
+		}
+		if res.limitedReason != "" {
+			limited.Status = metav1.ConditionTrue
+			limited.Reason = res.limitedReason
+			limited.Message = res.limitedMessage
+		}
+		meta.SetStatusCondition(&status.Conditions, limited)
+	}
+
+	if equality.Semantic.DeepEqual(base.Status, natGatewayAutoscaler.Status) {
+		return nil
+	}
+	return r.Status().Patch(ctx, natGatewayAutoscaler, client.MergeFrom(base))
 }
 
-func (r *NATGatewayAutoscalerReconciler) generateNewNATGatewayIPs(natGateway *v1alpha1.NATGateway, ct int) []v1alpha1.NATGatewayIP {
-	existingNames := utilslices.ToSetFunc(natGateway.Spec.IPs, func(ip v1alpha1.NATGatewayIP) string { return ip.Name })
-	newNATGatewayIPs := sets.New[v1alpha1.NATGatewayIP]()
-	for newNATGatewayIPs.Len() != ct {
+func (r *NATGatewayAutoscalerReconciler) generateNewNATGatewayIPs(existingNames sets.Set[string], ipFamily corev1.IPFamily, ct int) []v1alpha1.NATGatewayIP {
+	newNATGatewayIPs := make([]v1alpha1.NATGatewayIP, 0, ct)
+	for len(newNATGatewayIPs) != ct {
 		name := utilrand.String(noOfNATIPGenerateNameChars)
 		if !existingNames.Has(name) {
-			newNATGatewayIPs.Insert(v1alpha1.NATGatewayIP{Name: name})
+			existingNames.Insert(name)
+			newNATGatewayIPs = append(newNATGatewayIPs, v1alpha1.NATGatewayIP{Name: name, IPFamily: ipFamily})
+		}
+	}
+	return newNATGatewayIPs
+}
+
+// natGatewayRequestedNATIPs returns the number of requested NAT IPs of the given IP family of the NAT gateway.
+func natGatewayRequestedNATIPs(natGateway *v1alpha1.NATGateway, ipFamily corev1.IPFamily) int64 {
+	for _, ipFamilyStatus := range natGateway.Status.IPFamilies {
+		if ipFamilyStatus.IPFamily == ipFamily {
+			return ipFamilyStatus.RequestedNATIPs
+		}
+	}
+	if len(natGateway.Status.IPFamilies) == 0 && ipFamily == natGateway.Spec.IPFamily {
+		// The status has not been reported per IP family yet.
+		return natGateway.Status.RequestedNATIPs
+	}
+	return 0
+}
+
+// natGatewayAutoscalerIPFamilyStatus returns a copy of the status of the given IP family of the autoscaler.
+func natGatewayAutoscalerIPFamilyStatus(natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler, ipFamily corev1.IPFamily) v1alpha1.NATGatewayAutoscalerIPFamilyStatus {
+	for _, ipFamilyStatus := range natGatewayAutoscaler.Status.IPFamilies {
+		if ipFamilyStatus.IPFamily == ipFamily {
+			return *ipFamilyStatus.DeepCopy()
 		}
 	}
-	return newNATGatewayIPs.UnsortedList()
+	ipFamilyStatus := v1alpha1.NATGatewayAutoscalerIPFamilyStatus{IPFamily: ipFamily}
+	if len(natGatewayAutoscaler.Status.IPFamilies) == 0 {
+		// The status has not been reported per IP family yet.
+		ipFamilyStatus.LastScaleTime = natGatewayAutoscaler.Status.LastScaleTime.DeepCopy()
+	}
+	return ipFamilyStatus
 }
 
 func (r *NATGatewayAutoscalerReconciler) manageNATGatewayIPs(
 	ctx context.Context,
 	natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler,
 	natGateway *v1alpha1.NATGateway,
-) error {
-	totalRequests := natGateway.Status.RequestedNATIPs
-	currentNoOfIPs := len(natGateway.Spec.IPs)
-	desiredNoOfIPs := r.determineDesiredNumberOfIPs(natGatewayAutoscaler, natGateway, currentNoOfIPs, int(totalRequests))
-	diff := currentNoOfIPs - desiredNoOfIPs
+) (*natGatewayAutoscalerScaleResult, error) {
+	var (
+		existingNames = utilslices.ToSetFunc(natGateway.Spec.IPs, func(ip v1alpha1.NATGatewayIP) string { return ip.Name })
+		ips           = make([]v1alpha1.NATGatewayIP, 0, len(natGateway.Spec.IPs))
+		modified      bool
+		now           = time.Now()
+		res           = &natGatewayAutoscalerScaleResult{}
+	)
+	for _, ipFamily := range v1alpha1.GetNATGatewayIPFamilies(natGateway) {
+		var ipFamilyIPs, drainingIPs []v1alpha1.NATGatewayIP
+		for _, ip := range natGateway.Spec.IPs {
+			if v1alpha1.GetNATGatewayIPFamily(natGateway, &ip) != ipFamily {
+				continue
+			}
 
-	if diff < 0 {
-		diff *= -1
+			if ip.Draining {
+				if status := v1alpha1.GetNATGatewayDrainingIPStatus(natGateway, ip.Name); status != nil && status.Drained {
+					// The IP has been drained - remove it.
+					modified = true
+					continue
+				}
 
-		ips := append(make([]v1alpha1.NATGatewayIP, 0, len(natGateway.Spec.IPs)+diff), natGateway.Spec.IPs...)
-		ips = append(ips, r.generateNewNATGatewayIPs(natGateway, diff)...)
-		return r.updateNATGatewayIPs(ctx, natGateway, ips)
-	} else if diff > 0 {
-		// Delete IPs from the end since they are the 'newer' ones.
-		ips := natGateway.Spec.IPs[:len(natGateway.Spec.IPs)-diff]
-		return r.updateNATGatewayIPs(ctx, natGateway, ips)
+				drainingIPs = append(drainingIPs, ip)
+				continue
+			}
+			ipFamilyIPs = append(ipFamilyIPs, ip)
+		}
+
+		ipFamilyStatus := natGatewayAutoscalerIPFamilyStatus(natGatewayAutoscaler, ipFamily)
+		totalRequests := natGatewayRequestedNATIPs(natGateway, ipFamily)
+		currentNoOfIPs := len(ipFamilyIPs)
+		desiredNoOfIPs := r.determineDesiredNumberOfIPs(natGatewayAutoscaler, natGateway, int(totalRequests), res)
+		desiredNoOfIPs, stabilizeAfter := r.stabilizeNumberOfIPs(natGatewayAutoscaler, &ipFamilyStatus, now, currentNoOfIPs, desiredNoOfIPs)
+		res.requeue(stabilizeAfter)
+		res.desiredIPs += int32(desiredNoOfIPs)
+
+		noOfIPs := r.limitNumberOfIPs(natGatewayAutoscaler, ipFamilyStatus.LastScaleTime, now, currentNoOfIPs, desiredNoOfIPs, res)
+		res.currentIPs += int32(noOfIPs)
+		if noOfIPs != currentNoOfIPs {
+			ipFamilyStatus.LastScaleTime = &metav1.Time{Time: now}
+		}
+		res.ipFamilies = append(res.ipFamilies, ipFamilyStatus)
+
+		if diff := noOfIPs - currentNoOfIPs; diff > 0 {
+			// Re-use draining IPs before generating new ones.
+			for ; diff > 0 && len(drainingIPs) > 0; diff-- {
+				ip := drainingIPs[len(drainingIPs)-1]
+				drainingIPs = drainingIPs[:len(drainingIPs)-1]
+				ip.Draining = false
+				ipFamilyIPs = append(ipFamilyIPs, ip)
+			}
+			ipFamilyIPs = append(ipFamilyIPs, r.generateNewNATGatewayIPs(existingNames, ipFamily, diff)...)
+			modified = true
+			res.scaled = true
+		} else if diff < 0 {
+			// Drain IPs from the end since they are the 'newer' ones. The NAT gateway migrates
+			// the network interfaces off draining IPs, and they are removed once drained.
+			for i := noOfIPs; i < len(ipFamilyIPs); i++ {
+				ipFamilyIPs[i].Draining = true
+			}
+			modified = true
+			res.scaled = true
+		}
+		ips = append(ips, ipFamilyIPs...)
+		ips = append(ips, drainingIPs...)
+	}
+
+	if !modified && len(ips) == len(natGateway.Spec.IPs) {
+		return res, nil
+	}
+	if err := r.updateNATGatewayIPs(ctx, natGateway, ips); err != nil {
+		return nil, err
 	}
-	return nil
+	return res, nil
 }
 
 func (r *NATGatewayAutoscalerReconciler) updateNATGatewayIPs(
@@ -125,27 +308,124 @@ func (r *NATGatewayAutoscalerReconciler) updateNATGatewayIPs(
 	return r.Patch(ctx, natGateway, client.MergeFromWithOptions(base, &client.MergeFromWithOptimisticLock{}))
 }
 
+// determineDesiredNumberOfIPs returns the number of IPs required to serve the requests at the target
+// utilization with the spare slots kept available, bounded by the minimum / maximum number of IPs.
 func (r *NATGatewayAutoscalerReconciler) determineDesiredNumberOfIPs(
 	natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler,
 	natGateway *v1alpha1.NATGateway,
-	totalNoOfIPs int,
 	totalRequests int,
+	res *natGatewayAutoscalerScaleResult,
 ) int {
+	spec := &natGatewayAutoscaler.Spec
 	slotsPerIP := int64(natgateway.SlotsPerIP(natGateway.Spec.PortsPerNetworkInterface))
-	totalSlots := slotsPerIP * int64(totalNoOfIPs)
-	slotsDiff := totalSlots - int64(totalRequests)
-	ipsDiff := int(slotsDiff / slotsPerIP)
-	desiredNoOfPublicIPs := totalNoOfIPs - ipsDiff
 
-	if minPublicIPs := natGatewayAutoscaler.Spec.MinPublicIPs; minPublicIPs != nil {
-		desiredNoOfPublicIPs = max(int(*minPublicIPs), desiredNoOfPublicIPs)
+	targetUtilizationPercentage := int64(defaultNATGatewayAutoscalerTargetUtilizationPercentage)
+	if spec.TargetUtilizationPercentage != nil {
+		targetUtilizationPercentage = int64(*spec.TargetUtilizationPercentage)
+	}
+
+	requiredSlots := (int64(totalRequests)*100+targetUtilizationPercentage-1)/targetUtilizationPercentage +
+		int64(generic.DerefZero(spec.SpareSlots))
+	desiredNoOfPublicIPs := int((requiredSlots + slotsPerIP - 1) / slotsPerIP)
+
+	if minPublicIPs := spec.MinPublicIPs; minPublicIPs != nil && desiredNoOfPublicIPs < int(*minPublicIPs) {
+		res.limit("TooFewIPs", "the desired number of IPs is less than the minimum number of IPs")
+		desiredNoOfPublicIPs = int(*minPublicIPs)
 	}
-	if maxPublicIPs := natGatewayAutoscaler.Spec.MaxPublicIPs; maxPublicIPs != nil {
-		desiredNoOfPublicIPs = min(int(*maxPublicIPs), desiredNoOfPublicIPs)
+	if maxPublicIPs := spec.MaxPublicIPs; maxPublicIPs != nil && desiredNoOfPublicIPs > int(*maxPublicIPs) {
+		res.limit("TooManyIPs", "the desired number of IPs is more than the maximum number of IPs")
+		desiredNoOfPublicIPs = int(*maxPublicIPs)
 	}
 	return desiredNoOfPublicIPs
 }
 
+// natGatewayAutoscalerScalingRules returns the scale-up or scale-down rules of the autoscaler, if any.
+func natGatewayAutoscalerScalingRules(natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler, scaleUp bool) *v1alpha1.NATGatewayAutoscalerScalingRules {
+	behavior := natGatewayAutoscaler.Spec.Behavior
+	if behavior == nil {
+		return nil
+	}
+	if scaleUp {
+		return behavior.ScaleUp
+	}
+	return behavior.ScaleDown
+}
+
+func natGatewayAutoscalerStabilizationWindow(natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler, scaleUp bool) time.Duration {
+	rules := natGatewayAutoscalerScalingRules(natGatewayAutoscaler, scaleUp)
+	if rules == nil || rules.StabilizationWindow == nil {
+		return 0
+	}
+	return rules.StabilizationWindow.Duration
+}
+
+// stabilizeNumberOfIPs stabilizes the desired number of IPs using the recommendations within the
+// stabilization windows and records the recommendations to keep in the IP family status.
+func (r *NATGatewayAutoscalerReconciler) stabilizeNumberOfIPs(
+	natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler,
+	ipFamilyStatus *v1alpha1.NATGatewayAutoscalerIPFamilyStatus,
+	now time.Time,
+	currentNoOfIPs, desiredNoOfIPs int,
+) (int, time.Duration) {
+	noOfIPs, stabilizeAfter, recommendations := natgateway.StabilizeRecommendation(
+		ipFamilyStatus.Recommendations,
+		now,
+		currentNoOfIPs,
+		desiredNoOfIPs,
+		natGatewayAutoscalerStabilizationWindow(natGatewayAutoscaler, true),
+		natGatewayAutoscalerStabilizationWindow(natGatewayAutoscaler, false),
+	)
+	ipFamilyStatus.Recommendations = recommendations
+	return noOfIPs, stabilizeAfter
+}
+
+// limitNumberOfIPs limits the change from the current to the desired number of IPs of an IP family by
+// the step period since the last scale time of the IP family and the maximum number of IPs per step
+// of the scaling rules.
+func (r *NATGatewayAutoscalerReconciler) limitNumberOfIPs(
+	natGatewayAutoscaler *v1alpha1.NATGatewayAutoscaler,
+	lastScaleTime *metav1.Time,
+	now time.Time,
+	currentNoOfIPs, desiredNoOfIPs int,
+	res *natGatewayAutoscalerScaleResult,
+) int {
+	if desiredNoOfIPs == currentNoOfIPs {
+		return currentNoOfIPs
+	}
+
+	scaleUp := desiredNoOfIPs > currentNoOfIPs
+	rules := natGatewayAutoscalerScalingRules(natGatewayAutoscaler, scaleUp)
+	if rules == nil {
+		return desiredNoOfIPs
+	}
+
+	reason, message := "ScaleDownLimited", "the number of IPs is limited by the scale-down rules"
+	if scaleUp {
+		reason, message = "ScaleUpLimited", "the number of IPs is limited by the scale-up rules"
+	}
+
+	if stepPeriod := rules.StepPeriod; stepPeriod != nil && lastScaleTime != nil {
+		if remaining := lastScaleTime.Add(stepPeriod.Duration).Sub(now); remaining > 0 {
+			res.limit(reason, message)
+			res.requeue(remaining)
+			return currentNoOfIPs
+		}
+	}
+
+	if maxIPsPerStep := rules.MaxIPsPerStep; maxIPsPerStep != nil {
+		step := int(*maxIPsPerStep)
+		switch {
+		case desiredNoOfIPs > currentNoOfIPs+step:
+			res.limit(reason, message)
+			return currentNoOfIPs + step
+		case desiredNoOfIPs < currentNoOfIPs-step:
+			res.limit(reason, message)
+			return currentNoOfIPs - step
+		}
+	}
+	return desiredNoOfIPs
+}
+
 func (r *NATGatewayAutoscalerReconciler) enqueueByNATGateway() handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
 		natGateway := obj.(*v1alpha1.NATGateway)
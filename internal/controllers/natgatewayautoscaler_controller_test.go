@@ -4,6 +4,8 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore/utils/generic"
 	. "github.com/ironcore-dev/ironcore/utils/testing"
@@ -11,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
 
 var _ = Describe("NATGatewayAutoscalerController", func() {
@@ -47,5 +50,143 @@ var _ = Describe("NATGatewayAutoscalerController", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, natGatewayAutoscaler)).To(Succeed())
+
+		By("waiting for the autoscaler to scale to the minimum number of public IPs")
+		Eventually(Object(natGateway)).Should(HaveField("Spec.IPs", HaveLen(1)))
+		Eventually(Object(natGatewayAutoscaler)).Should(SatisfyAll(
+			HaveField("Status.CurrentIPs", BeEquivalentTo(1)),
+			HaveField("Status.DesiredIPs", BeEquivalentTo(1)),
+			HaveField("Status.Conditions", ContainElements(
+				SatisfyAll(
+					HaveField("Type", v1alpha1.NATGatewayAutoscalerScalingActive),
+					HaveField("Status", metav1.ConditionTrue),
+				),
+				SatisfyAll(
+					HaveField("Type", v1alpha1.NATGatewayAutoscalerScalingLimited),
+					HaveField("Status", metav1.ConditionTrue),
+					HaveField("Reason", "TooFewIPs"),
+				),
+			)),
+		))
+	})
+
+	It("should keep spare slots available and limit the IPs added per step", func(ctx SpecContext) {
+		By("creating a NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: network.Name},
+				PortsPerNetworkInterface: 64512,
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("creating a NAT gateway autoscaler with spare slots and scale-up rules")
+		natGatewayAutoscaler := &v1alpha1.NATGatewayAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-as-",
+			},
+			Spec: v1alpha1.NATGatewayAutoscalerSpec{
+				NATGatewayRef: corev1.LocalObjectReference{
+					Name: natGateway.Name,
+				},
+				MaxPublicIPs: generic.Pointer[int32](3),
+				SpareSlots:   generic.Pointer[int32](2),
+				Behavior: &v1alpha1.NATGatewayAutoscalerBehavior{
+					ScaleUp: &v1alpha1.NATGatewayAutoscalerScalingRules{
+						MaxIPsPerStep: generic.Pointer[int32](1),
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGatewayAutoscaler)).To(Succeed())
+
+		By("waiting for the autoscaler to add an IP per spare slot")
+		Eventually(Object(natGateway)).Should(HaveField("Spec.IPs", HaveLen(2)))
+		Eventually(Object(natGatewayAutoscaler)).Should(SatisfyAll(
+			HaveField("Status.CurrentIPs", BeEquivalentTo(2)),
+			HaveField("Status.DesiredIPs", BeEquivalentTo(2)),
+			HaveField("Status.LastScaleTime", Not(BeNil())),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", v1alpha1.NATGatewayAutoscalerScalingLimited),
+				HaveField("Status", metav1.ConditionFalse),
+			))),
+		))
+	})
+
+	It("should limit the step period per IP family and persist the recommendations", func(ctx SpecContext) {
+		By("creating a NAT gateway autoscaler with scale-up and scale-down rules")
+		natGatewayAutoscaler := &v1alpha1.NATGatewayAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-as-",
+			},
+			Spec: v1alpha1.NATGatewayAutoscalerSpec{
+				NATGatewayRef: corev1.LocalObjectReference{
+					Name: "dual-stack-nat-gateway",
+				},
+				SpareSlots: generic.Pointer[int32](1),
+				Behavior: &v1alpha1.NATGatewayAutoscalerBehavior{
+					ScaleUp: &v1alpha1.NATGatewayAutoscalerScalingRules{
+						StepPeriod: &metav1.Duration{Duration: time.Hour},
+					},
+					ScaleDown: &v1alpha1.NATGatewayAutoscalerScalingRules{
+						StabilizationWindow: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGatewayAutoscaler)).To(Succeed())
+
+		By("reporting a recent scale of the IPv4 family")
+		Eventually(UpdateStatus(natGatewayAutoscaler, func() {
+			natGatewayAutoscaler.Status.IPFamilies = []v1alpha1.NATGatewayAutoscalerIPFamilyStatus{
+				{IPFamily: corev1.IPv4Protocol, LastScaleTime: &metav1.Time{Time: time.Now()}},
+			}
+		})).Should(Succeed())
+
+		By("creating the dual-stack NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "dual-stack-nat-gateway",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamilies:               []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				NetworkRef:               corev1.LocalObjectReference{Name: network.Name},
+				PortsPerNetworkInterface: 64512,
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("waiting for the autoscaler to only scale the IPv6 family")
+		Eventually(Object(natGateway)).Should(HaveField("Spec.IPs", ConsistOf(
+			HaveField("IPFamily", corev1.IPv6Protocol),
+		)))
+		Eventually(Object(natGatewayAutoscaler)).Should(SatisfyAll(
+			HaveField("Status.CurrentIPs", BeEquivalentTo(1)),
+			HaveField("Status.DesiredIPs", BeEquivalentTo(2)),
+			HaveField("Status.IPFamilies", ConsistOf(
+				SatisfyAll(
+					HaveField("IPFamily", corev1.IPv4Protocol),
+					HaveField("Recommendations", ConsistOf(HaveField("IPs", BeEquivalentTo(1)))),
+				),
+				SatisfyAll(
+					HaveField("IPFamily", corev1.IPv6Protocol),
+					HaveField("LastScaleTime", Not(BeNil())),
+					HaveField("Recommendations", ConsistOf(HaveField("IPs", BeEquivalentTo(1)))),
+				),
+			)),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", v1alpha1.NATGatewayAutoscalerScalingLimited),
+				HaveField("Status", metav1.ConditionTrue),
+				HaveField("Reason", "ScaleUpLimited"),
+			))),
+		))
 	})
 })
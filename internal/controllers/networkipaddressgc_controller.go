@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/lru"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultNetworkIPAddressGCGracePeriod is the default duration a network IP address is kept before
+// checking its claimer, so that claims of objects that are being created are not released.
+const DefaultNetworkIPAddressGCGracePeriod = 1 * time.Minute
+
+// NetworkIPAddressGCReconciler releases network IP addresses whose claimer does not exist anymore
+// or does not use the IP anymore, e.g. because the apiserver failed to release them.
+type NetworkIPAddressGCReconciler struct {
+	client.Client
+	APIReader   client.Reader
+	GracePeriod time.Duration
+
+	AbsenceCache *lru.Cache
+}
+
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkipaddresses,verbs=get;list;watch;delete
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch
+
+func (r *NetworkIPAddressGCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	networkIPAddress := &v1alpha1.NetworkIPAddress{}
+	if err := r.Get(ctx, req.NamespacedName, networkIPAddress); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !networkIPAddress.DeletionTimestamp.IsZero() {
+		// Don't try to GC addresses that are already deleting.
+		return ctrl.Result{}, nil
+	}
+
+	if r.GracePeriod > 0 {
+		if remaining := r.GracePeriod - time.Since(networkIPAddress.CreationTimestamp.Time); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	log.V(1).Info("Checking whether network IP address claimer uses the IP")
+	ok, err := r.networkIPAddressClaimerUsesIP(ctx, networkIPAddress)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error checking whether network IP address claimer uses the IP: %w", err)
+	}
+	if ok {
+		log.V(1).Info("Network IP address claimer still uses the IP")
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Network IP address claimer does not use the IP, releasing network IP address")
+	if err := r.Delete(ctx, networkIPAddress, client.Preconditions{UID: &networkIPAddress.UID}); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting network IP address: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *NetworkIPAddressGCReconciler) networkIPAddressClaimerUsesIP(ctx context.Context, networkIPAddress *v1alpha1.NetworkIPAddress) (bool, error) {
+	claimRef := networkIPAddress.Spec.ClaimRef
+	if _, ok := r.AbsenceCache.Get(claimRef.UID); ok {
+		return false, nil
+	}
+
+	var (
+		claimer client.Object
+		usesIP  func() bool
+	)
+	switch claimRef.Resource {
+	case "loadbalancers":
+		loadBalancer := &v1alpha1.LoadBalancer{}
+		claimer = loadBalancer
+		usesIP = func() bool {
+			return loadBalancer.Spec.Type == v1alpha1.LoadBalancerTypeInternal &&
+				loadBalancer.Spec.NetworkRef == networkIPAddress.Spec.NetworkRef &&
+				slices.ContainsFunc(loadBalancer.Spec.IPs, func(ip v1alpha1.LoadBalancerIP) bool {
+					return ip.IP == networkIPAddress.Spec.IP
+				})
+		}
+	case "networkinterfaces":
+		nic := &v1alpha1.NetworkInterface{}
+		claimer = nic
+		usesIP = func() bool {
+			return nic.Spec.NetworkRef == networkIPAddress.Spec.NetworkRef &&
+				slices.ContainsFunc(nic.Spec.IPs, func(ip net.IP) bool {
+					return ip == networkIPAddress.Spec.IP
+				})
+		}
+	default:
+		return false, fmt.Errorf("unsupported claimer resource %s", claimRef.Resource)
+	}
+
+	claimerKey := client.ObjectKey{Namespace: networkIPAddress.Namespace, Name: claimRef.Name}
+	if err := r.APIReader.Get(ctx, claimerKey, claimer); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("error getting claiming %s %s: %w", claimRef.Resource, claimerKey, err)
+		}
+
+		r.AbsenceCache.Add(claimRef.UID, nil)
+		return false, nil
+	}
+	if claimRef.UID != claimer.GetUID() {
+		r.AbsenceCache.Add(claimRef.UID, nil)
+		return false, nil
+	}
+	return usesIP(), nil
+}
+
+func (r *NetworkIPAddressGCReconciler) enqueueByClaimer() handler.EventHandler {
+	mapAndEnqueue := func(ctx context.Context, claimer client.Object, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		log := ctrl.LoggerFrom(ctx)
+
+		networkIPAddressList := &v1alpha1.NetworkIPAddressList{}
+		if err := r.List(ctx, networkIPAddressList,
+			client.InNamespace(claimer.GetNamespace()),
+		); err != nil {
+			log.Error(err, "Error listing network IP addresses")
+			return
+		}
+
+		for _, networkIPAddress := range networkIPAddressList.Items {
+			if v1alpha1.IsNetworkIPAddressClaimedBy(&networkIPAddress, claimer) {
+				queue.Add(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&networkIPAddress)})
+			}
+		}
+	}
+
+	return &handler.Funcs{
+		UpdateFunc: func(ctx context.Context, event event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			mapAndEnqueue(ctx, event.ObjectNew, queue)
+		},
+		DeleteFunc: func(ctx context.Context, event event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			mapAndEnqueue(ctx, event.Object, queue)
+		},
+		GenericFunc: func(ctx context.Context, event event.GenericEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if !event.Object.GetDeletionTimestamp().IsZero() {
+				mapAndEnqueue(ctx, event.Object, queue)
+			}
+		},
+	}
+}
+
+func (r *NetworkIPAddressGCReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("networkipaddressgc").
+		For(&v1alpha1.NetworkIPAddress{}).
+		Watches(
+			&v1alpha1.LoadBalancer{},
+			r.enqueueByClaimer(),
+		).
+		Watches(
+			&v1alpha1.NetworkInterface{},
+			r.enqueueByClaimer(),
+		).
+		Complete(r)
+}
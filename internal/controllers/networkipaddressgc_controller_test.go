@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"net/netip"
+
+	"github.com/google/uuid"
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("NetworkIPAddressGarbageCollectorController", func() {
+	ns := SetupNamespace(&k8sClient)
+	network := SetupNetwork(ns)
+
+	It("should not release network IP addresses of a network interface using the IP", func(ctx SpecContext) {
+		By("creating a network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef:    corev1.LocalObjectReference{Name: "my-node"},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("asserting the network IP address stays present")
+		networkIPAddress := &v1alpha1.NetworkIPAddress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      v1alpha1.NetworkIPAddressName(network.Name, netip.MustParseAddr("10.0.0.1")),
+			},
+		}
+		Eventually(Object(networkIPAddress)).Should(HaveField("Spec.ClaimRef.UID", nic.UID))
+		Consistently(Get(networkIPAddress)).Should(Succeed())
+
+		By("deleting the network interface")
+		Expect(k8sClient.Delete(ctx, nic)).To(Succeed())
+
+		By("waiting for the network IP address to be gone")
+		Eventually(Get(networkIPAddress)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should release a network IP address with a non-existent claimer", func(ctx SpecContext) {
+		By("creating a network IP address")
+		addr := netip.MustParseAddr("10.0.0.2")
+		networkIPAddress := &v1alpha1.NetworkIPAddress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      v1alpha1.NetworkIPAddressName(network.Name, addr),
+			},
+			Spec: v1alpha1.NetworkIPAddressSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IP:         net.IP{Addr: addr},
+				ClaimRef: v1alpha1.IPAddressClaimRef{
+					Group:     v1alpha1.GroupName,
+					Resource:  "loadbalancers",
+					Namespace: ns.Name,
+					Name:      "should-not-exist",
+					UID:       types.UID(uuid.NewString()),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, networkIPAddress)).To(Succeed())
+
+		By("waiting for the network IP address to be gone")
+		Eventually(Get(networkIPAddress)).Should(Satisfy(apierrors.IsNotFound))
+	})
+})
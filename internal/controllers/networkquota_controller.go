@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkquotas,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkquotas/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=ips,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=loadbalancers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgateways,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch
+
+// NetworkQuotaReconciler recomputes the usage of network quotas from the objects of their namespace.
+// The apiserver charges admitted requests to the usage, the reconciler gives back the usage of deleted objects.
+type NetworkQuotaReconciler struct {
+	client.Client
+}
+
+func (r *NetworkQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	networkQuota := &v1alpha1.NetworkQuota{}
+	if err := r.Get(ctx, req.NamespacedName, networkQuota); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !networkQuota.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcile(ctx, log, networkQuota)
+}
+
+func (r *NetworkQuotaReconciler) reconcile(ctx context.Context, log logr.Logger, networkQuota *v1alpha1.NetworkQuota) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Computing usage")
+	used, err := r.used(ctx, networkQuota)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error computing usage: %w", err)
+	}
+
+	if equality.Semantic.DeepEqual(networkQuota.Status.Used, used) {
+		log.V(1).Info("Usage is up-to-date")
+		return ctrl.Result{}, nil
+	}
+
+	// Update instead of patch to fail on concurrent charges of the apiserver.
+	log.V(1).Info("Updating status", "Used", used)
+	networkQuota.Status.Used = used
+	if err := r.Status().Update(ctx, networkQuota); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+// countedIP is an IP of a claimer counted by a resource other than the ips resource of its family.
+type countedIP struct {
+	claimerUID types.UID
+	addr       netip.Addr
+}
+
+// used returns the usage of each resource limited by the network quota.
+func (r *NetworkQuotaReconciler) used(ctx context.Context, networkQuota *v1alpha1.NetworkQuota) (map[v1alpha1.NetworkQuotaResourceName]int64, error) {
+	inNamespace := client.InNamespace(networkQuota.Namespace)
+
+	networkList := &v1alpha1.NetworkList{}
+	if err := r.List(ctx, networkList, inNamespace); err != nil {
+		return nil, fmt.Errorf("error listing networks: %w", err)
+	}
+
+	loadBalancerList := &v1alpha1.LoadBalancerList{}
+	if err := r.List(ctx, loadBalancerList, inNamespace); err != nil {
+		return nil, fmt.Errorf("error listing load balancers: %w", err)
+	}
+
+	natGatewayList := &v1alpha1.NATGatewayList{}
+	if err := r.List(ctx, natGatewayList, inNamespace); err != nil {
+		return nil, fmt.Errorf("error listing NAT gateways: %w", err)
+	}
+
+	nicList := &v1alpha1.NetworkInterfaceList{}
+	if err := r.List(ctx, nicList, inNamespace); err != nil {
+		return nil, fmt.Errorf("error listing network interfaces: %w", err)
+	}
+
+	ipList := &v1alpha1.IPList{}
+	if err := r.List(ctx, ipList, inNamespace); err != nil {
+		return nil, fmt.Errorf("error listing IPs: %w", err)
+	}
+
+	// IPs of existing NAT gateways and network interfaces are counted by their own resources.
+	countedIPs := sets.New[countedIP]()
+	used := map[v1alpha1.NetworkQuotaResourceName]int64{
+		v1alpha1.NetworkQuotaResourceNetworks:      int64(len(networkList.Items)),
+		v1alpha1.NetworkQuotaResourceLoadBalancers: int64(len(loadBalancerList.Items)),
+	}
+	for _, natGateway := range natGatewayList.Items {
+		for _, ip := range natGateway.Spec.IPs {
+			countedIPs.Insert(countedIP{natGateway.UID, ip.IP.Addr})
+		}
+		used[v1alpha1.NetworkQuotaResourceNATGatewayIPs] += int64(len(natGateway.Spec.IPs))
+	}
+	for _, nic := range nicList.Items {
+		for _, ip := range nic.Spec.PublicIPs {
+			countedIPs.Insert(countedIP{nic.UID, ip.IP.Addr})
+		}
+		used[v1alpha1.NetworkQuotaResourceNetworkInterfacePublicIPs] += int64(len(nic.Spec.PublicIPs))
+	}
+	for _, ip := range ipList.Items {
+		if claimRef := ip.Spec.ClaimRef; claimRef != nil && countedIPs.Has(countedIP{claimRef.UID, ip.Spec.IP.Addr}) {
+			continue
+		}
+
+		switch ip.Spec.IPFamily {
+		case corev1.IPv4Protocol:
+			used[v1alpha1.NetworkQuotaResourceIPv4IPs]++
+		case corev1.IPv6Protocol:
+			used[v1alpha1.NetworkQuotaResourceIPv6IPs]++
+		}
+	}
+
+	res := make(map[v1alpha1.NetworkQuotaResourceName]int64, len(networkQuota.Spec.Hard))
+	for name := range networkQuota.Spec.Hard {
+		res[name] = used[name]
+	}
+	return res, nil
+}
+
+func (r *NetworkQuotaReconciler) enqueueByNamespace() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		log := ctrl.LoggerFrom(ctx)
+
+		networkQuotaList := &v1alpha1.NetworkQuotaList{}
+		if err := r.List(ctx, networkQuotaList, client.InNamespace(obj.GetNamespace())); err != nil {
+			log.Error(err, "Error listing network quotas")
+			return nil
+		}
+
+		reqs := make([]ctrl.Request, 0, len(networkQuotaList.Items))
+		for _, networkQuota := range networkQuotaList.Items {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&networkQuota)})
+		}
+		return reqs
+	})
+}
+
+func (r *NetworkQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.NetworkQuota{}).
+		Watches(&v1alpha1.IP{}, r.enqueueByNamespace()).
+		Watches(&v1alpha1.Network{}, r.enqueueByNamespace()).
+		Watches(&v1alpha1.LoadBalancer{}, r.enqueueByNamespace()).
+		Watches(&v1alpha1.NATGateway{}, r.enqueueByNamespace()).
+		Watches(&v1alpha1.NetworkInterface{}, r.enqueueByNamespace()).
+		Complete(r)
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("NetworkQuotaController", func() {
+	ns := SetupNamespace(&k8sClient)
+	network := SetupNetwork(ns)
+
+	newIP := func() *v1alpha1.IP {
+		return &v1alpha1.IP{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "ip-",
+			},
+			Spec: v1alpha1.IPSpec{
+				Type:     v1alpha1.IPTypePublic,
+				IPFamily: corev1.IPv4Protocol,
+			},
+		}
+	}
+
+	It("should track the usage of the network quota", func(ctx SpecContext) {
+		By("creating a NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:   corev1.IPv4Protocol,
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs:        []v1alpha1.NATGatewayIP{{Name: "ip-1"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("creating a network quota")
+		networkQuota := &v1alpha1.NetworkQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "network-quota-",
+			},
+			Spec: v1alpha1.NetworkQuotaSpec{
+				Hard: map[v1alpha1.NetworkQuotaResourceName]int64{
+					v1alpha1.NetworkQuotaResourceIPv4IPs:       1,
+					v1alpha1.NetworkQuotaResourceNATGatewayIPs: 2,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, networkQuota)).To(Succeed())
+
+		By("waiting for the usage to count the NAT gateway IP by its own resource only")
+		Eventually(Object(networkQuota)).Should(HaveField("Status.Used", Equal(map[v1alpha1.NetworkQuotaResourceName]int64{
+			v1alpha1.NetworkQuotaResourceIPv4IPs:       0,
+			v1alpha1.NetworkQuotaResourceNATGatewayIPs: 1,
+		})))
+
+		By("creating an IP using up the IPv4 quota")
+		ip := newIP()
+		Expect(k8sClient.Create(ctx, ip)).To(Succeed())
+		Expect(Object(networkQuota)()).To(HaveField("Status.Used", HaveKeyWithValue(v1alpha1.NetworkQuotaResourceIPv4IPs, int64(1))))
+
+		By("asserting another IP is rejected")
+		Expect(k8sClient.Create(ctx, newIP())).To(Satisfy(apierrors.IsForbidden))
+
+		By("deleting the IP")
+		Expect(k8sClient.Delete(ctx, ip)).To(Succeed())
+
+		By("waiting for the usage to be given back")
+		Eventually(Object(networkQuota)).Should(HaveField("Status.Used", HaveKeyWithValue(v1alpha1.NetworkQuotaResourceIPv4IPs, int64(0))))
+
+		By("creating another IP")
+		Expect(k8sClient.Create(ctx, newIP())).To(Succeed())
+	})
+})
@@ -0,0 +1,339 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ipconsistency checks the consistency between IPs, the IP addresses backing them
+// and the objects claiming them.
+package ipconsistency
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type IssueType string
+
+const (
+	// IssueTypeOrphanedIPAddress is an IP address claimed by an IP that does not exist
+	// (anymore) or that has a different address.
+	IssueTypeOrphanedIPAddress IssueType = "OrphanedIPAddress"
+	// IssueTypeDanglingIPClaimRef is an IP whose claimer does not exist (anymore).
+	IssueTypeDanglingIPClaimRef IssueType = "DanglingIPClaimRef"
+	// IssueTypeDuplicateClaim is an address held by more than one IP or claimed by more than one object.
+	IssueTypeDuplicateClaim IssueType = "DuplicateClaim"
+)
+
+// IssueTypes are all known issue types.
+var IssueTypes = []IssueType{
+	IssueTypeOrphanedIPAddress,
+	IssueTypeDanglingIPClaimRef,
+	IssueTypeDuplicateClaim,
+}
+
+// Issue is a single inconsistency found by the Checker.
+type Issue struct {
+	Type IssueType
+	// Object is the object the issue was found on.
+	Object client.Object
+	// Address is the address affected by the issue.
+	Address netip.Addr
+	Message string
+}
+
+// Fixable reports whether the Checker can fix the issue.
+// Duplicate claims are never fixed automatically, as there is no way to tell which claimer is right.
+func (i Issue) Fixable() bool {
+	return i.Type != IssueTypeDuplicateClaim
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s %s: %s", i.Type, klog.KObj(i.Object), i.Message)
+}
+
+// Checker finds inconsistencies between IPs, IP addresses and the objects claiming them.
+type Checker struct {
+	// Client is used to fix issues and to map claim references to their kinds.
+	Client client.Client
+	// Reader is used to read the objects to check. Use a reader bypassing any cache
+	// to not report issues caused by stale objects.
+	Reader client.Reader
+	// GracePeriod is the minimum age of an object before it is checked, giving
+	// concurrent allocations and releases time to settle.
+	GracePeriod time.Duration
+}
+
+// claimerKey identifies a public address claimed in a namespace.
+type claimerKey struct {
+	namespace string
+	addr      netip.Addr
+}
+
+// Check returns all issues found.
+func (c *Checker) Check(ctx context.Context) ([]Issue, error) {
+	ipList := &v1alpha1.IPList{}
+	if err := c.Reader.List(ctx, ipList); err != nil {
+		return nil, fmt.Errorf("error listing IPs: %w", err)
+	}
+
+	ipAddressList := &v1alpha1.IPAddressList{}
+	if err := c.Reader.List(ctx, ipAddressList); err != nil {
+		return nil, fmt.Errorf("error listing IP addresses: %w", err)
+	}
+
+	claimers, err := c.listClaimers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	issues = append(issues, c.checkIPAddresses(ipList.Items, ipAddressList.Items)...)
+
+	danglingIssues, err := c.checkIPClaimRefs(ctx, ipList.Items)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, danglingIssues...)
+
+	issues = append(issues, c.checkDuplicateClaims(ipList.Items, claimers)...)
+	return issues, nil
+}
+
+func (c *Checker) settled(obj client.Object) bool {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+	return time.Since(obj.GetCreationTimestamp().Time) > c.GracePeriod
+}
+
+func isClaimedByIP(ipAddress *v1alpha1.IPAddress) bool {
+	claimRef := ipAddress.Spec.ClaimRef
+	return claimRef.Group == v1alpha1.GroupName && claimRef.Resource == "ips"
+}
+
+func (c *Checker) checkIPAddresses(ips []v1alpha1.IP, ipAddresses []v1alpha1.IPAddress) []Issue {
+	ipByUID := make(map[types.UID]*v1alpha1.IP, len(ips))
+	for i := range ips {
+		ipByUID[ips[i].UID] = &ips[i]
+	}
+
+	var issues []Issue
+	for i := range ipAddresses {
+		ipAddress := &ipAddresses[i]
+		if !isClaimedByIP(ipAddress) || !c.settled(ipAddress) {
+			continue
+		}
+		if _, ok := ipAddress.Annotations[v1alpha1.IPAddressQuarantinedUntilAnnotation]; ok {
+			// Quarantined IP addresses are expected to outlive their IP.
+			continue
+		}
+
+		claimRef := ipAddress.Spec.ClaimRef
+		addr := ipAddress.Spec.IP.Addr
+		ip, ok := ipByUID[claimRef.UID]
+		switch {
+		case !ok:
+			issues = append(issues, Issue{
+				Type:    IssueTypeOrphanedIPAddress,
+				Object:  ipAddress,
+				Address: addr,
+				Message: fmt.Sprintf("Claiming IP %s/%s does not exist", claimRef.Namespace, claimRef.Name),
+			})
+		case ip.Spec.IP.Addr != addr:
+			issues = append(issues, Issue{
+				Type:    IssueTypeOrphanedIPAddress,
+				Object:  ipAddress,
+				Address: addr,
+				Message: fmt.Sprintf("Claiming IP %s has address %s", klog.KObj(ip), ip.Spec.IP.Addr),
+			})
+		}
+	}
+	return issues
+}
+
+func (c *Checker) checkIPClaimRefs(ctx context.Context, ips []v1alpha1.IP) ([]Issue, error) {
+	var issues []Issue
+	for i := range ips {
+		ip := &ips[i]
+		claimRef := ip.Spec.ClaimRef
+		if claimRef == nil || !c.settled(ip) {
+			continue
+		}
+
+		ok, err := c.claimerExists(ctx, ip.Namespace, *claimRef)
+		if err != nil {
+			return nil, fmt.Errorf("error checking claimer of IP %s: %w", klog.KObj(ip), err)
+		}
+		if !ok {
+			issues = append(issues, Issue{
+				Type:    IssueTypeDanglingIPClaimRef,
+				Object:  ip,
+				Address: ip.Spec.IP.Addr,
+				Message: fmt.Sprintf("Claiming %s %s/%s does not exist", claimRef.Resource, ip.Namespace, claimRef.Name),
+			})
+		}
+	}
+	return issues, nil
+}
+
+func (c *Checker) claimerExists(ctx context.Context, namespace string, claimRef v1alpha1.IPClaimRef) (bool, error) {
+	gvr := schema.GroupVersionResource{Group: claimRef.Group, Resource: claimRef.Resource}
+	gvks, err := c.Client.RESTMapper().KindsFor(gvr)
+	if err != nil {
+		return false, fmt.Errorf("error getting kinds for %s: %w", gvr.GroupResource(), err)
+	}
+	if len(gvks) == 0 {
+		return false, fmt.Errorf("no kind for %s", gvr.GroupResource())
+	}
+
+	claimer := &metav1.PartialObjectMetadata{}
+	claimer.SetGroupVersionKind(gvks[0])
+	if err := c.Reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: claimRef.Name}, claimer); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		return false, nil
+	}
+	return claimer.UID == claimRef.UID, nil
+}
+
+func (c *Checker) listClaimers(ctx context.Context) (map[claimerKey][]client.Object, error) {
+	claimers := make(map[claimerKey][]client.Object)
+	add := func(obj client.Object, addr netip.Addr) {
+		if !addr.IsValid() {
+			return
+		}
+		key := claimerKey{namespace: obj.GetNamespace(), addr: addr}
+		if !slices.Contains(claimers[key], obj) {
+			claimers[key] = append(claimers[key], obj)
+		}
+	}
+
+	nicList := &v1alpha1.NetworkInterfaceList{}
+	if err := c.Reader.List(ctx, nicList); err != nil {
+		return nil, fmt.Errorf("error listing network interfaces: %w", err)
+	}
+	for i := range nicList.Items {
+		nic := &nicList.Items[i]
+		for _, publicIP := range nic.Spec.PublicIPs {
+			add(nic, publicIP.IP.Addr)
+		}
+	}
+
+	loadBalancerList := &v1alpha1.LoadBalancerList{}
+	if err := c.Reader.List(ctx, loadBalancerList); err != nil {
+		return nil, fmt.Errorf("error listing load balancers: %w", err)
+	}
+	for i := range loadBalancerList.Items {
+		loadBalancer := &loadBalancerList.Items[i]
+		if loadBalancer.Spec.Type != v1alpha1.LoadBalancerTypePublic {
+			// Internal IPs are addresses of the network of the load balancer and may be
+			// used by other networks of the namespace. They are claimed by network IP addresses.
+			continue
+		}
+		for _, ip := range loadBalancer.Spec.IPs {
+			add(loadBalancer, ip.IP.Addr)
+		}
+	}
+
+	natGatewayList := &v1alpha1.NATGatewayList{}
+	if err := c.Reader.List(ctx, natGatewayList); err != nil {
+		return nil, fmt.Errorf("error listing NAT gateways: %w", err)
+	}
+	for i := range natGatewayList.Items {
+		natGateway := &natGatewayList.Items[i]
+		for _, ip := range natGateway.Spec.IPs {
+			add(natGateway, ip.IP.Addr)
+		}
+	}
+	return claimers, nil
+}
+
+func (c *Checker) checkDuplicateClaims(ips []v1alpha1.IP, claimers map[claimerKey][]client.Object) []Issue {
+	var issues []Issue
+
+	ipsByAddr := make(map[netip.Addr][]*v1alpha1.IP)
+	for i := range ips {
+		ip := &ips[i]
+		if addr := ip.Spec.IP.Addr; addr.IsValid() && c.settled(ip) {
+			ipsByAddr[addr] = append(ipsByAddr[addr], ip)
+		}
+	}
+	for addr, ips := range ipsByAddr {
+		if len(ips) < 2 {
+			continue
+		}
+		for _, ip := range ips {
+			issues = append(issues, Issue{
+				Type:    IssueTypeDuplicateClaim,
+				Object:  ip,
+				Address: addr,
+				Message: fmt.Sprintf("Address %s is held by %d IPs", addr, len(ips)),
+			})
+		}
+	}
+
+	for key, objs := range claimers {
+		// The holder of the address is the claimer of the IP, if any.
+		var holder types.UID
+		for _, ip := range ipsByAddr[key.addr] {
+			if ip.Namespace == key.namespace && ip.Spec.ClaimRef != nil {
+				holder = ip.Spec.ClaimRef.UID
+			}
+		}
+
+		var others []client.Object
+		for _, obj := range objs {
+			if obj.GetUID() != holder && c.settled(obj) {
+				others = append(others, obj)
+			}
+		}
+		if holder == "" && len(others) < 2 {
+			// A single claimer of an unclaimed address is not a duplicate claim.
+			continue
+		}
+		for _, obj := range others {
+			issues = append(issues, Issue{
+				Type:    IssueTypeDuplicateClaim,
+				Object:  obj,
+				Address: key.addr,
+				Message: fmt.Sprintf("Address %s is claimed by another object", key.addr),
+			})
+		}
+	}
+	return issues
+}
+
+// Fix fixes the given issue. It reports false if the issue is not fixable.
+//
+// Orphaned IP addresses are deleted, releasing their address. Dangling claim references are
+// removed from their IP, making the IP claimable again.
+func (c *Checker) Fix(ctx context.Context, issue Issue) (bool, error) {
+	switch issue.Type {
+	case IssueTypeOrphanedIPAddress:
+		ipAddress := issue.Object.(*v1alpha1.IPAddress)
+		if err := c.Client.Delete(ctx, ipAddress, client.Preconditions{UID: &ipAddress.UID}); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("error deleting IP address %s: %w", ipAddress.Name, err)
+		}
+		return true, nil
+	case IssueTypeDanglingIPClaimRef:
+		ip := issue.Object.(*v1alpha1.IP)
+		base := ip.DeepCopy()
+		ip.Spec.ClaimRef = nil
+		if err := c.Client.Patch(ctx, ip, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("error removing claim reference of IP %s: %w", klog.KObj(ip), err)
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -6,6 +6,7 @@ package natgateway
 import (
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	"github.com/ironcore-dev/ironcore-net/utils/container"
+	corev1 "k8s.io/api/core/v1"
 )
 
 const (
@@ -14,9 +15,11 @@ const (
 	noOfEphemeralPorts       = maxEphemeralPort + 1 - minEphemeralPort
 )
 
+// AllocationManager manages the port allocations of NAT gateway IPs.
+// The IPs of every IP family form a separate allocation pool.
 type AllocationManager struct {
 	portsPerNetworkInterface int32
-	slots                    *container.KeySlots[net.IP]
+	slotsByIPFamily          map[corev1.IPFamily]*container.KeySlots[net.IP]
 }
 
 func SlotsPerIP(portsPerNetworkInterface int32) int32 {
@@ -25,61 +28,144 @@ func SlotsPerIP(portsPerNetworkInterface int32) int32 {
 
 func NewAllocationManager(portsPerNetworkInterface int32, ips []net.IP) *AllocationManager {
 	slotsPerIP := uint(noOfEphemeralPorts / portsPerNetworkInterface)
-	slots := container.NewKeySlots(slotsPerIP, ips)
+
+	ipsByIPFamily := make(map[corev1.IPFamily][]net.IP)
+	for _, ip := range ips {
+		ipFamily := ip.Family()
+		ipsByIPFamily[ipFamily] = append(ipsByIPFamily[ipFamily], ip)
+	}
+
+	slotsByIPFamily := make(map[corev1.IPFamily]*container.KeySlots[net.IP], len(ipsByIPFamily))
+	for ipFamily, ips := range ipsByIPFamily {
+		slotsByIPFamily[ipFamily] = container.NewKeySlots(slotsPerIP, ips)
+	}
 
 	return &AllocationManager{
 		portsPerNetworkInterface: portsPerNetworkInterface,
-		slots:                    slots,
+		slotsByIPFamily:          slotsByIPFamily,
 	}
 }
 
 func (m *AllocationManager) HasIP(ip net.IP) bool {
-	return m.slots.HasKey(ip)
+	slots, ok := m.slotsByIPFamily[ip.Family()]
+	return ok && slots.HasKey(ip)
 }
 
-func (m *AllocationManager) endPort(port int32) int32 {
-	return port + m.portsPerNetworkInterface - 1
+// Drain excludes the IP from UseNextFree. Existing port ranges of the IP can still be used.
+func (m *AllocationManager) Drain(ip net.IP) {
+	slots, ok := m.slotsByIPFamily[ip.Family()]
+	if !ok {
+		return
+	}
+	slots.Cordon(ip)
+}
+
+// IsDraining reports whether the IP is excluded from UseNextFree.
+func (m *AllocationManager) IsDraining(ip net.IP) bool {
+	slots, ok := m.slotsByIPFamily[ip.Family()]
+	return ok && slots.IsCordoned(ip)
 }
 
-func (m *AllocationManager) slotForPorts(port, endPort int32) (uint, bool) {
-	if port < minEphemeralPort || port >= endPort || endPort > maxEphemeralPort {
-		return 0, false
+// SlotsForPorts returns the number of port ranges needed to allocate the given number of ports.
+// Each port range spans PortsPerNetworkInterface ports.
+func (m *AllocationManager) SlotsForPorts(ports int32) int64 {
+	if ports <= m.portsPerNetworkInterface {
+		return 1
 	}
-	if m.endPort(port) != endPort {
-		return 0, false
+	return int64((ports + m.portsPerNetworkInterface - 1) / m.portsPerNetworkInterface)
+}
+
+func (m *AllocationManager) slotsForPorts(port, endPort int32) (slot, n uint, ok bool) {
+	if port < minEphemeralPort || port > endPort || endPort > maxEphemeralPort {
+		return 0, 0, false
+	}
+	if (port-minEphemeralPort)%m.portsPerNetworkInterface != 0 ||
+		(endPort+1-port)%m.portsPerNetworkInterface != 0 {
+		return 0, 0, false
 	}
-	return uint((port - minEphemeralPort) / m.portsPerNetworkInterface), true
+	return uint((port - minEphemeralPort) / m.portsPerNetworkInterface),
+		uint((endPort + 1 - port) / m.portsPerNetworkInterface),
+		true
 }
 
-func (m *AllocationManager) portsForSlot(slot uint) (port, endPort int32) {
+func (m *AllocationManager) portsForSlots(slot, n uint) (port, endPort int32) {
 	port = int32(slot)*m.portsPerNetworkInterface + minEphemeralPort
-	endPort = m.endPort(port)
+	endPort = port + int32(n)*m.portsPerNetworkInterface - 1
 	return port, endPort
 }
 
+// Use uses the given port range of the IP. The port range has to span a multiple
+// of PortsPerNetworkInterface ports and start at a port range boundary.
 func (m *AllocationManager) Use(ip net.IP, port, endPort int32) bool {
-	slot, ok := m.slotForPorts(port, endPort)
+	slots, ok := m.slotsByIPFamily[ip.Family()]
+	if !ok {
+		return false
+	}
+
+	slot, n, ok := m.slotsForPorts(port, endPort)
 	if !ok {
 		return false
 	}
 
-	return m.slots.Use(ip, slot)
+	return slots.UseRange(ip, slot, n)
 }
 
-func (m *AllocationManager) UseNextFree() (ip net.IP, port, endPort int32, ok bool) {
-	ip, slot, ok := m.slots.UseNextFree()
+// Release releases the given port range of the IP.
+func (m *AllocationManager) Release(ip net.IP, port, endPort int32) {
+	slots, ok := m.slotsByIPFamily[ip.Family()]
+	if !ok {
+		return
+	}
+
+	slot, n, ok := m.slotsForPorts(port, endPort)
+	if !ok {
+		return
+	}
+
+	slots.Release(ip, slot, n)
+}
+
+// UseAdjacent uses the port range of PortsPerNetworkInterface ports directly following or,
+// if that is not free, directly preceding the given port range of the IP.
+func (m *AllocationManager) UseAdjacent(ip net.IP, port, endPort int32) (adjacentPort, adjacentEndPort int32, ok bool) {
+	if next := endPort + 1; m.Use(ip, next, next+m.portsPerNetworkInterface-1) {
+		return next, next + m.portsPerNetworkInterface - 1, true
+	}
+	if prev := port - m.portsPerNetworkInterface; m.Use(ip, prev, port-1) {
+		return prev, port - 1, true
+	}
+	return 0, 0, false
+}
+
+// UseNextFree uses the next free port range of an IP of the given IP family
+// that spans at least the given number of ports.
+func (m *AllocationManager) UseNextFree(ipFamily corev1.IPFamily, ports int32) (ip net.IP, port, endPort int32, ok bool) {
+	slots, ok := m.slotsByIPFamily[ipFamily]
+	if !ok {
+		return net.IP{}, 0, 0, false
+	}
+
+	n := uint(m.SlotsForPorts(ports))
+	ip, slot, ok := slots.UseNextFreeRange(n)
 	if !ok {
 		return net.IP{}, 0, 0, false
 	}
 
-	port, endPort = m.portsForSlot(slot)
+	port, endPort = m.portsForSlots(slot, n)
 	return ip, port, endPort, true
 }
 
-func (m *AllocationManager) Total() int64 {
-	return int64(m.slots.Total())
+// Total returns the total number of port ranges of the given IP family.
+func (m *AllocationManager) Total(ipFamily corev1.IPFamily) int64 {
+	return int64(m.slotsByIPFamily[ipFamily].Total())
+}
+
+// Used returns the number of used port ranges of the given IP family.
+func (m *AllocationManager) Used(ipFamily corev1.IPFamily) int64 {
+	return int64(m.slotsByIPFamily[ipFamily].Used())
 }
 
-func (m *AllocationManager) Used() int64 {
-	return int64(m.slots.Used())
+// UsedByIP returns the number of used port ranges of the given IP.
+func (m *AllocationManager) UsedByIP(ip net.IP) int64 {
+	return int64(m.slotsByIPFamily[ip.Family()].UsedByKey(ip))
 }
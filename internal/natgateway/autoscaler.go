@@ -5,8 +5,11 @@ package natgateway
 
 import (
 	"sync"
+	"time"
 
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/utils/container"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -78,3 +81,68 @@ func (m *AutoscalerSelectors) Delete(key client.ObjectKey) {
 
 	m.selectorByKey.Delete(key)
 }
+
+// StabilizeRecommendation records the recommendation into the past recommendations of an IP family
+// and returns the stabilized number of IPs along with the recommendations to keep: When scaling up,
+// the lowest recommendation within the scale-up window is used, when scaling down, the highest
+// recommendation within the scale-down window. Only the latest time of every recommended number of IPs
+// is kept, and only as long as it is within one of the windows.
+// If the stabilized number differs from the recommendation, the returned duration reports when the
+// next recommendation bounding the result leaves its window.
+func StabilizeRecommendation(
+	recommendations []v1alpha1.NATGatewayAutoscalerRecommendation,
+	now time.Time,
+	current, recommendation int,
+	scaleUpWindow, scaleDownWindow time.Duration,
+) (int, time.Duration, []v1alpha1.NATGatewayAutoscalerRecommendation) {
+	maxWindow := max(scaleUpWindow, scaleDownWindow)
+
+	var (
+		keep               []v1alpha1.NATGatewayAutoscalerRecommendation
+		upRecommendation   = recommendation
+		downRecommendation = recommendation
+		upExpiry           time.Duration
+		downExpiry         time.Duration
+	)
+	for _, rec := range recommendations {
+		noOfIPs := int(rec.IPs)
+		age := now.Sub(rec.Time.Time)
+		if age >= maxWindow || noOfIPs == recommendation {
+			// Expired or superseded by the current recommendation.
+			continue
+		}
+		keep = append(keep, rec)
+
+		if age < scaleUpWindow && noOfIPs < recommendation {
+			upRecommendation = min(upRecommendation, noOfIPs)
+			if expiry := scaleUpWindow - age; upExpiry == 0 || expiry < upExpiry {
+				upExpiry = expiry
+			}
+		}
+		if age < scaleDownWindow && noOfIPs > recommendation {
+			downRecommendation = max(downRecommendation, noOfIPs)
+			if expiry := scaleDownWindow - age; downExpiry == 0 || expiry < downExpiry {
+				downExpiry = expiry
+			}
+		}
+	}
+	if maxWindow > 0 {
+		keep = append(keep, v1alpha1.NATGatewayAutoscalerRecommendation{
+			IPs:  int32(recommendation),
+			Time: metav1.NewTime(now).Rfc3339Copy(),
+		})
+	}
+
+	switch {
+	case current < upRecommendation:
+		return upRecommendation, upExpiry, keep
+	case current > downRecommendation:
+		return downRecommendation, downExpiry, keep
+	case current < recommendation:
+		return current, upExpiry, keep
+	case current > recommendation:
+		return current, downExpiry, keep
+	default:
+		return current, 0, keep
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package allocationstrategy
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+)
+
+// Strategy determines the offset within a range of IDs to start searching for a free ID at.
+// The search continues sequentially from the start offset, wrapping around at the end of the range.
+type Strategy interface {
+	// Start returns the offset in [0, size) of the range identified by rangeKey to start searching at.
+	// claimKey identifies the claimer of the ID.
+	Start(rangeKey string, size int64, claimKey string) int64
+	// Allocated records that the ID at offset was allocated from the range identified by rangeKey.
+	Allocated(rangeKey string, offset int64)
+}
+
+// Strategies holds a Strategy per AllocationStrategy. Strategies keeping state,
+// like SequentialAfterLast, share their state across all allocations done via the set,
+// i.e. across all allocations of a single apiserver replica.
+type Strategies struct {
+	byName map[v1alpha1.AllocationStrategy]Strategy
+}
+
+func NewStrategies() *Strategies {
+	return &Strategies{
+		byName: map[v1alpha1.AllocationStrategy]Strategy{
+			v1alpha1.AllocationStrategyRandom:              random{},
+			v1alpha1.AllocationStrategyLowestFree:          lowestFree{},
+			v1alpha1.AllocationStrategySequentialAfterLast: &sequentialAfterLast{last: make(map[string]int64)},
+			v1alpha1.AllocationStrategyHashed:              hashed{},
+		},
+	}
+}
+
+// Get returns the Strategy with the given name.
+func (s *Strategies) Get(name v1alpha1.AllocationStrategy) (Strategy, error) {
+	strategy, ok := s.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown allocation strategy %q", name)
+	}
+	return strategy, nil
+}
+
+// Names returns the names of all known strategies.
+func Names() []v1alpha1.AllocationStrategy {
+	return []v1alpha1.AllocationStrategy{
+		v1alpha1.AllocationStrategyRandom,
+		v1alpha1.AllocationStrategyLowestFree,
+		v1alpha1.AllocationStrategySequentialAfterLast,
+		v1alpha1.AllocationStrategyHashed,
+	}
+}
+
+type random struct{}
+
+func (random) Start(_ string, size int64, _ string) int64 {
+	return rand.Int63n(size)
+}
+
+func (random) Allocated(string, int64) {}
+
+type lowestFree struct{}
+
+func (lowestFree) Start(string, int64, string) int64 {
+	return 0
+}
+
+func (lowestFree) Allocated(string, int64) {}
+
+// sequentialAfterLast remembers the last allocated offset per range in memory.
+// The state is per apiserver replica: each replica continues after the last offset it allocated itself,
+// so with multiple replicas the allocations are not sequential across the whole range.
+// After a restart, allocation starts at the beginning of the range again.
+type sequentialAfterLast struct {
+	mu   sync.Mutex
+	last map[string]int64
+}
+
+func (s *sequentialAfterLast) Start(rangeKey string, size int64, _ string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, ok := s.last[rangeKey]
+	if !ok {
+		return 0
+	}
+	return (last + 1) % size
+}
+
+func (s *sequentialAfterLast) Allocated(rangeKey string, offset int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.last[rangeKey] = offset
+}
+
+type hashed struct{}
+
+func (hashed) Start(_ string, size int64, claimKey string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(claimKey))
+	return int64(h.Sum64() % uint64(size))
+}
+
+func (hashed) Allocated(string, int64) {}
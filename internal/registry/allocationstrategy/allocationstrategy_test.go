@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package allocationstrategy
+
+import (
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Strategies", func() {
+	const size = 4
+
+	// allocation is an allocation of the ID at offset from the range identified by rangeKey.
+	type allocation struct {
+		rangeKey string
+		offset   int64
+	}
+
+	DescribeTable("Start",
+		func(name v1alpha1.AllocationStrategy, allocated []allocation, rangeKey, claimKey string, matcher OmegaMatcher) {
+			strategy, err := NewStrategies().Get(name)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, a := range allocated {
+				strategy.Allocated(a.rangeKey, a.offset)
+			}
+			Expect(strategy.Start(rangeKey, size, claimKey)).To(matcher)
+		},
+		Entry("lowest free starting at the beginning",
+			v1alpha1.AllocationStrategyLowestFree, nil, "range", "claim", BeEquivalentTo(0),
+		),
+		Entry("lowest free starting at the beginning after allocations",
+			v1alpha1.AllocationStrategyLowestFree, []allocation{{"range", 2}}, "range", "claim", BeEquivalentTo(0),
+		),
+		Entry("sequential after last starting at the beginning",
+			v1alpha1.AllocationStrategySequentialAfterLast, nil, "range", "claim", BeEquivalentTo(0),
+		),
+		Entry("sequential after last continuing after the last allocation",
+			v1alpha1.AllocationStrategySequentialAfterLast, []allocation{{"range", 0}, {"range", 1}}, "range", "claim", BeEquivalentTo(2),
+		),
+		Entry("sequential after last wrapping around at the end of the range",
+			v1alpha1.AllocationStrategySequentialAfterLast, []allocation{{"range", size - 1}}, "range", "claim", BeEquivalentTo(0),
+		),
+		Entry("sequential after last tracking each range separately",
+			v1alpha1.AllocationStrategySequentialAfterLast, []allocation{{"other-range", 2}}, "range", "claim", BeEquivalentTo(0),
+		),
+		Entry("hashed being in the range",
+			v1alpha1.AllocationStrategyHashed, nil, "range", "claim", SatisfyAll(BeNumerically(">=", 0), BeNumerically("<", size)),
+		),
+		Entry("random being in the range",
+			v1alpha1.AllocationStrategyRandom, nil, "range", "claim", SatisfyAll(BeNumerically(">=", 0), BeNumerically("<", size)),
+		),
+	)
+
+	It("should start at the same offset for the same claim key with the hashed strategy", func() {
+		strategy, err := NewStrategies().Get(v1alpha1.AllocationStrategyHashed)
+		Expect(err).NotTo(HaveOccurred())
+
+		start := strategy.Start("range", 1024, "claim")
+		strategy.Allocated("range", start)
+		Expect(strategy.Start("range", 1024, "claim")).To(Equal(start))
+		Expect(strategy.Start("other-range", 1024, "claim")).To(Equal(start))
+
+		otherStarts := make(map[int64]struct{})
+		for _, claimKey := range []string{"claim-1", "claim-2", "claim-3", "claim-4"} {
+			otherStarts[strategy.Start("range", 1024, claimKey)] = struct{}{}
+		}
+		Expect(len(otherStarts)).To(BeNumerically(">", 1), "different claim keys should spread")
+	})
+
+	It("should always start in the range with the random strategy", func() {
+		strategy, err := NewStrategies().Get(v1alpha1.AllocationStrategyRandom)
+		Expect(err).NotTo(HaveOccurred())
+
+		for range 1000 {
+			Expect(strategy.Start("range", size, "claim")).To(SatisfyAll(BeNumerically(">=", 0), BeNumerically("<", size)))
+		}
+	})
+
+	It("should not share the sequential after last state across strategy sets", func() {
+		strategy, err := NewStrategies().Get(v1alpha1.AllocationStrategySequentialAfterLast)
+		Expect(err).NotTo(HaveOccurred())
+		strategy.Allocated("range", 2)
+
+		otherStrategy, err := NewStrategies().Get(v1alpha1.AllocationStrategySequentialAfterLast)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(otherStrategy.Start("range", size, "claim")).To(BeEquivalentTo(0))
+	})
+
+	It("should error for an unknown strategy", func() {
+		_, err := NewStrategies().Get("Unknown")
+		Expect(err).To(HaveOccurred())
+	})
+})
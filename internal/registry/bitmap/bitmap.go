@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitmap
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+const wordSize = 64
+
+// Bitmap tracks the used offsets of a range of IDs in memory.
+// Words without any used offset are not stored, so sparsely used large ranges stay cheap.
+type Bitmap struct {
+	mu    sync.Mutex
+	size  int64
+	count int64
+	words map[int64]uint64
+}
+
+// New creates a new Bitmap for a range of the given size.
+func New(size int64) *Bitmap {
+	return &Bitmap{
+		size:  size,
+		words: make(map[int64]uint64),
+	}
+}
+
+// Size returns the size of the range.
+func (b *Bitmap) Size() int64 {
+	return b.size
+}
+
+// Count returns the number of used offsets.
+func (b *Bitmap) Count() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.count
+}
+
+func (b *Bitmap) inRange(offset int64) bool {
+	return offset >= 0 && offset < b.size
+}
+
+// Has reports whether the offset is used.
+func (b *Bitmap) Has(offset int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.has(offset)
+}
+
+func (b *Bitmap) has(offset int64) bool {
+	return b.words[offset/wordSize]&(1<<(offset%wordSize)) != 0
+}
+
+// Set marks the offset as used. It reports whether the offset was unused before.
+func (b *Bitmap) Set(offset int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.inRange(offset) || b.has(offset) {
+		return false
+	}
+	b.words[offset/wordSize] |= 1 << (offset % wordSize)
+	b.count++
+	return true
+}
+
+// Clear marks the offset as unused. It reports whether the offset was used before.
+func (b *Bitmap) Clear(offset int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.inRange(offset) || !b.has(offset) {
+		return false
+	}
+	idx := offset / wordSize
+	word := b.words[idx] &^ (1 << (offset % wordSize))
+	if word == 0 {
+		delete(b.words, idx)
+	} else {
+		b.words[idx] = word
+	}
+	b.count--
+	return true
+}
+
+// Offsets returns all used offsets in no particular order.
+func (b *Bitmap) Offsets() []int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := make([]int64, 0, b.count)
+	for idx, word := range b.words {
+		for word != 0 {
+			bit := int64(bits.TrailingZeros64(word))
+			res = append(res, idx*wordSize+bit)
+			word &^= 1 << bit
+		}
+	}
+	return res
+}
+
+// Range is an inclusive range of offsets.
+type Range struct {
+	First int64
+	Last  int64
+}
+
+// MergeRanges sorts the ranges and merges overlapping and adjacent ones.
+// Empty ranges are dropped.
+func MergeRanges(ranges []Range) []Range {
+	ranges = slices.DeleteFunc(slices.Clone(ranges), func(r Range) bool { return r.First > r.Last })
+	slices.SortFunc(ranges, func(a, b Range) int { return cmp.Compare(a.First, b.First) })
+
+	var res []Range
+	for _, r := range ranges {
+		if n := len(res); n > 0 && r.First <= res[n-1].Last+1 {
+			res[n-1].Last = max(res[n-1].Last, r.Last)
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+// skipEnd returns the offset after the end of the range of skip containing the offset, at most size.
+// If no range contains the offset, the offset itself is returned.
+func skipEnd(skip []Range, offset, size int64) int64 {
+	i, _ := slices.BinarySearchFunc(skip, offset, func(r Range, offset int64) int {
+		if r.Last < offset {
+			return -1
+		}
+		if r.First > offset {
+			return 1
+		}
+		return 0
+	})
+	if i < len(skip) && skip[i].First <= offset && offset <= skip[i].Last {
+		return min(skip[i].Last, size-1) + 1
+	}
+	return offset
+}
+
+// NextClear returns the first unused offset at or after start, wrapping around at the end of the range.
+// Offsets in any of the skip ranges are treated as used. skip has to be sorted and merged, as returned by
+// MergeRanges. Skipped ranges are jumped over as a whole, so large skipped ranges don't slow down the lookup.
+// NextClear reports false if there is no such offset.
+func (b *Bitmap) NextClear(start int64, skip []Range) (int64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.size <= 0 || b.count >= b.size {
+		return 0, false
+	}
+	if !b.inRange(start) {
+		start = 0
+	}
+
+	offset := start
+	for scanned := int64(0); scanned < b.size; {
+		// Jump past a skipped range, but never beyond the end of the range.
+		advance := skipEnd(skip, offset, b.size) - offset
+		if advance == 0 {
+			bit := offset % wordSize
+			free := ^b.words[offset/wordSize] >> bit
+
+			// Jump to the next unused offset in this word, but never beyond the end of the range.
+			advance = min(int64(wordSize)-bit, b.size-offset)
+			if free != 0 {
+				advance = min(int64(bits.TrailingZeros64(free)), advance)
+			}
+		}
+		if advance == 0 {
+			return offset, true
+		}
+
+		scanned += advance
+		offset += advance
+		if offset == b.size {
+			offset = 0
+		}
+	}
+	return 0, false
+}
+
+// Drift returns the number of offsets only used in actual (leaked) and only used in desired (missing).
+func Drift(actual, desired *Bitmap) (leaked, missing int) {
+	for _, offset := range actual.Offsets() {
+		if !desired.Has(offset) {
+			leaked++
+		}
+	}
+	for _, offset := range desired.Offsets() {
+		if !actual.Has(offset) {
+			missing++
+		}
+	}
+	return leaked, missing
+}
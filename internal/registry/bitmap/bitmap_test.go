@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitmap
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBitmap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bitmap Suite")
+}
+
+func nextClear(b *Bitmap, start int64, skip ...Range) int64 {
+	offset, ok := b.NextClear(start, skip)
+	ExpectWithOffset(1, ok).To(BeTrue())
+	return offset
+}
+
+var _ = Describe("Bitmap", func() {
+	It("should set and clear offsets", func() {
+		b := New(100)
+		Expect(b.Set(3)).To(BeTrue())
+		Expect(b.Set(3)).To(BeFalse())
+		Expect(b.Set(100)).To(BeFalse())
+		Expect(b.Has(3)).To(BeTrue())
+		Expect(b.Count()).To(Equal(int64(1)))
+
+		Expect(b.Clear(3)).To(BeTrue())
+		Expect(b.Clear(3)).To(BeFalse())
+		Expect(b.Count()).To(BeZero())
+	})
+
+	It("should find the next unused offset, wrapping around", func() {
+		b := New(130)
+		for i := int64(0); i < 130; i++ {
+			if i != 2 && i != 127 {
+				b.Set(i)
+			}
+		}
+
+		Expect(nextClear(b, 0)).To(Equal(int64(2)))
+		Expect(nextClear(b, 3)).To(Equal(int64(127)))
+		Expect(nextClear(b, 128)).To(Equal(int64(2)))
+		Expect(nextClear(b, 3, Range{First: 127, Last: 127})).To(Equal(int64(2)))
+	})
+
+	It("should report a full bitmap", func() {
+		b := New(3)
+		b.Set(0)
+		b.Set(2)
+		_, ok := b.NextClear(0, []Range{{First: 1, Last: 1}})
+		Expect(ok).To(BeFalse())
+
+		b.Set(1)
+		_, ok = b.NextClear(0, nil)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should handle large sparse ranges", func() {
+		b := New(1 << 62)
+		b.Set(1<<61 + 5)
+		Expect(nextClear(b, 1<<61+5)).To(Equal(int64(1<<61 + 6)))
+		Expect(b.Offsets()).To(ConsistOf(int64(1<<61 + 5)))
+	})
+
+	It("should jump over large skipped ranges", func() {
+		b := New(1<<63 - 1)
+		b.Set(1<<56 + 1)
+		skip := MergeRanges([]Range{
+			{First: 1 << 56, Last: 1<<56 + 1<<40},
+			{First: 0, Last: 1<<56 - 1},
+		})
+		Expect(skip).To(Equal([]Range{{First: 0, Last: 1<<56 + 1<<40}}))
+
+		Expect(nextClear(b, 0, skip...)).To(Equal(int64(1<<56 + 1<<40 + 1)))
+		Expect(nextClear(b, 1<<62, skip...)).To(Equal(int64(1 << 62)))
+	})
+
+	It("should report a full bitmap if all unused offsets are skipped", func() {
+		b := New(1 << 62)
+		b.Set(1<<62 - 1)
+		_, ok := b.NextClear(5, []Range{{First: 0, Last: 1<<62 - 2}})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should merge ranges", func() {
+		Expect(MergeRanges([]Range{
+			{First: 10, Last: 20},
+			{First: 0, Last: 4},
+			{First: 5, Last: 6},
+			{First: 15, Last: 30},
+			{First: 40, Last: 39},
+		})).To(Equal([]Range{{First: 0, Last: 6}, {First: 10, Last: 30}}))
+	})
+
+	It("should compute the drift between bitmaps", func() {
+		actual, desired := New(10), New(10)
+		actual.Set(1)
+		actual.Set(2)
+		desired.Set(2)
+		desired.Set(3)
+		desired.Set(4)
+
+		leaked, missing := Drift(actual, desired)
+		Expect(leaked).To(Equal(1))
+		Expect(missing).To(Equal(2))
+	})
+})
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddressallocator
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/bitmap"
+	netiputils "github.com/ironcore-dev/ironcore-net/utils/netip"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// usedAddresses tracks the used addresses of each prefix allocated from in memory.
+// It is kept up to date by IP address informer events and periodically repaired
+// against the stored IP addresses, which remain the source of truth.
+type usedAddresses struct {
+	mu       sync.Mutex
+	byPrefix map[netip.Prefix]*bitmap.Bitmap
+}
+
+// addressOffset returns the offset of the address in the prefix. Offsets beyond the range of int64
+// are capped to math.MaxInt64, which is beyond the size of any bitmap.
+func addressOffset(prefix netip.Prefix, addr netip.Addr) int64 {
+	first := new(big.Int).SetBytes(prefix.Masked().Addr().AsSlice())
+	offset := new(big.Int).SetBytes(addr.AsSlice())
+	offset.Sub(offset, first)
+	if !offset.IsInt64() {
+		return math.MaxInt64
+	}
+	return offset.Int64()
+}
+
+func newPrefixBitmap(prefix netip.Prefix, ipAddresses []*v1alpha1.IPAddress) *bitmap.Bitmap {
+	b := bitmap.New(netiputils.PrefixSize(prefix))
+	for _, ipAddress := range ipAddresses {
+		if addr := ipAddress.Spec.IP.Addr; prefix.Contains(addr) {
+			b.Set(addressOffset(prefix, addr))
+		}
+	}
+	return b
+}
+
+// bitmapFor returns the bitmap of the prefix, building it from the informer cache if it does not exist yet.
+func (a *Allocator) bitmapFor(prefix netip.Prefix) (*bitmap.Bitmap, error) {
+	a.used.mu.Lock()
+	defer a.used.mu.Unlock()
+
+	if b, ok := a.used.byPrefix[prefix]; ok {
+		return b, nil
+	}
+
+	ipAddresses, err := a.ipAddressLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	b := newPrefixBitmap(prefix, ipAddresses)
+	a.used.byPrefix[prefix] = b
+	return b, nil
+}
+
+func (a *Allocator) markUsed(addr netip.Addr, used bool) {
+	a.used.mu.Lock()
+	defer a.used.mu.Unlock()
+
+	for prefix, b := range a.used.byPrefix {
+		if !prefix.Contains(addr) {
+			continue
+		}
+		if used {
+			b.Set(addressOffset(prefix, addr))
+		} else {
+			b.Clear(addressOffset(prefix, addr))
+		}
+	}
+}
+
+func (a *Allocator) ipAddressEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if ipAddress, ok := obj.(*v1alpha1.IPAddress); ok {
+				a.markUsed(ipAddress.Spec.IP.Addr, true)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if ipAddress, ok := obj.(*v1alpha1.IPAddress); ok {
+				a.markUsed(ipAddress.Spec.IP.Addr, false)
+			}
+		},
+	}
+}
+
+// knownPrefixes returns all static and IP pool prefixes of the allocator's family.
+func (a *Allocator) knownPrefixes() (map[netip.Prefix]struct{}, error) {
+	ipPools, err := a.ipPoolLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make(map[netip.Prefix]struct{})
+	for _, meta := range a.staticPrefixMetaInformation {
+		prefixes[meta.prefix] = struct{}{}
+	}
+	for _, pool := range ipPools {
+		if pool.Spec.IPFamily != a.family {
+			continue
+		}
+		for _, prefix := range pool.Spec.Prefixes {
+			prefixes[prefix.Prefix] = struct{}{}
+		}
+	}
+	return prefixes, nil
+}
+
+// RunRepair periodically reconciles the in-memory bitmaps with the stored IP addresses until ctx is done.
+func (a *Allocator) RunRepair(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, a.repair, interval)
+}
+
+func (a *Allocator) repair(ctx context.Context) {
+	log := klog.FromContext(ctx).WithValues("IPFamily", a.family)
+	if !a.ready() {
+		log.V(1).Info("Allocator not ready, skipping repair")
+		return
+	}
+
+	ipAddressList, err := a.client.IPAddresses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "Error listing IP addresses for repair")
+		return
+	}
+	ipAddresses := make([]*v1alpha1.IPAddress, len(ipAddressList.Items))
+	for i := range ipAddressList.Items {
+		ipAddresses[i] = &ipAddressList.Items[i]
+	}
+
+	knownPrefixes, err := a.knownPrefixes()
+	if err != nil {
+		log.Error(err, "Error listing IP pools for repair")
+		return
+	}
+
+	a.used.mu.Lock()
+	defer a.used.mu.Unlock()
+
+	for prefix, b := range a.used.byPrefix {
+		if _, ok := knownPrefixes[prefix]; !ok {
+			log.V(1).Info("Dropping bitmap of removed prefix", "Prefix", prefix)
+			delete(a.used.byPrefix, prefix)
+			continue
+		}
+
+		repaired := newPrefixBitmap(prefix, ipAddresses)
+		if leaked, missing := bitmap.Drift(b, repaired); leaked > 0 || missing > 0 {
+			log.Info("Repaired bitmap of prefix", "Prefix", prefix, "Leaked", leaked, "Missing", missing)
+		}
+		a.used.byPrefix[prefix] = repaired
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddressallocator
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// historyGCInterval is the interval expired leases are pruned from the IP address histories at.
+const historyGCInterval = time.Hour
+
+// recordAllocated appends a lease of the claimer to the history of the address.
+func (a *Allocator) recordAllocated(ctx context.Context, addr netip.Addr, claimRef v1alpha1.IPAddressClaimRef) {
+	now := metav1.Now()
+	a.updateHistory(ctx, addr, func(history *v1alpha1.IPAddressHistory) bool {
+		leases := history.Spec.Leases
+		if n := len(leases); n > 0 && leases[n-1].ReleasedTime == nil {
+			// The release of the previous claimer was not recorded, e.g. because its IP address
+			// was deleted directly. Close its lease at the latest possible time.
+			leases[n-1].ReleasedTime = &now
+		}
+		history.Spec.Leases = append(leases, v1alpha1.IPAddressLease{
+			ClaimRef:      claimRef,
+			AllocatedTime: now,
+		})
+		return true
+	})
+}
+
+// recordReleased releases the current lease of the history of the address, if any.
+func (a *Allocator) recordReleased(ctx context.Context, addr netip.Addr) {
+	now := metav1.Now()
+	a.updateHistory(ctx, addr, func(history *v1alpha1.IPAddressHistory) bool {
+		leases := history.Spec.Leases
+		n := len(leases)
+		if n == 0 || leases[n-1].ReleasedTime != nil {
+			return false
+		}
+		leases[n-1].ReleasedTime = &now
+		return true
+	})
+}
+
+// updateHistory applies mutate to the history of the address, creating the history if it does not exist.
+// Expired leases are pruned along the way. Recording the history is best-effort: Errors are logged,
+// as they must not fail the allocation or release of the address.
+func (a *Allocator) updateHistory(ctx context.Context, addr netip.Addr, mutate func(history *v1alpha1.IPAddressHistory) bool) {
+	if a.historyRetention <= 0 {
+		return
+	}
+
+	name := addr.String()
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		history, err := a.client.IPAddressHistories().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			history = &v1alpha1.IPAddressHistory{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: v1alpha1.IPAddressHistorySpec{
+					IP: net.NewIP(addr),
+				},
+			}
+			if !mutate(history) {
+				return nil
+			}
+			_, err = a.client.IPAddressHistories().Create(ctx, history, metav1.CreateOptions{})
+			return err
+		}
+
+		mutated := mutate(history)
+		leases, pruned := a.pruneLeases(history.Spec.Leases, time.Now())
+		if !mutated && !pruned {
+			return nil
+		}
+		history.Spec.Leases = leases
+		_, err = a.client.IPAddressHistories().Update(ctx, history, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Error recording IP address history", "Address", addr)
+	}
+}
+
+// pruneLeases drops the leading leases released longer than the history retention ago.
+// It reports whether any lease was dropped.
+func (a *Allocator) pruneLeases(leases []v1alpha1.IPAddressLease, now time.Time) ([]v1alpha1.IPAddressLease, bool) {
+	cutoff := now.Add(-a.historyRetention)
+
+	var n int
+	for n < len(leases) && leases[n].ReleasedTime != nil && leases[n].ReleasedTime.Time.Before(cutoff) {
+		n++
+	}
+	return leases[n:], n > 0
+}
+
+// RunHistoryGC periodically prunes expired leases from the IP address histories of the allocator's
+// family and deletes histories without any lease left until ctx is done.
+func (a *Allocator) RunHistoryGC(ctx context.Context) {
+	if a.historyRetention <= 0 {
+		return
+	}
+	wait.UntilWithContext(ctx, a.gcHistories, historyGCInterval)
+}
+
+func (a *Allocator) gcHistories(ctx context.Context) {
+	log := klog.FromContext(ctx).WithValues("IPFamily", a.family)
+
+	historyList, err := a.client.IPAddressHistories().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "Error listing IP address histories")
+		return
+	}
+
+	now := time.Now()
+	for i := range historyList.Items {
+		history := &historyList.Items[i]
+		addr := history.Spec.IP.Addr
+		if !addr.IsValid() || addr.Is6() != (a.family == corev1.IPv6Protocol) {
+			continue
+		}
+
+		leases, pruned := a.pruneLeases(history.Spec.Leases, now)
+		if !pruned {
+			continue
+		}
+
+		if len(leases) == 0 {
+			err = a.client.IPAddressHistories().Delete(ctx, history.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{ResourceVersion: &history.ResourceVersion},
+			})
+		} else {
+			history.Spec.Leases = leases
+			_, err = a.client.IPAddressHistories().Update(ctx, history, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+				// Concurrently modified, retry with the next run.
+				log.V(1).Info("IP address history changed, skipping", "Address", addr)
+				continue
+			}
+			log.Error(err, "Error pruning IP address history", "Address", addr)
+			continue
+		}
+		log.V(1).Info("Pruned IP address history", "Address", addr, "Leases", len(leases))
+	}
+}
@@ -7,8 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/rand"
 	"net/netip"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -17,11 +18,15 @@ import (
 	v1alpha1informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions/core/v1alpha1"
 	v1alpha1client "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/typed/core/v1alpha1"
 	v1alpha1listers "github.com/ironcore-dev/ironcore-net/client-go/listers/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/allocationstrategy"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/bitmap"
 	netiputils "github.com/ironcore-dev/ironcore-net/utils/netip"
 	"go4.org/netipx"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	utiltrace "k8s.io/utils/trace"
@@ -31,93 +36,344 @@ var (
 	ErrFull       = errors.New("all IPs are allocated")
 	ErrAllocated  = errors.New("provided IP is already allocated")
 	ErrNotInRange = errors.New("the provided IP is not in range")
+	ErrNoPool     = errors.New("no matching IP pool")
+	ErrExcluded   = errors.New("the provided IP is excluded from allocation")
+	ErrReserved   = errors.New("the provided IP is reserved")
 )
 
+// ReservedAuthorizer reports whether addresses of the reserved ranges of the IP pool
+// with the given name may be allocated.
+type ReservedAuthorizer func(ctx context.Context, poolName string) (bool, error)
+
+// PoolSelection selects the IP pools to allocate addresses from.
+type PoolSelection struct {
+	// Type is the type of the pools to allocate from.
+	Type v1alpha1.IPType
+	// Name is the name of a single pool to allocate from.
+	Name string
+	// Selector selects the pools to allocate from by their labels.
+	Selector labels.Selector
+	// AuthorizeReserved authorizes explicit allocations from reserved ranges.
+	// If unset, addresses of reserved ranges cannot be allocated.
+	AuthorizeReserved ReservedAuthorizer
+	// Namespace is the namespace of the claimer. Bring-your-own-IP pools only match
+	// if they belong to the namespace and are selected by Name or Selector.
+	// If unset, no bring-your-own-IP pool matches.
+	Namespace string
+}
+
 type prefixMetaInformation struct {
 	prefix  netip.Prefix
 	firstIP netip.Addr
 	lastIP  netip.Addr
 	size    int64
+
+	// pool is the name of the IP pool of the prefix, empty for static prefixes.
+	pool     string
+	excluded []netip.Prefix
+	reserved []netip.Prefix
+	// skip holds the merged offset ranges of the excluded and reserved prefixes,
+	// which are skipped as a whole when allocating dynamically.
+	skip []bitmap.Range
+	// strategy is the allocation strategy of the pool, empty to use the allocator's default.
+	strategy v1alpha1.AllocationStrategy
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *prefixMetaInformation) contains(addr netip.Addr) bool {
+	return !addr.Less(m.firstIP) && !m.lastIP.Less(addr)
+}
+
+// offsetRanges returns the merged ranges of offsets of the given prefixes within the prefix.
+// Parts of the prefixes beyond the size of the prefix are dropped.
+func (m *prefixMetaInformation) offsetRanges(prefixes []netip.Prefix) []bitmap.Range {
+	var ranges []bitmap.Range
+	for _, prefix := range prefixes {
+		if !m.prefix.Overlaps(prefix) {
+			continue
+		}
+
+		first, last := prefix.Masked().Addr(), netipx.PrefixLastIP(prefix)
+		if first.Less(m.firstIP) {
+			first = m.firstIP
+		}
+		if m.lastIP.Less(last) {
+			last = m.lastIP
+		}
+
+		firstOffset := addressOffset(m.prefix, first)
+		if firstOffset >= m.size {
+			continue
+		}
+		ranges = append(ranges, bitmap.Range{
+			First: firstOffset,
+			Last:  min(addressOffset(m.prefix, last), m.size-1),
+		})
+	}
+	return bitmap.MergeRanges(ranges)
+}
+
+func newPrefixMetaInformation(prefix netip.Prefix) prefixMetaInformation {
+	return prefixMetaInformation{
+		prefix:  prefix,
+		firstIP: prefix.Masked().Addr(),
+		lastIP:  netipx.PrefixLastIP(prefix),
+		size:    netiputils.PrefixSize(prefix),
+	}
 }
 
 type Allocator struct {
 	family corev1.IPFamily
 
-	prefixMetaInformation []prefixMetaInformation
+	// staticPrefixMetaInformation holds the statically configured public prefixes.
+	// They are used in addition to any public IP pool if no pool is selected explicitly.
+	staticPrefixMetaInformation []prefixMetaInformation
 
 	client          v1alpha1client.CoreV1alpha1Interface
 	ipAddressLister v1alpha1listers.IPAddressLister
 	ipAddressSynced cache.InformerSynced
+	ipPoolLister    v1alpha1listers.IPPoolLister
+	ipPoolSynced    cache.InformerSynced
+
+	// quarantinePeriod is the duration released IP addresses are held by a tombstone
+	// before they can be allocated again.
+	quarantinePeriod time.Duration
+	// historyRetention is the duration released leases are kept in the IP address histories.
+	// If not positive, no history is recorded.
+	historyRetention time.Duration
+
+	strategies      *allocationstrategy.Strategies
+	defaultStrategy v1alpha1.AllocationStrategy
+
+	used usedAddresses
 }
 
 func New(
-	prefixes []netip.Prefix,
+	family corev1.IPFamily,
+	staticPrefixes []netip.Prefix,
 	client v1alpha1client.CoreV1alpha1Interface,
 	informer v1alpha1informers.IPAddressInformer,
+	ipPoolInformer v1alpha1informers.IPPoolInformer,
+	quarantinePeriod time.Duration,
+	defaultStrategy v1alpha1.AllocationStrategy,
+	historyRetention time.Duration,
 ) (*Allocator, error) {
-	if len(prefixes) == 0 {
-		return nil, fmt.Errorf("at least one prefix must be provided")
+	strategies := allocationstrategy.NewStrategies()
+	if _, err := strategies.Get(defaultStrategy); err != nil {
+		return nil, err
 	}
 
-	var family corev1.IPFamily
-	if prefixes[0].Addr().Is6() {
-		family = corev1.IPv6Protocol
-	} else {
-		family = corev1.IPv4Protocol
-	}
-
-	prefixMetaInfo := make([]prefixMetaInformation, len(prefixes))
-	for i, prefix := range prefixes {
+	staticPrefixMetaInfo := make([]prefixMetaInformation, len(staticPrefixes))
+	for i, prefix := range staticPrefixes {
 		if prefix.Addr().Is6() != (family == corev1.IPv6Protocol) {
-			return nil, fmt.Errorf("all prefixes must be of the same IP family")
-		}
-		prefixMetaInfo[i] = prefixMetaInformation{
-			prefix:  prefix,
-			firstIP: prefix.Masked().Addr(),
-			lastIP:  netipx.PrefixLastIP(prefix),
-			size:    netiputils.PrefixSize(prefix),
+			return nil, fmt.Errorf("all prefixes must be of IP family %s", family)
 		}
+		staticPrefixMetaInfo[i] = newPrefixMetaInformation(prefix)
 	}
 
-	return &Allocator{
-		family:                family,
-		prefixMetaInformation: prefixMetaInfo,
-		client:                client,
-		ipAddressLister:       informer.Lister(),
-		ipAddressSynced:       informer.Informer().HasSynced,
-	}, nil
+	a := &Allocator{
+		family:                      family,
+		staticPrefixMetaInformation: staticPrefixMetaInfo,
+		client:                      client,
+		ipAddressLister:             informer.Lister(),
+		ipAddressSynced:             informer.Informer().HasSynced,
+		ipPoolLister:                ipPoolInformer.Lister(),
+		ipPoolSynced:                ipPoolInformer.Informer().HasSynced,
+		quarantinePeriod:            quarantinePeriod,
+		strategies:                  strategies,
+		defaultStrategy:             defaultStrategy,
+		historyRetention:            historyRetention,
+		used: usedAddresses{
+			byPrefix: make(map[netip.Prefix]*bitmap.Bitmap),
+		},
+	}
+	if _, err := informer.Informer().AddEventHandler(a.ipAddressEventHandler()); err != nil {
+		return nil, fmt.Errorf("error adding IP address event handler: %w", err)
+	}
+	return a, nil
 }
 
 func (a *Allocator) IPFamily() corev1.IPFamily {
 	return a.family
 }
 
-func (a *Allocator) Allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, ip netip.Addr) error {
-	return a.allocate(ctx, claimRef, ip, false)
+func (a *Allocator) ready() bool {
+	return a.ipAddressSynced() && a.ipPoolSynced()
+}
+
+func (a *Allocator) poolMatches(pool *v1alpha1.IPPool, pools PoolSelection) bool {
+	return pool.DeletionTimestamp.IsZero() &&
+		pool.Spec.IPFamily == a.family &&
+		pool.Spec.Type == pools.Type &&
+		(pool.Spec.Namespace == "" || pool.Spec.Namespace == pools.Namespace)
 }
 
-func (a *Allocator) allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, ip netip.Addr, dryRun bool) error {
-	if !a.ipAddressSynced() {
+func (a *Allocator) appendPoolPrefixes(metas []prefixMetaInformation, pool *v1alpha1.IPPool) []prefixMetaInformation {
+	rangesIn := func(prefix netip.Prefix, ranges []net.IPPrefix) []netip.Prefix {
+		var res []netip.Prefix
+		for _, r := range ranges {
+			if prefix.Overlaps(r.Prefix) {
+				res = append(res, r.Prefix)
+			}
+		}
+		return res
+	}
+
+	for _, prefix := range pool.Spec.Prefixes {
+		meta := newPrefixMetaInformation(prefix.Prefix)
+		meta.pool = pool.Name
+		meta.excluded = rangesIn(prefix.Prefix, pool.Spec.Excluded)
+		meta.reserved = rangesIn(prefix.Prefix, pool.Spec.Reserved)
+		meta.skip = meta.offsetRanges(slices.Concat(meta.excluded, meta.reserved))
+		meta.strategy = pool.Spec.AllocationStrategy
+		metas = append(metas, meta)
+	}
+	return metas
+}
+
+// prefixes returns the prefixes of the pools selected by the given selection.
+// Pools are ordered by name, with the bring-your-own-IP pools of the namespace first,
+// followed by the static prefixes. Bring-your-own-IP pools are only used if they are
+// selected by name or selector, so that a prefix brought for one purpose is not used
+// for every IP of the namespace.
+func (a *Allocator) prefixes(pools PoolSelection) ([]prefixMetaInformation, error) {
+	if pools.Name != "" {
+		pool, err := a.ipPoolLister.Get(pools.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: IP pool %s not found", ErrNoPool, pools.Name)
+			}
+			return nil, err
+		}
+		if ns := pool.Spec.Namespace; ns != "" && ns != pools.Namespace {
+			return nil, fmt.Errorf("%w: IP pool %s is reserved for namespace %s", ErrNoPool, pools.Name, ns)
+		}
+		if !a.poolMatches(pool, pools) {
+			return nil, fmt.Errorf("%w: IP pool %s does not provide %s IPs of family %s",
+				ErrNoPool, pools.Name, pools.Type, a.family)
+		}
+		return a.appendPoolPrefixes(nil, pool), nil
+	}
+
+	sel := pools.Selector
+	if sel == nil {
+		sel = labels.Everything()
+	}
+	ipPools, err := a.ipPoolLister.List(sel)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(ipPools, func(a, b *v1alpha1.IPPool) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var metas []prefixMetaInformation
+	if pools.Selector != nil {
+		for _, pool := range ipPools {
+			if pool.Spec.Namespace != "" && a.poolMatches(pool, pools) {
+				metas = a.appendPoolPrefixes(metas, pool)
+			}
+		}
+	} else if pools.Type == v1alpha1.IPTypePublic {
+		metas = append(metas, a.staticPrefixMetaInformation...)
+	}
+	for _, pool := range ipPools {
+		if pool.Spec.Namespace == "" && a.poolMatches(pool, pools) {
+			metas = a.appendPoolPrefixes(metas, pool)
+		}
+	}
+	if len(metas) == 0 {
+		return nil, fmt.Errorf("%w: no %s IP pool of family %s", ErrNoPool, pools.Type, a.family)
+	}
+	return metas, nil
+}
+
+// HasPrefixes reports whether there are any prefixes to allocate addresses from.
+func (a *Allocator) HasPrefixes() bool {
+	if len(a.staticPrefixMetaInformation) > 0 {
+		return true
+	}
+
+	ipPools, err := a.ipPoolLister.List(labels.Everything())
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(ipPools, func(pool *v1alpha1.IPPool) bool {
+		return pool.DeletionTimestamp.IsZero() && pool.Spec.IPFamily == a.family && len(pool.Spec.Prefixes) > 0
+	})
+}
+
+func (a *Allocator) Allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection, ip netip.Addr) error {
+	return a.allocate(ctx, claimRef, pools, ip, false)
+}
+
+func (a *Allocator) allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection, ip netip.Addr, dryRun bool) error {
+	if !a.ready() {
 		return fmt.Errorf("allocator not ready")
 	}
 	if !ip.IsValid() {
 		return fmt.Errorf("invalid IP")
 	}
 
+	metas, err := a.prefixes(pools)
+	if err != nil {
+		return err
+	}
+
 	// Check if IP is in any of the prefixes
-	for _, meta := range a.prefixMetaInformation {
-		if !ip.Less(meta.firstIP) && !meta.lastIP.Less(ip) {
-			if dryRun {
-				return nil
+	for _, meta := range metas {
+		if !meta.contains(ip) {
+			continue
+		}
+
+		if containsAddr(meta.excluded, ip) {
+			return ErrExcluded
+		}
+		if containsAddr(meta.reserved, ip) {
+			if err := authorizeReserved(ctx, pools.AuthorizeReserved, meta.pool); err != nil {
+				return err
 			}
-			return a.createIPAddress(ctx, ip, claimRef)
 		}
+
+		if dryRun {
+			return nil
+		}
+		if err := a.createIPAddress(ctx, ip, claimRef, meta.pool); err != nil {
+			return err
+		}
+		a.markUsed(ip, true)
+		return nil
 	}
 
 	return ErrNotInRange
 }
 
-func (a *Allocator) createIPAddress(ctx context.Context, addr netip.Addr, claimRef v1alpha1.IPAddressClaimRef) error {
+func authorizeReserved(ctx context.Context, authorize ReservedAuthorizer, poolName string) error {
+	if authorize == nil {
+		return ErrReserved
+	}
+
+	ok, err := authorize(ctx, poolName)
+	if err != nil {
+		return fmt.Errorf("error authorizing reserved IP of pool %s: %w", poolName, err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: not allowed to use IP pool %s", ErrReserved, poolName)
+	}
+	return nil
+}
+
+// createIPAddress creates the IP address claimed by claimRef, recording the pool it was allocated from.
+func (a *Allocator) createIPAddress(ctx context.Context, addr netip.Addr, claimRef v1alpha1.IPAddressClaimRef, pool string) error {
+	claimRef.Pool = pool
 	ipAddress := &v1alpha1.IPAddress{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: addr.String(),
@@ -134,35 +390,49 @@ func (a *Allocator) createIPAddress(ctx context.Context, addr netip.Addr, claimR
 		}
 		return err
 	}
+	a.recordAllocated(ctx, addr, claimRef)
 	return nil
 }
 
-func (a *Allocator) AllocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef) (netip.Addr, error) {
-	return a.allocateNext(ctx, claimRef, false)
+func (a *Allocator) AllocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection) (netip.Addr, error) {
+	return a.allocateNext(ctx, claimRef, pools, false)
 }
 
-func (a *Allocator) allocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, dryRun bool) (netip.Addr, error) {
+func (a *Allocator) allocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection, dryRun bool) (netip.Addr, error) {
 	log := klog.FromContext(ctx).WithValues(
 		"ClaimRef", claimRef,
 		"DryRun", dryRun,
 	)
 
-	if !a.ipAddressSynced() {
+	if !a.ready() {
 		return netip.Addr{}, fmt.Errorf("allocator not ready")
 	}
+
+	metas, err := a.prefixes(pools)
+	if err != nil {
+		return netip.Addr{}, err
+	}
 	if dryRun {
-		return a.prefixMetaInformation[0].prefix.Addr(), nil
+		return metas[0].prefix.Addr(), nil
 	}
 
 	trace := utiltrace.New("allocate dynamic IPAddress")
 	defer trace.LogIfLong(500 * time.Millisecond)
 
+	claimKey := fmt.Sprintf("%s/%s/%s", claimRef.Resource, claimRef.Namespace, claimRef.Name)
+
 	// Try each prefix in order
-	for _, meta := range a.prefixMetaInformation {
-		offset := rand.Int63n(meta.size)
-		iterator := ipIterator(meta.firstIP, meta.lastIP, uint64(offset))
-		addr, err := a.allocateFromIterator(ctx, log, claimRef, iterator)
+	for _, meta := range metas {
+		strategy, err := a.strategyFor(&meta)
+		if err != nil {
+			return netip.Addr{}, err
+		}
+
+		rangeKey := meta.prefix.String()
+		start := strategy.Start(rangeKey, meta.size, claimKey)
+		addr, err := a.allocateFromPrefix(ctx, log, claimRef, &meta, start)
 		if err == nil {
+			strategy.Allocated(rangeKey, addressOffset(meta.prefix, addr))
 			return addr, nil
 		}
 		if !errors.Is(err, ErrFull) {
@@ -173,30 +443,52 @@ func (a *Allocator) allocateNext(ctx context.Context, claimRef v1alpha1.IPAddres
 	return netip.Addr{}, ErrFull
 }
 
-func (a *Allocator) allocateFromIterator(ctx context.Context, log logr.Logger, claimRef v1alpha1.IPAddressClaimRef, it func() netip.Addr) (netip.Addr, error) {
+func (a *Allocator) strategyFor(meta *prefixMetaInformation) (allocationstrategy.Strategy, error) {
+	if meta.strategy != "" {
+		return a.strategies.Get(meta.strategy)
+	}
+	return a.strategies.Get(a.defaultStrategy)
+}
+
+// allocateFromPrefix allocates the next unused address of the prefix at or after the start offset.
+// Unused addresses are looked up in the in-memory bitmap of the prefix. The created IPAddress is the
+// commit record of the allocation: If it already exists, the bitmap was stale and the next address is tried.
+func (a *Allocator) allocateFromPrefix(
+	ctx context.Context,
+	log logr.Logger,
+	claimRef v1alpha1.IPAddressClaimRef,
+	meta *prefixMetaInformation,
+	start int64,
+) (netip.Addr, error) {
+	b, err := a.bitmapFor(meta.prefix)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("error getting used addresses of prefix %s: %w", meta.prefix, err)
+	}
+
 	for {
-		addr := it()
-		if !addr.IsValid() {
+		offset, ok := b.NextClear(start, meta.skip)
+		if !ok {
 			return netip.Addr{}, ErrFull
 		}
-
-		name := addr.String()
-		_, err := a.client.IPAddresses().Get(ctx, name, metav1.GetOptions{})
-		if err == nil {
+		if !b.Set(offset) {
+			// Concurrently allocated, try the next one.
 			continue
 		}
 
-		if !apierrors.IsNotFound(err) {
-			log.Error(err, "Unexpected error getting IP address", "Address", addr)
-			continue
-		}
-
-		err = a.createIPAddress(ctx, addr, claimRef)
+		addr, err := netiputils.AddOffsetAddress(meta.firstIP, uint64(offset))
 		if err != nil {
-			log.Error(err, "Error creating IP address", "Address", addr)
-			continue
+			b.Clear(offset)
+			return netip.Addr{}, fmt.Errorf("error computing address at offset %d of prefix %s: %w", offset, meta.prefix, err)
 		}
+		if err := a.createIPAddress(ctx, addr, claimRef, meta.pool); err != nil {
+			if errors.Is(err, ErrAllocated) {
+				log.V(1).Info("IP address already allocated, trying next", "Address", addr)
+				continue
+			}
 
+			b.Clear(offset)
+			return netip.Addr{}, fmt.Errorf("error creating IP address %s: %w", addr, err)
+		}
 		return addr, nil
 	}
 }
@@ -205,8 +497,39 @@ func (a *Allocator) Release(ctx context.Context, ip netip.Addr) error {
 	return a.release(ctx, ip, false)
 }
 
+// Quarantine releases the IP. If a quarantine period is configured, the IP address is not
+// deleted but marked as tombstone until the period has passed, so it cannot be allocated
+// again in the meantime. Tombstones are deleted by the IP address garbage collector.
+func (a *Allocator) Quarantine(ctx context.Context, ip netip.Addr) error {
+	return a.quarantine(ctx, ip, false)
+}
+
+func (a *Allocator) quarantine(ctx context.Context, ip netip.Addr, dryRun bool) error {
+	if a.quarantinePeriod <= 0 {
+		return a.release(ctx, ip, dryRun)
+	}
+	if !a.ready() {
+		return fmt.Errorf("allocator not ready")
+	}
+	if dryRun {
+		return nil
+	}
+
+	name := ip.String()
+	until := time.Now().Add(a.quarantinePeriod).UTC().Format(time.RFC3339)
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, v1alpha1.IPAddressQuarantinedUntilAnnotation, until)
+	if _, err := a.client.IPAddresses().Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error quarantining IP address %s: %w", name, err)
+	}
+	a.recordReleased(ctx, ip)
+	return nil
+}
+
 func (a *Allocator) release(ctx context.Context, ip netip.Addr, dryRun bool) error {
-	if !a.ipAddressSynced() {
+	if !a.ready() {
 		return fmt.Errorf("allocator not ready")
 	}
 	if dryRun {
@@ -217,6 +540,8 @@ func (a *Allocator) release(ctx context.Context, ip netip.Addr, dryRun bool) err
 	if err := a.client.IPAddresses().Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
 		return fmt.Errorf("error deleting IP address %s: %w", name, err)
 	}
+	a.markUsed(ip, false)
+	a.recordReleased(ctx, ip)
 
 	return nil
 }
@@ -233,59 +558,36 @@ func (dry dryRunAllocator) IPFamily() corev1.IPFamily {
 	return dry.real.IPFamily()
 }
 
-func (dry dryRunAllocator) Allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, ip netip.Addr) error {
-	return dry.real.allocate(ctx, claimRef, ip, true)
+func (dry dryRunAllocator) HasPrefixes() bool {
+	return dry.real.HasPrefixes()
+}
+
+func (dry dryRunAllocator) Allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection, ip netip.Addr) error {
+	return dry.real.allocate(ctx, claimRef, pools, ip, true)
 }
 
-func (dry dryRunAllocator) AllocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef) (netip.Addr, error) {
-	return dry.real.allocateNext(ctx, claimRef, true)
+func (dry dryRunAllocator) AllocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection) (netip.Addr, error) {
+	return dry.real.allocateNext(ctx, claimRef, pools, true)
 }
 
 func (dry dryRunAllocator) Release(ctx context.Context, ip netip.Addr) error {
 	return dry.real.release(ctx, ip, true)
 }
 
-func (dry dryRunAllocator) DryRun() Interface {
-	return dry
+func (dry dryRunAllocator) Quarantine(ctx context.Context, ip netip.Addr) error {
+	return dry.real.quarantine(ctx, ip, true)
 }
 
-func ipIterator(first netip.Addr, last netip.Addr, offset uint64) func() netip.Addr {
-	// There are no modulo operations for IP addresses
-	modulo := func(addr netip.Addr) netip.Addr {
-		if addr.Compare(last) == 1 {
-			return first
-		}
-		return addr
-	}
-	next := func(addr netip.Addr) netip.Addr {
-		return modulo(addr.Next())
-	}
-	start, err := netiputils.AddOffsetAddress(first, offset)
-	if err != nil {
-		return func() netip.Addr { return netip.Addr{} }
-	}
-	start = modulo(start)
-	ip := start
-	seen := false
-	return func() netip.Addr {
-		value := ip
-		// is the last or the first iteration
-		if value == start {
-			if seen {
-				return netip.Addr{}
-			}
-			seen = true
-		}
-		ip = next(ip)
-		return value
-	}
-
+func (dry dryRunAllocator) DryRun() Interface {
+	return dry
 }
 
 type Interface interface {
 	IPFamily() corev1.IPFamily
-	Allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, ip netip.Addr) error
-	AllocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef) (netip.Addr, error)
+	HasPrefixes() bool
+	Allocate(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection, ip netip.Addr) error
+	AllocateNext(ctx context.Context, claimRef v1alpha1.IPAddressClaimRef, pools PoolSelection) (netip.Addr, error)
 	Release(ctx context.Context, ip netip.Addr) error
+	Quarantine(ctx context.Context, ip netip.Addr) error
 	DryRun() Interface
 }
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddressallocator
+
+import (
+	"math"
+	"net/netip"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions"
+	"github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/fake"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/bitmap"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("prefixMetaInformation", func() {
+	It("should compute the offset ranges of excluded and reserved prefixes", func() {
+		meta := newPrefixMetaInformation(netip.MustParsePrefix("10.0.0.0/24"))
+		Expect(meta.offsetRanges([]netip.Prefix{
+			netip.MustParsePrefix("10.0.0.128/25"),
+			netip.MustParsePrefix("10.0.0.0/31"),
+			netip.MustParsePrefix("10.0.0.2/32"),
+			netip.MustParsePrefix("10.0.1.0/24"),
+		})).To(Equal([]bitmap.Range{
+			{First: 0, Last: 2},
+			{First: 128, Last: 255},
+		}))
+	})
+
+	It("should cap the offset ranges of large IPv6 prefixes to the size of the prefix", func() {
+		meta := newPrefixMetaInformation(netip.MustParsePrefix("2001:db8::/48"))
+		Expect(meta.size).To(Equal(int64(math.MaxInt64)))
+		Expect(meta.offsetRanges([]netip.Prefix{
+			netip.MustParsePrefix("2001:db8::/56"),
+			netip.MustParsePrefix("2001:db8:0:ff00::/56"),
+		})).To(Equal([]bitmap.Range{{First: 0, Last: math.MaxInt64 - 1}}))
+	})
+})
+
+var _ = Describe("Allocator", func() {
+	newAllocator := func(ctx SpecContext, reserved string) *Allocator {
+		client := fake.NewSimpleClientset(&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+			Spec: v1alpha1.IPPoolSpec{
+				Type:     v1alpha1.IPTypePublic,
+				IPFamily: corev1.IPv6Protocol,
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("2001:db8::/48")},
+				Excluded: []net.IPPrefix{net.MustParseIPPrefix("2001:db8::100:0:0:0/128")},
+				Reserved: []net.IPPrefix{net.MustParseIPPrefix(reserved)},
+			},
+		})
+		factory := externalversions.NewSharedInformerFactory(client, 0)
+		coreInformers := factory.Core().V1alpha1()
+
+		a, err := New(
+			corev1.IPv6Protocol,
+			nil,
+			client.CoreV1alpha1(),
+			coreInformers.IPAddresses(),
+			coreInformers.IPPools(),
+			0,
+			v1alpha1.AllocationStrategyLowestFree,
+			0,
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+		return a
+	}
+	claimRef := v1alpha1.IPAddressClaimRef{Resource: "loadbalancers", Name: "lb"}
+	pools := PoolSelection{Type: v1alpha1.IPTypePublic, Name: "pool"}
+
+	It("should allocate past a large reserved IPv6 range without walking it", func(ctx SpecContext) {
+		a := newAllocator(ctx, "2001:db8::/72")
+
+		start := time.Now()
+		addr, err := a.AllocateNext(ctx, claimRef, pools)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addr).To(Equal(netip.MustParseAddr("2001:db8::100:0:0:1")))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It("should report a full prefix if a reserved IPv6 range covers all allocatable addresses", func(ctx SpecContext) {
+		// Only the first math.MaxInt64 addresses of a /48 are tracked, all of which are part of the /56.
+		a := newAllocator(ctx, "2001:db8::/56")
+
+		start := time.Now()
+		_, err := a.AllocateNext(ctx, claimRef, pools)
+		Expect(err).To(MatchError(ErrFull))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+})
+
+var _ = Describe("Allocator bring-your-own-IP pools", func() {
+	newAllocator := func(ctx SpecContext) *Allocator {
+		client := fake.NewSimpleClientset(
+			&v1alpha1.IPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+				Spec: v1alpha1.IPPoolSpec{
+					Type:     v1alpha1.IPTypePublic,
+					IPFamily: corev1.IPv4Protocol,
+					Prefixes: []net.IPPrefix{net.MustParseIPPrefix("192.0.2.0/24")},
+				},
+			},
+			&v1alpha1.IPPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "byoip",
+					Labels: map[string]string{"pool": "byoip"},
+				},
+				Spec: v1alpha1.IPPoolSpec{
+					Type:      v1alpha1.IPTypePublic,
+					IPFamily:  corev1.IPv4Protocol,
+					Prefixes:  []net.IPPrefix{net.MustParseIPPrefix("198.51.100.0/24")},
+					Namespace: "tenant",
+				},
+			},
+		)
+		factory := externalversions.NewSharedInformerFactory(client, 0)
+		coreInformers := factory.Core().V1alpha1()
+
+		a, err := New(
+			corev1.IPv4Protocol,
+			nil,
+			client.CoreV1alpha1(),
+			coreInformers.IPAddresses(),
+			coreInformers.IPPools(),
+			0,
+			v1alpha1.AllocationStrategyLowestFree,
+			0,
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+		return a
+	}
+	claimRef := v1alpha1.IPAddressClaimRef{Resource: "ips", Namespace: "tenant", Name: "ip"}
+
+	DescribeTable("should only allocate from bring-your-own-IP pools selected by the claimer",
+		func(ctx SpecContext, pools PoolSelection, expectedPrefix string) {
+			a := newAllocator(ctx)
+
+			addr, err := a.AllocateNext(ctx, claimRef, pools)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(netip.MustParsePrefix(expectedPrefix).Contains(addr)).To(BeTrue(), "address %s", addr)
+		},
+		Entry("no pool selected",
+			PoolSelection{Type: v1alpha1.IPTypePublic, Namespace: "tenant"},
+			"192.0.2.0/24",
+		),
+		Entry("pool selected by name",
+			PoolSelection{Type: v1alpha1.IPTypePublic, Namespace: "tenant", Name: "byoip"},
+			"198.51.100.0/24",
+		),
+		Entry("pool selected by selector",
+			PoolSelection{Type: v1alpha1.IPTypePublic, Namespace: "tenant", Selector: labels.SelectorFromSet(labels.Set{"pool": "byoip"})},
+			"198.51.100.0/24",
+		),
+	)
+
+	It("should not allocate from bring-your-own-IP pools of other namespaces", func(ctx SpecContext) {
+		a := newAllocator(ctx)
+
+		_, err := a.AllocateNext(ctx, claimRef, PoolSelection{Type: v1alpha1.IPTypePublic, Namespace: "other", Name: "byoip"})
+		Expect(err).To(MatchError(ErrNoPool))
+	})
+})
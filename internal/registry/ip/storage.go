@@ -17,6 +17,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	apisrvstorage "k8s.io/apiserver/pkg/storage"
@@ -31,6 +33,51 @@ type IPStorage struct {
 type REST struct {
 	*genericregistry.Store
 	allocatorByFamily map[corev1.IPFamily]ipaddressallocator.Interface
+	authorizer        authorizer.Authorizer
+}
+
+// authorizeReserved checks whether the requesting user may 'use' the IP pool with the given name.
+func (r *REST) authorizeReserved(ctx context.Context, poolName string) (bool, error) {
+	if r.authorizer == nil {
+		return false, nil
+	}
+
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return false, nil
+	}
+
+	decision, _, err := r.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            user,
+		Verb:            "use",
+		APIGroup:        v1alpha1.GroupName,
+		APIVersion:      v1alpha1.SchemeGroupVersion.Version,
+		Resource:        "ippools",
+		Name:            poolName,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return false, err
+	}
+	return decision == authorizer.DecisionAllow, nil
+}
+
+func poolSelectionFor(ip *core.IP) (ipaddressallocator.PoolSelection, error) {
+	pools := ipaddressallocator.PoolSelection{
+		Type:      v1alpha1.IPType(ip.Spec.Type),
+		Namespace: ip.Namespace,
+	}
+	if poolRef := ip.Spec.PoolRef; poolRef != nil {
+		pools.Name = poolRef.Name
+	}
+	if poolSelector := ip.Spec.PoolSelector; poolSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(poolSelector)
+		if err != nil {
+			return ipaddressallocator.PoolSelection{}, fmt.Errorf("error parsing pool selector: %w", err)
+		}
+		pools.Selector = sel
+	}
+	return pools, nil
 }
 
 func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1.CreateOptions) (genericregistry.FinishFunc, error) {
@@ -49,6 +96,12 @@ func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1
 		alloc = alloc.DryRun()
 	}
 
+	pools, err := poolSelectionFor(ip)
+	if err != nil {
+		return nil, err
+	}
+	pools.AuthorizeReserved = r.authorizeReserved
+
 	claimRef := v1alpha1.IPAddressClaimRef{
 		Group:     v1alpha1.GroupName,
 		Resource:  "ips",
@@ -59,12 +112,21 @@ func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1
 
 	addr := ip.Spec.IP
 	if addr.IsValid() {
-		if err := alloc.Allocate(ctx, claimRef, addr.Addr); err != nil {
+		if err := alloc.Allocate(ctx, claimRef, pools, addr.Addr); err != nil {
+			if errors.Is(err, ipaddressallocator.ErrNoPool) {
+				return nil, apierrors.NewBadRequest(err.Error())
+			}
+			if errors.Is(err, ipaddressallocator.ErrExcluded) || errors.Is(err, ipaddressallocator.ErrReserved) {
+				return nil, apierrors.NewForbidden(v1alpha1.Resource("ips"), ip.Name, err)
+			}
 			return nil, fmt.Errorf("error allocating IP %s: %w", addr.Addr, err)
 		}
 	} else {
-		newAddr, err := alloc.AllocateNext(ctx, claimRef)
+		newAddr, err := alloc.AllocateNext(ctx, claimRef, pools)
 		if err != nil {
+			if errors.Is(err, ipaddressallocator.ErrNoPool) {
+				return nil, apierrors.NewBadRequest(err.Error())
+			}
 			if !errors.Is(err, ipaddressallocator.ErrFull) {
 				return nil, fmt.Errorf("error allocating dynamic IP: %w", err)
 			}
@@ -110,7 +172,7 @@ func (r *REST) afterDelete(obj runtime.Object, opts *metav1.DeleteOptions) {
 		}
 
 		addr := ip.Spec.IP.Addr
-		if err := alloc.Release(ctx, addr); err != nil {
+		if err := alloc.Quarantine(ctx, addr); err != nil {
 			log.Error(err, "Error releasing IP")
 		} else {
 			log.Info("Released IP")
@@ -122,6 +184,7 @@ func NewStorage(
 	scheme *runtime.Scheme,
 	optsGetter generic.RESTOptionsGetter,
 	allocatorByFamily map[corev1.IPFamily]ipaddressallocator.Interface,
+	authorizer authorizer.Authorizer,
 ) (IPStorage, error) {
 	strategy := NewStrategy(scheme)
 
@@ -156,6 +219,7 @@ func NewStorage(
 	genericStore := &REST{
 		Store:             store,
 		allocatorByFamily: allocatorByFamily,
+		authorizer:        authorizer,
 	}
 
 	store.BeginCreate = genericStore.beginCreate
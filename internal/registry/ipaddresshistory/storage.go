@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddresshistory
+
+import (
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+)
+
+type IPAddressHistoryStorage struct {
+	IPAddressHistory *REST
+}
+
+type REST struct {
+	*genericregistry.Store
+}
+
+func NewStorage(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter) (IPAddressHistoryStorage, error) {
+	strategy := NewStrategy(scheme)
+
+	store := &genericregistry.Store{
+		NewFunc: func() runtime.Object {
+			return &core.IPAddressHistory{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &core.IPAddressHistoryList{}
+		},
+		PredicateFunc:             MatchIPAddressHistory,
+		DefaultQualifiedResource:  core.Resource("ipaddresshistories"),
+		SingularQualifiedResource: core.Resource("ipaddresshistory"),
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return IPAddressHistoryStorage{}, err
+	}
+
+	return IPAddressHistoryStorage{
+		IPAddressHistory: &REST{store},
+	}, nil
+}
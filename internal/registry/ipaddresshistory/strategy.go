@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddresshistory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	ipAddressHistory, ok := obj.(*core.IPAddressHistory)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a IPAddressHistory")
+	}
+	return ipAddressHistory.Labels, SelectableFields(ipAddressHistory), nil
+}
+
+func MatchIPAddressHistory(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(ipAddressHistory *core.IPAddressHistory) fields.Set {
+	return generic.ObjectMetaFieldsSet(&ipAddressHistory.ObjectMeta, true)
+}
+
+type ipAddressHistoryStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+func NewStrategy(typer runtime.ObjectTyper) ipAddressHistoryStrategy {
+	return ipAddressHistoryStrategy{typer, names.SimpleNameGenerator}
+}
+
+func (ipAddressHistoryStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (ipAddressHistoryStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (ipAddressHistoryStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+func (ipAddressHistoryStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	ipAddressHistory := obj.(*core.IPAddressHistory)
+	return validation.ValidateIPAddressHistory(ipAddressHistory)
+}
+
+func (ipAddressHistoryStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (ipAddressHistoryStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (ipAddressHistoryStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (ipAddressHistoryStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (ipAddressHistoryStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newIPAddressHistory := obj.(*core.IPAddressHistory)
+	oldIPAddressHistory := old.(*core.IPAddressHistory)
+	return validation.ValidateIPAddressHistoryUpdate(newIPAddressHistory, oldIPAddressHistory)
+}
+
+func (ipAddressHistoryStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddresshistory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Leases", Type: "integer", Description: "The number of recorded leases"},
+		{Name: "Holder", Type: "string", Description: "The claiming entity currently holding the address"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func formatHolder(leases []core.IPAddressLease) string {
+	if len(leases) == 0 {
+		return "<none>"
+	}
+	lease := leases[len(leases)-1]
+	if lease.ReleasedTime != nil {
+		return "<none>"
+	}
+
+	claimRef := lease.ClaimRef
+	gr := schema.GroupResource{Group: claimRef.Group, Resource: claimRef.Resource}
+	ref := klog.KRef(claimRef.Namespace, claimRef.Name)
+	return fmt.Sprintf("%s %s", gr, ref)
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		ipAddressHistory := obj.(*core.IPAddressHistory)
+
+		cells = append(cells, name)
+		cells = append(cells, len(ipAddressHistory.Spec.Leases))
+		cells = append(cells, formatHolder(ipAddressHistory.Spec.Leases))
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
@@ -76,6 +76,12 @@ func NewAllocators(
 	}
 }
 
+// SupportsIPFamily reports whether the allocators can allocate IPs of the given family.
+func (a *Allocators) SupportsIPFamily(ipFamily corev1.IPFamily) bool {
+	alloc, ok := a.allocByFamily[ipFamily]
+	return ok && alloc.HasPrefixes()
+}
+
 func (a *Allocators) requesterAndClaimerForObject(obj client.Object) (Requester, *Claimer, error) {
 	requester, err := a.requesterFor(obj)
 	if err != nil {
@@ -123,7 +129,15 @@ func (a *Allocators) releaseIPs(
 		errs     []error
 	)
 	for _, ip := range ips {
-		alloc := allocByIPFamily[core.IPFamilyForAddr(ip)]
+		if !ip.IsValid() {
+			continue
+		}
+
+		alloc, ok := allocByIPFamily[core.IPFamilyForAddr(ip)]
+		if !ok {
+			errs = append(errs, fmt.Errorf("no allocator for IP %s", ip))
+			continue
+		}
 		if err := alloc.Release(ctx, claimedBy, ip); err != nil {
 			errs = append(errs, err)
 			continue
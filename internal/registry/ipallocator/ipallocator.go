@@ -16,6 +16,7 @@ import (
 	v1alpha1informers "github.com/ironcore-dev/ironcore-net/client-go/informers/externalversions/core/v1alpha1"
 	v1alpha1client "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/typed/core/v1alpha1"
 	v1alpha1listers "github.com/ironcore-dev/ironcore-net/client-go/listers/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/ip/ipaddressallocator"
 	"github.com/ironcore-dev/ironcore/utils/generic"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,8 +42,8 @@ var (
 type Allocator struct {
 	scheme *runtime.Scheme
 
-	prefixes []netip.Prefix
-	ipFamily corev1.IPFamily
+	ipFamily           corev1.IPFamily
+	ipAddressAllocator ipaddressallocator.Interface
 
 	client   v1alpha1client.CoreV1alpha1Interface
 	ipLister v1alpha1listers.IPLister
@@ -51,34 +52,17 @@ type Allocator struct {
 
 func New(
 	scheme *runtime.Scheme,
-	prefixes []netip.Prefix,
+	ipAddressAllocator ipaddressallocator.Interface,
 	client v1alpha1client.CoreV1alpha1Interface,
 	informer v1alpha1informers.IPInformer,
 ) (*Allocator, error) {
-	if len(prefixes) == 0 {
-		return nil, fmt.Errorf("at least one prefix must be provided")
-	}
-
-	var ipFamily corev1.IPFamily
-	if prefixes[0].Addr().Is6() {
-		ipFamily = corev1.IPv6Protocol
-	} else {
-		ipFamily = corev1.IPv4Protocol
-	}
-
-	for _, prefix := range prefixes {
-		if prefix.Addr().Is6() != (ipFamily == corev1.IPv6Protocol) {
-			return nil, fmt.Errorf("all prefixes must be of the same IP family")
-		}
-	}
-
 	return &Allocator{
-		scheme:   scheme,
-		prefixes: prefixes,
-		ipFamily: ipFamily,
-		client:   client,
-		ipLister: informer.Lister(),
-		ipSynced: informer.Informer().HasSynced,
+		scheme:             scheme,
+		ipFamily:           ipAddressAllocator.IPFamily(),
+		ipAddressAllocator: ipAddressAllocator,
+		client:             client,
+		ipLister:           informer.Lister(),
+		ipSynced:           informer.Informer().HasSynced,
 	}, nil
 }
 
@@ -86,6 +70,11 @@ func (a *Allocator) IPFamily() corev1.IPFamily {
 	return a.ipFamily
 }
 
+// HasPrefixes reports whether there are any public prefixes to allocate IPs from.
+func (a *Allocator) HasPrefixes() bool {
+	return a.ipAddressAllocator.HasPrefixes()
+}
+
 func (a *Allocator) Allocate(ctx context.Context, claimer *Claimer, ip netip.Addr) error {
 	return a.allocate(ctx, claimer, ip, false)
 }
@@ -231,7 +220,10 @@ func (a *Allocator) allocateNext(
 		return netip.Addr{}, fmt.Errorf("allocator not ready")
 	}
 	if dryRun {
-		return a.prefixes[0].Masked().Addr(), nil
+		return a.ipAddressAllocator.DryRun().AllocateNext(ctx, v1alpha1.IPAddressClaimRef{}, ipaddressallocator.PoolSelection{
+			Type:      v1alpha1.IPTypePublic,
+			Namespace: claimer.Object.GetNamespace(),
+		})
 	}
 
 	trace := utiltrace.New("allocate dynamic IP")
@@ -377,6 +369,10 @@ func (dry dryRunAllocator) IPFamily() corev1.IPFamily {
 	return dry.real.IPFamily()
 }
 
+func (dry dryRunAllocator) HasPrefixes() bool {
+	return dry.real.HasPrefixes()
+}
+
 func (dry dryRunAllocator) Allocate(ctx context.Context, claimer *Claimer, ip netip.Addr) error {
 	return dry.real.allocate(ctx, claimer, ip, true)
 }
@@ -408,6 +404,7 @@ type Claimer struct {
 
 type Interface interface {
 	IPFamily() corev1.IPFamily
+	HasPrefixes() bool
 	Allocate(ctx context.Context, claimer *Claimer, ip netip.Addr) error
 	AllocateNext(ctx context.Context, claimer *Claimer) (netip.Addr, error)
 	Release(ctx context.Context, claimer *Claimer, ip netip.Addr) error
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ippool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	ipPool, ok := obj.(*core.IPPool)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a IPPool")
+	}
+	return ipPool.Labels, SelectableFields(ipPool), nil
+}
+
+func MatchIPPool(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(ipPool *core.IPPool) fields.Set {
+	return generic.ObjectMetaFieldsSet(&ipPool.ObjectMeta, true)
+}
+
+type ipPoolStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+func NewStrategy(typer runtime.ObjectTyper) ipPoolStrategy {
+	return ipPoolStrategy{typer, names.SimpleNameGenerator}
+}
+
+func (ipPoolStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (ipPoolStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (ipPoolStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+func (ipPoolStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	ipPool := obj.(*core.IPPool)
+	return validation.ValidateIPPool(ipPool)
+}
+
+func (ipPoolStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (ipPoolStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (ipPoolStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (ipPoolStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (ipPoolStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newIPPool := obj.(*core.IPPool)
+	oldIPPool := old.(*core.IPPool)
+	return validation.ValidateIPPoolUpdate(newIPPool, oldIPPool)
+}
+
+func (ipPoolStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type ipPoolStatusStrategy struct {
+	ipPoolStrategy
+}
+
+func NewStatusStrategy(typer runtime.ObjectTyper) ipPoolStatusStrategy {
+	return ipPoolStatusStrategy{NewStrategy(typer)}
+}
+
+func (ipPoolStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"apinet.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (ipPoolStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newIPPool := obj.(*core.IPPool)
+	oldIPPool := old.(*core.IPPool)
+	newIPPool.Spec = oldIPPool.Spec
+}
+
+func (ipPoolStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newIPPool := obj.(*core.IPPool)
+	oldIPPool := old.(*core.IPPool)
+	return validation.ValidateIPPoolStatusUpdate(newIPPool, oldIPPool)
+}
+
+func (ipPoolStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
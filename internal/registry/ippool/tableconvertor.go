@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ippool
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	utilstrings "github.com/ironcore-dev/ironcore-net/utils/strings"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Type", Type: "string", Description: "The type of IPs allocated from the pool"},
+		{Name: "IPFamily", Type: "string", Description: "The IP family of the pool"},
+		{Name: "Prefixes", Type: "string", Description: "The prefixes of the pool"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func formatPrefixes(prefixes []net.IPPrefix) string {
+	j := utilstrings.NewJoiner(",")
+	for _, p := range prefixes {
+		j.Add(p.String())
+	}
+	return j.String()
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		ipPool := obj.(*core.IPPool)
+
+		cells = append(cells, name)
+		cells = append(cells, ipPool.Spec.Type)
+		cells = append(cells, ipPool.Spec.IPFamily)
+		cells = append(cells, formatPrefixes(ipPool.Spec.Prefixes))
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
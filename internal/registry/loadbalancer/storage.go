@@ -12,6 +12,7 @@ import (
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/ipallocator"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/network/networkipallocator"
 	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
 
 	corev1 "k8s.io/api/core/v1"
@@ -59,18 +60,73 @@ type LoadBalancerStorage struct {
 
 type REST struct {
 	*genericregistry.Store
-	allocators *ipallocator.Allocators
+	allocators         *ipallocator.Allocators
+	networkIPAllocator *networkipallocator.Allocator
+}
+
+func (r *REST) allocateInternal(ctx context.Context, loadBalancer, oldLoadBalancer *core.LoadBalancer, dryRun bool) (genericregistry.FinishFunc, error) {
+	txn, err := r.networkIPAllocator.AllocateLoadBalancer(ctx, loadBalancer, oldLoadBalancer, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, success bool) {
+		if success {
+			txn.Commit()
+		} else {
+			txn.Revert()
+		}
+	}, nil
+}
+
+// retainInternalLoadBalancerIPs keeps the IPs of the old load balancer for IPs of the new load balancer
+// that have the same name and IP family but don't specify an IP.
+func retainInternalLoadBalancerIPs(newLoadBalancer, oldLoadBalancer *core.LoadBalancer) {
+	oldIPByName := make(map[string]core.LoadBalancerIP)
+	for _, ip := range oldLoadBalancer.Spec.IPs {
+		oldIPByName[ip.Name] = ip
+	}
+
+	for i := range newLoadBalancer.Spec.IPs {
+		ip := &newLoadBalancer.Spec.IPs[i]
+		if ip.IP.IsValid() {
+			continue
+		}
+
+		if oldIP, ok := oldIPByName[ip.Name]; ok && oldIP.IPFamily == ip.IPFamily {
+			ip.IP = oldIP.IP
+		}
+	}
+}
+
+// supportsIPFamily reports whether IPs of the given IP family can be allocated for the load balancer.
+// Public IPs are allocated from the public IP pools, internal ones from the prefixes of the network.
+func (r *REST) supportsIPFamily(ctx context.Context, loadBalancer *core.LoadBalancer, ipFamily corev1.IPFamily) bool {
+	if loadBalancer.Spec.Type == core.LoadBalancerTypePublic {
+		return r.allocators.SupportsIPFamily(ipFamily)
+	}
+	return r.networkIPAllocator.SupportsIPFamily(ctx, loadBalancer.Namespace, loadBalancer.Spec.NetworkRef.Name, ipFamily)
+}
+
+// addIPsToAllocate adds the IPs of all IP families the load balancer will have but does not have IPs of yet.
+// The strategy only defaults the IP families after the begin hooks ran, but the IPs have to be
+// allocated in the begin hooks already so that they can be released if persisting the load balancer fails.
+func (r *REST) addIPsToAllocate(ctx context.Context, loadBalancer *core.LoadBalancer) {
+	_, ipFamilies := loadBalancerIPFamilies(loadBalancer, func(ipFamily corev1.IPFamily) bool {
+		return r.supportsIPFamily(ctx, loadBalancer, ipFamily)
+	})
+	addLoadBalancerIPsForIPFamilies(loadBalancer, ipFamilies)
 }
 
 func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1.CreateOptions) (genericregistry.FinishFunc, error) {
 	loadBalancer := obj.(*core.LoadBalancer)
+	r.addIPsToAllocate(ctx, loadBalancer)
 
+	dryRun := dryrun.IsDryRun(opts.DryRun)
 	if loadBalancer.Spec.Type != core.LoadBalancerTypePublic {
-		return func(ctx context.Context, success bool) {}, nil
+		return r.allocateInternal(ctx, loadBalancer, nil, dryRun)
 	}
 
-	dryRun := dryrun.IsDryRun(opts.DryRun)
-
 	txn, err := r.allocators.AllocateCreate(ctx, loadBalancer, dryRun)
 	if err != nil {
 		return nil, err
@@ -88,12 +144,13 @@ func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1
 func (r *REST) beginUpdate(ctx context.Context, obj, oldObj runtime.Object, opts *metav1.UpdateOptions) (genericregistry.FinishFunc, error) {
 	newLoadBalancer := obj.(*core.LoadBalancer)
 	oldLoadBalancer := oldObj.(*core.LoadBalancer)
+	r.addIPsToAllocate(ctx, newLoadBalancer)
 
+	dryRun := dryrun.IsDryRun(opts.DryRun)
 	if newLoadBalancer.Spec.Type != core.LoadBalancerTypePublic {
-		return func(ctx context.Context, success bool) {}, nil
+		retainInternalLoadBalancerIPs(newLoadBalancer, oldLoadBalancer)
+		return r.allocateInternal(ctx, newLoadBalancer, oldLoadBalancer, dryRun)
 	}
-
-	dryRun := dryrun.IsDryRun(opts.DryRun)
 	txn, err := r.allocators.AllocateUpdate(ctx, newLoadBalancer, oldLoadBalancer, dryRun)
 	if err != nil {
 		return nil, err
@@ -112,11 +169,12 @@ func (r *REST) afterDelete(obj runtime.Object, opts *metav1.DeleteOptions) {
 	ctx := context.TODO()
 	loadBalancer := obj.(*core.LoadBalancer)
 
+	dryRun := dryrun.IsDryRun(opts.DryRun)
 	if loadBalancer.Spec.Type != core.LoadBalancerTypePublic {
+		r.networkIPAllocator.ReleaseLoadBalancer(ctx, loadBalancer, dryRun)
 		return
 	}
 
-	dryRun := dryrun.IsDryRun(opts.DryRun)
 	r.allocators.Release(ctx, loadBalancer, dryRun)
 }
 
@@ -124,8 +182,20 @@ func NewStorage(
 	scheme *runtime.Scheme,
 	optsGetter generic.RESTOptionsGetter,
 	allocatorByFamily map[corev1.IPFamily]ipallocator.Interface,
+	networkIPAllocator *networkipallocator.Allocator,
 ) (LoadBalancerStorage, error) {
-	strategy := NewStrategy(scheme)
+	genericStore := &REST{
+		allocators: ipallocator.NewAllocators(
+			allocatorByFamily,
+			v1alpha1.SchemeGroupVersion,
+			"LoadBalancer",
+			"loadbalancers",
+			GetLoadBalancerIPAllocatorAccessor,
+		),
+		networkIPAllocator: networkIPAllocator,
+	}
+
+	strategy := NewStrategy(scheme, genericStore.supportsIPFamily)
 	statusStrategy := NewStatusStrategy(scheme)
 
 	store := &genericregistry.Store{
@@ -151,16 +221,7 @@ func NewStorage(
 		return LoadBalancerStorage{}, err
 	}
 
-	genericStore := &REST{
-		Store: store,
-		allocators: ipallocator.NewAllocators(
-			allocatorByFamily,
-			v1alpha1.SchemeGroupVersion,
-			"LoadBalancer",
-			"loadbalancers",
-			GetLoadBalancerIPAllocatorAccessor,
-		),
-	}
+	genericStore.Store = store
 	store.BeginCreate = genericStore.beginCreate
 	store.BeginUpdate = genericStore.beginUpdate
 	store.AfterDelete = genericStore.afterDelete
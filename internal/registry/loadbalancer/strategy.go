@@ -6,9 +6,13 @@ package loadbalancer
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	utilscore "github.com/ironcore-dev/ironcore-net/utils/core"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,23 +43,96 @@ func SelectableFields(loadBalancer *core.LoadBalancer) fields.Set {
 	return generic.ObjectMetaFieldsSet(&loadBalancer.ObjectMeta, true)
 }
 
+// loadBalancerIPFamilies returns the defaulted IP family policy and IP families of the load balancer.
+// For dual-stack policies, the secondary IP family is added if missing. PreferDualStack only adds it if
+// supportsIPFamily reports it as supported.
+func loadBalancerIPFamilies(loadBalancer *core.LoadBalancer, supportsIPFamily func(corev1.IPFamily) bool) (corev1.IPFamilyPolicy, []corev1.IPFamily) {
+	spec := &loadBalancer.Spec
+
+	ipFamilies := slices.Clone(spec.IPFamilies)
+	if len(ipFamilies) == 0 {
+		ipFamilies = core.GetLoadBalancerIPFamilies(loadBalancer)
+	}
+	if len(ipFamilies) == 0 && spec.Type == core.LoadBalancerTypePublic {
+		ipFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+	}
+
+	ipFamilyPolicy := corev1.IPFamilyPolicySingleStack
+	switch {
+	case spec.IPFamilyPolicy != nil:
+		ipFamilyPolicy = *spec.IPFamilyPolicy
+	case len(ipFamilies) > 1:
+		ipFamilyPolicy = corev1.IPFamilyPolicyRequireDualStack
+	}
+
+	if len(ipFamilies) == 1 {
+		secondaryIPFamily := utilscore.OtherIPFamily(ipFamilies[0])
+		switch ipFamilyPolicy {
+		case corev1.IPFamilyPolicyRequireDualStack:
+			ipFamilies = append(ipFamilies, secondaryIPFamily)
+		case corev1.IPFamilyPolicyPreferDualStack:
+			if supportsIPFamily(secondaryIPFamily) {
+				ipFamilies = append(ipFamilies, secondaryIPFamily)
+			}
+		}
+	}
+	return ipFamilyPolicy, ipFamilies
+}
+
+// addLoadBalancerIPsForIPFamilies adds an IP to allocate for every given IP family
+// that is not covered by the IPs of the load balancer yet.
+func addLoadBalancerIPsForIPFamilies(loadBalancer *core.LoadBalancer, ipFamilies []corev1.IPFamily) {
+	coveredIPFamilies := core.GetLoadBalancerIPFamilies(loadBalancer)
+	for _, ipFamily := range ipFamilies {
+		if slices.Contains(coveredIPFamilies, ipFamily) {
+			continue
+		}
+
+		loadBalancer.Spec.IPs = append(loadBalancer.Spec.IPs, core.LoadBalancerIP{
+			Name:     strings.ToLower(string(ipFamily)),
+			IPFamily: ipFamily,
+		})
+	}
+}
+
+// PrepareLoadBalancerIPFamilies defaults the IP family policy and the IP families of the load balancer
+// and adds an IP to allocate for every IP family that is not covered by the IPs of the load balancer yet.
+func PrepareLoadBalancerIPFamilies(loadBalancer *core.LoadBalancer, supportsIPFamily func(corev1.IPFamily) bool) {
+	ipFamilyPolicy, ipFamilies := loadBalancerIPFamilies(loadBalancer, supportsIPFamily)
+	loadBalancer.Spec.IPFamilyPolicy = &ipFamilyPolicy
+	loadBalancer.Spec.IPFamilies = ipFamilies
+	addLoadBalancerIPsForIPFamilies(loadBalancer, ipFamilies)
+}
+
+// IPFamilySupportFunc reports whether IPs of the given IP family can be allocated for the load balancer.
+type IPFamilySupportFunc func(ctx context.Context, loadBalancer *core.LoadBalancer, ipFamily corev1.IPFamily) bool
+
 type loadBalancerStrategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
+	supportsIPFamily IPFamilySupportFunc
 }
 
-func NewStrategy(typer runtime.ObjectTyper) loadBalancerStrategy {
-	return loadBalancerStrategy{typer, names.SimpleNameGenerator}
+func NewStrategy(typer runtime.ObjectTyper, supportsIPFamily IPFamilySupportFunc) loadBalancerStrategy {
+	return loadBalancerStrategy{typer, names.SimpleNameGenerator, supportsIPFamily}
 }
 
 func (loadBalancerStrategy) NamespaceScoped() bool {
 	return true
 }
 
-func (loadBalancerStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+func (s loadBalancerStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	loadBalancer := obj.(*core.LoadBalancer)
+	PrepareLoadBalancerIPFamilies(loadBalancer, func(ipFamily corev1.IPFamily) bool {
+		return s.supportsIPFamily(ctx, loadBalancer, ipFamily)
+	})
 }
 
-func (loadBalancerStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+func (s loadBalancerStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newLoadBalancer := obj.(*core.LoadBalancer)
+	PrepareLoadBalancerIPFamilies(newLoadBalancer, func(ipFamily corev1.IPFamily) bool {
+		return s.supportsIPFamily(ctx, newLoadBalancer, ipFamily)
+	})
 }
 
 func (loadBalancerStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
@@ -93,7 +170,7 @@ type loadBalancerStatusStrategy struct {
 }
 
 func NewStatusStrategy(typer runtime.ObjectTyper) loadBalancerStatusStrategy {
-	return loadBalancerStatusStrategy{NewStrategy(typer)}
+	return loadBalancerStatusStrategy{NewStrategy(typer, nil)}
 }
 
 func (loadBalancerStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
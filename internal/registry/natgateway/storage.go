@@ -30,12 +30,25 @@ type natGatewayIPRequester struct {
 func (acc *natGatewayIPRequester) GetRequests() []ipallocator.Request {
 	return utilslices.Map(acc.Spec.IPs, func(ip core.NATGatewayIP) ipallocator.Request {
 		return ipallocator.Request{
-			IPFamily: acc.Spec.IPFamily,
+			IPFamily: acc.ipFamily(&ip),
 			Addr:     ip.IP.Addr,
 		}
 	})
 }
 
+// ipFamily returns the IP family of the given IP. NAT gateways created before
+// IP families were defaulted per IP don't specify it.
+func (acc *natGatewayIPRequester) ipFamily(ip *core.NATGatewayIP) corev1.IPFamily {
+	switch {
+	case ip.IPFamily != "":
+		return ip.IPFamily
+	case ip.IP.IsValid():
+		return ip.IP.Family()
+	default:
+		return acc.Spec.IPFamily
+	}
+}
+
 func (acc *natGatewayIPRequester) SetIP(idx int, addr netip.Addr) {
 	acc.Spec.IPs[idx].IP = net.IP{Addr: addr}
 }
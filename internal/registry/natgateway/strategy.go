@@ -9,6 +9,7 @@ import (
 
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,6 +40,32 @@ func SelectableFields(natGateway *core.NATGateway) fields.Set {
 	return generic.ObjectMetaFieldsSet(&natGateway.ObjectMeta, true)
 }
 
+// PrepareNATGatewayIPFamilies defaults the primary IP family and the IP families of the NAT gateway
+// from each other and defaults the IP family of every NAT gateway IP.
+func PrepareNATGatewayIPFamilies(natGateway *core.NATGateway) {
+	spec := &natGateway.Spec
+
+	if len(spec.IPFamilies) == 0 && spec.IPFamily != "" {
+		spec.IPFamilies = []corev1.IPFamily{spec.IPFamily}
+	}
+	if spec.IPFamily == "" && len(spec.IPFamilies) > 0 {
+		spec.IPFamily = spec.IPFamilies[0]
+	}
+
+	for i := range spec.IPs {
+		ip := &spec.IPs[i]
+		if ip.IPFamily != "" {
+			continue
+		}
+
+		if ip.IP.IsValid() {
+			ip.IPFamily = ip.IP.Family()
+		} else {
+			ip.IPFamily = spec.IPFamily
+		}
+	}
+}
+
 type natGatewayStrategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
@@ -53,9 +80,13 @@ func (natGatewayStrategy) NamespaceScoped() bool {
 }
 
 func (natGatewayStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	natGateway := obj.(*core.NATGateway)
+	PrepareNATGatewayIPFamilies(natGateway)
 }
 
 func (natGatewayStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newNATGateway := obj.(*core.NATGateway)
+	PrepareNATGatewayIPFamilies(newNATGateway)
 }
 
 func (natGatewayStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
@@ -7,7 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
@@ -15,9 +15,13 @@ import (
 	v1alpha1client "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/typed/core/v1alpha1"
 	v1alpha1listers "github.com/ironcore-dev/ironcore-net/client-go/listers/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/allocationstrategy"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/bitmap"
 	"github.com/ironcore-dev/ironcore-net/networkid"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	utiltrace "k8s.io/utils/trace"
@@ -36,24 +40,150 @@ type Allocator struct {
 	client          v1alpha1client.CoreV1alpha1Interface
 	networkIDLister v1alpha1listers.NetworkIDLister
 	networkIDSynced cache.InformerSynced
+
+	strategy allocationstrategy.Strategy
+
+	// usedMu guards used.
+	usedMu sync.Mutex
+	// used tracks the used VNIs in memory, as offset from minVNI. It is built lazily from the
+	// informer cache, kept up to date by informer events and periodically repaired.
+	used *bitmap.Bitmap
 }
 
+const vniRangeKey = "vni"
+
 func NewNetworkIDAllocator(
 	client v1alpha1client.CoreV1alpha1Interface,
 	networkIDInformer v1alpha1informers.NetworkIDInformer,
 	minVNI, maxVNI int32,
+	strategy v1alpha1.AllocationStrategy,
 ) (*Allocator, error) {
 	if minVNI < 0 || maxVNI < 0 || minVNI > maxVNI || maxVNI == 0 || minVNI == maxVNI {
 		return nil, fmt.Errorf("invalid min / max vnis %d/%d", minVNI, maxVNI)
 	}
 
-	return &Allocator{
+	s, err := allocationstrategy.NewStrategies().Get(strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Allocator{
 		minVNI:          minVNI,
 		maxVNI:          maxVNI,
 		client:          client,
 		networkIDLister: networkIDInformer.Lister(),
 		networkIDSynced: networkIDInformer.Informer().HasSynced,
-	}, nil
+		strategy:        s,
+	}
+	if _, err := networkIDInformer.Informer().AddEventHandler(a.networkIDEventHandler()); err != nil {
+		return nil, fmt.Errorf("error adding network ID event handler: %w", err)
+	}
+	return a, nil
+}
+
+func (a *Allocator) size() int64 {
+	return int64(a.maxVNI) - int64(a.minVNI) + 1
+}
+
+func (a *Allocator) newBitmap(networkIDNames []string) *bitmap.Bitmap {
+	b := bitmap.New(a.size())
+	for _, name := range networkIDNames {
+		if vni, err := networkid.ParseVNI(name); err == nil {
+			b.Set(int64(vni) - int64(a.minVNI))
+		}
+	}
+	return b
+}
+
+// usedBitmap returns the bitmap of used VNIs, building it from the informer cache if it does not exist yet.
+func (a *Allocator) usedBitmap() (*bitmap.Bitmap, error) {
+	a.usedMu.Lock()
+	defer a.usedMu.Unlock()
+
+	if a.used != nil {
+		return a.used, nil
+	}
+
+	networkIDs, err := a.networkIDLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(networkIDs))
+	for i, networkID := range networkIDs {
+		names[i] = networkID.Name
+	}
+	a.used = a.newBitmap(names)
+	return a.used, nil
+}
+
+func (a *Allocator) markUsed(name string, used bool) {
+	a.usedMu.Lock()
+	defer a.usedMu.Unlock()
+
+	if a.used == nil {
+		return
+	}
+	vni, err := networkid.ParseVNI(name)
+	if err != nil {
+		return
+	}
+	if used {
+		a.used.Set(int64(vni) - int64(a.minVNI))
+	} else {
+		a.used.Clear(int64(vni) - int64(a.minVNI))
+	}
+}
+
+func (a *Allocator) networkIDEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if networkID, ok := obj.(*v1alpha1.NetworkID); ok {
+				a.markUsed(networkID.Name, true)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if networkID, ok := obj.(*v1alpha1.NetworkID); ok {
+				a.markUsed(networkID.Name, false)
+			}
+		},
+	}
+}
+
+// RunRepair periodically reconciles the in-memory bitmap with the stored network IDs until ctx is done.
+func (a *Allocator) RunRepair(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, a.repair, interval)
+}
+
+func (a *Allocator) repair(ctx context.Context) {
+	log := klog.FromContext(ctx)
+	if !a.networkIDSynced() {
+		log.V(1).Info("Allocator not ready, skipping repair")
+		return
+	}
+
+	networkIDList, err := a.client.NetworkIDs().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "Error listing network IDs for repair")
+		return
+	}
+	names := make([]string, len(networkIDList.Items))
+	for i, networkID := range networkIDList.Items {
+		names[i] = networkID.Name
+	}
+	repaired := a.newBitmap(names)
+
+	a.usedMu.Lock()
+	defer a.usedMu.Unlock()
+
+	if a.used != nil {
+		if leaked, missing := bitmap.Drift(a.used, repaired); leaked > 0 || missing > 0 {
+			log.Info("Repaired network ID bitmap", "Leaked", leaked, "Missing", missing)
+		}
+	}
+	a.used = repaired
 }
 
 func (a *Allocator) AllocateNetwork(network *core.Network, id string) error {
@@ -76,7 +206,13 @@ func (a *Allocator) allocateNetwork(network *core.Network, id string, dryRun boo
 	if dryRun {
 		return nil
 	}
-	return a.createNetworkID(networkid.EncodeVNI(vni), network)
+
+	name := networkid.EncodeVNI(vni)
+	if err := a.createNetworkID(name, network); err != nil {
+		return err
+	}
+	a.markUsed(name, true)
+	return nil
 }
 
 func (a *Allocator) AllocateNextNetwork(network *core.Network) (string, error) {
@@ -94,35 +230,47 @@ func (a *Allocator) allocateNextNetwork(network *core.Network, dryRun bool) (str
 	trace := utiltrace.New("allocate dynamic NetworkID ID")
 	defer trace.LogIfLong(500 * time.Millisecond)
 
-	start := randomVNIIteratorStart(a.minVNI, a.maxVNI)
-	iterator := vniIterator(a.minVNI, a.maxVNI, start)
-	return a.allocateFromRange(iterator, network)
+	claimKey := fmt.Sprintf("%s/%s", network.Namespace, network.Name)
+	start := a.strategy.Start(vniRangeKey, a.size(), claimKey)
+	offset, err := a.allocateFromRange(start, network)
+	if err != nil {
+		return "", err
+	}
+
+	a.strategy.Allocated(vniRangeKey, offset)
+	return networkid.EncodeVNI(a.minVNI + int32(offset)), nil
 }
 
-func (a *Allocator) allocateFromRange(iterator func() (int32, bool), network *core.Network) (string, error) {
+// allocateFromRange allocates the next unused VNI at or after the start offset and returns its offset.
+// Unused VNIs are looked up in the in-memory bitmap. The created NetworkID is the commit record of
+// the allocation: If it already exists, the bitmap was stale and the next VNI is tried.
+func (a *Allocator) allocateFromRange(start int64, network *core.Network) (int64, error) {
+	b, err := a.usedBitmap()
+	if err != nil {
+		return 0, fmt.Errorf("error getting used VNIs: %w", err)
+	}
+
 	for {
-		vni, ok := iterator()
+		offset, ok := b.NextClear(start, nil)
 		if !ok {
-			return "", ErrFull
-		}
-
-		name := networkid.EncodeVNI(vni)
-		_, err := a.networkIDLister.Get(name)
-		if err == nil {
-			continue
+			return 0, ErrFull
 		}
-		if !apierrors.IsNotFound(err) {
-			klog.InfoS("Unexpected error", "err", err)
+		if !b.Set(offset) {
+			// Concurrently allocated, try the next one.
 			continue
 		}
 
-		err = a.createNetworkID(name, network)
-		if err != nil {
-			klog.InfoS("Cannot create network ID", "name", name, "err", err)
-			continue
-		}
+		name := networkid.EncodeVNI(a.minVNI + int32(offset))
+		if err := a.createNetworkID(name, network); err != nil {
+			if errors.Is(err, ErrAllocated) {
+				klog.V(1).InfoS("Network ID already allocated, trying next", "name", name)
+				continue
+			}
 
-		return networkid.EncodeVNI(vni), nil
+			b.Clear(offset)
+			return 0, fmt.Errorf("error creating network ID %s: %w", name, err)
+		}
+		return offset, nil
 	}
 }
 
@@ -141,6 +289,7 @@ func (a *Allocator) release(id string, dryRun bool) error {
 	name := id
 	err := a.client.NetworkIDs().Delete(context.Background(), name, metav1.DeleteOptions{})
 	if err == nil {
+		a.markUsed(name, false)
 		return nil
 	}
 	klog.InfoS("error releasing ID", "name", name, "err", err)
@@ -161,37 +310,6 @@ func networkToRef(network *core.Network) v1alpha1.NetworkIDClaimRef {
 	}
 }
 
-func randomVNIIteratorStart(minVNI, maxVNI int32) int32 {
-	diff := maxVNI - minVNI
-	n := rand.Int31n(diff)
-	return minVNI + n
-}
-
-func vniIterator(minVNI, maxVNI, start int32) func() (int32, bool) {
-	var (
-		next = func(vni int32) int32 {
-			if vni == maxVNI {
-				return minVNI
-			}
-			return vni + 1
-		}
-		seen bool
-		vni  = start
-	)
-
-	return func() (int32, bool) {
-		value := vni
-		if value == start {
-			if seen {
-				return 0, false
-			}
-			seen = true
-		}
-		vni = next(vni)
-		return value, true
-	}
-}
-
 func (a *Allocator) createNetworkID(name string, network *core.Network) error {
 	networkID := v1alpha1.NetworkID{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,577 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkipallocator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	v1alpha1client "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/typed/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	utilcore "github.com/ironcore-dev/ironcore-net/utils/core"
+	"go4.org/netipx"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+var (
+	ErrFull = errors.New("all IPs of the network are allocated")
+)
+
+// Allocator allocates internal IPs of load balancers from the prefixes of their network and
+// guards internal load balancers and network interfaces of the same network against using the same IP.
+//
+// An IP of a network is claimed by creating the NetworkIPAddress named after the network and the IP.
+// As object names are unique, an IP claimed by an internal load balancer cannot be used by any other object
+// of the network, consistently across all apiserver replicas. Claims that could not be released are garbage
+// collected by the controller manager.
+type Allocator struct {
+	client v1alpha1client.CoreV1alpha1Interface
+}
+
+func New(client v1alpha1client.CoreV1alpha1Interface) *Allocator {
+	return &Allocator{
+		client: client,
+	}
+}
+
+// networkIP is an IP of a network.
+type networkIP struct {
+	networkName string
+	addr        netip.Addr
+}
+
+// ConflictError is returned if an IP of a network is claimed by another object.
+type ConflictError struct {
+	Addr     netip.Addr
+	ClaimRef v1alpha1.IPAddressClaimRef
+}
+
+func (e *ConflictError) Error() string {
+	switch e.ClaimRef.Resource {
+	case "loadbalancers":
+		return fmt.Sprintf("IP is used by internal load balancer %s", e.ClaimRef.Name)
+	case "networkinterfaces":
+		return fmt.Sprintf("IP is used by network interface %s", e.ClaimRef.Name)
+	default:
+		return fmt.Sprintf("IP is used by %s %s", e.ClaimRef.Resource, e.ClaimRef.Name)
+	}
+}
+
+func claimRefFor(resource string, obj metav1.Object) v1alpha1.IPAddressClaimRef {
+	return v1alpha1.IPAddressClaimRef{
+		Group:     v1alpha1.GroupName,
+		Resource:  resource,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		UID:       obj.GetUID(),
+	}
+}
+
+// Transaction finishes claiming the IPs of an object. It has to be committed once the object
+// has been persisted and reverted if persisting the object failed.
+type Transaction struct {
+	ctx       context.Context
+	alloc     *Allocator
+	namespace string
+	claimRef  v1alpha1.IPAddressClaimRef
+	dryRun    bool
+
+	// claimed are the IPs claimed by the transaction. They are released on revert.
+	claimed []networkIP
+	// unused are the IPs of the old object the object does not use anymore. They are released on commit.
+	unused []networkIP
+}
+
+func (a *Allocator) newTransaction(ctx context.Context, namespace string, claimRef v1alpha1.IPAddressClaimRef, dryRun bool) *Transaction {
+	return &Transaction{
+		ctx:       ctx,
+		alloc:     a,
+		namespace: namespace,
+		claimRef:  claimRef,
+		dryRun:    dryRun,
+	}
+}
+
+func (t *Transaction) Commit() {
+	t.alloc.release(t.ctx, t.namespace, t.claimRef, t.unused, t.dryRun)
+}
+
+func (t *Transaction) Revert() {
+	t.alloc.release(t.ctx, t.namespace, t.claimRef, t.claimed, t.dryRun)
+}
+
+func (t *Transaction) claim(ip networkIP) error {
+	claimed, err := t.alloc.claim(t.ctx, t.namespace, ip, t.claimRef, t.dryRun)
+	if err != nil {
+		return err
+	}
+	if claimed {
+		t.claimed = append(t.claimed, ip)
+	}
+	return nil
+}
+
+// claimNext claims the first IP of available that is not in used.
+// IPs found to be claimed concurrently are added to used and skipped.
+func (t *Transaction) claimNext(networkName string, available *netipx.IPSet, used sets.Set[netip.Addr]) (netip.Addr, error) {
+	for {
+		addr, err := nextFreeIP(available, used)
+		if err != nil {
+			return netip.Addr{}, err
+		}
+
+		used.Insert(addr)
+		if err := t.claim(networkIP{networkName: networkName, addr: addr}); err != nil {
+			var conflictErr *ConflictError
+			if errors.As(err, &conflictErr) {
+				continue
+			}
+			return netip.Addr{}, err
+		}
+		return addr, nil
+	}
+}
+
+// claim creates the network IP address of the IP for the claimer. It reports whether the network
+// IP address was created, i.e. whether it has to be released if the claimer is not persisted.
+// If the IP is claimed by another object, a *ConflictError is returned.
+// If dryRun is set, the claim is only checked, not recorded.
+func (a *Allocator) claim(ctx context.Context, namespace string, ip networkIP, claimRef v1alpha1.IPAddressClaimRef, dryRun bool) (bool, error) {
+	name := v1alpha1.NetworkIPAddressName(ip.networkName, ip.addr)
+
+	var created bool
+	err := retry.OnError(retry.DefaultRetry, apierrors.IsNotFound, func() error {
+		if !dryRun {
+			_, err := a.client.NetworkIPAddresses(namespace).Create(ctx, &v1alpha1.NetworkIPAddress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+					Labels: map[string]string{
+						v1alpha1.NetworkNameLabel: ip.networkName,
+					},
+				},
+				Spec: v1alpha1.NetworkIPAddressSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: ip.networkName},
+					IP:         net.IP{Addr: ip.addr},
+					ClaimRef:   claimRef,
+				},
+			}, metav1.CreateOptions{})
+			if err == nil {
+				created = true
+				return nil
+			}
+			if !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("error creating network IP address %s: %w", name, err)
+			}
+		}
+
+		networkIPAddress, err := a.client.NetworkIPAddresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) && dryRun {
+				return nil
+			}
+			// If the network IP address was released in the meantime, creating it is retried.
+			return err
+		}
+		if !v1alpha1.IsNetworkIPAddressClaimedBy(networkIPAddress, &metav1.ObjectMeta{UID: claimRef.UID}) &&
+			!isSharedNetworkInterfaceIP(networkIPAddress.Spec.ClaimRef, claimRef) {
+			return &ConflictError{Addr: ip.addr, ClaimRef: networkIPAddress.Spec.ClaimRef}
+		}
+		return nil
+	})
+	return created, err
+}
+
+// isSharedNetworkInterfaceIP reports whether both claimers are network interfaces. Network interfaces of a network
+// may share IPs, only load balancers require exclusive IPs. The network IP address is held by the network interface
+// that claimed the IP first. If it is released while other network interfaces still use the IP, it is re-created by
+// the repair.
+func isSharedNetworkInterfaceIP(claimRef, otherClaimRef v1alpha1.IPAddressClaimRef) bool {
+	return claimRef.Resource == "networkinterfaces" && otherClaimRef.Resource == "networkinterfaces"
+}
+
+// release deletes the network IP addresses of the IPs that are claimed by the claimer.
+func (a *Allocator) release(ctx context.Context, namespace string, claimRef v1alpha1.IPAddressClaimRef, ips []networkIP, dryRun bool) {
+	if dryRun || len(ips) == 0 {
+		return
+	}
+
+	log := klog.FromContext(ctx).WithValues("ClaimRef", claimRef)
+	for _, ip := range ips {
+		if err := a.releaseIP(ctx, namespace, claimRef.UID, ip); err != nil {
+			log.Error(err, "Error releasing network IP", "Network", ip.networkName, "IP", ip.addr)
+			continue
+		}
+		log.V(2).Info("Released network IP", "Network", ip.networkName, "IP", ip.addr)
+	}
+}
+
+func (a *Allocator) releaseIP(ctx context.Context, namespace string, uid types.UID, ip networkIP) error {
+	name := v1alpha1.NetworkIPAddressName(ip.networkName, ip.addr)
+	networkIPAddress, err := a.client.NetworkIPAddresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting network IP address %s: %w", name, err)
+	}
+	if networkIPAddress.Spec.ClaimRef.UID != uid {
+		return nil
+	}
+
+	if err := a.client.NetworkIPAddresses(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: metav1.NewUIDPreconditions(string(networkIPAddress.UID)),
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting network IP address %s: %w", name, err)
+	}
+	return nil
+}
+
+func loadBalancerNetworkIPs(loadBalancer *core.LoadBalancer) []networkIP {
+	if loadBalancer.Spec.Type != core.LoadBalancerTypeInternal {
+		return nil
+	}
+
+	var ips []networkIP
+	for _, ip := range loadBalancer.Spec.IPs {
+		if ip.IP.IsValid() {
+			ips = append(ips, networkIP{networkName: loadBalancer.Spec.NetworkRef.Name, addr: ip.IP.Addr})
+		}
+	}
+	return ips
+}
+
+func networkInterfaceNetworkIPs(nic *core.NetworkInterface) []networkIP {
+	ips := make([]networkIP, 0, len(nic.Spec.IPs))
+	for _, ip := range nic.Spec.IPs {
+		ips = append(ips, networkIP{networkName: nic.Spec.NetworkRef.Name, addr: ip.Addr})
+	}
+	return ips
+}
+
+// unusedIPs returns the old IPs that are not in the new IPs.
+func unusedIPs(newIPs, oldIPs []networkIP) []networkIP {
+	used := sets.New(newIPs...)
+	var unused []networkIP
+	for _, ip := range oldIPs {
+		if !used.Has(ip) {
+			unused = append(unused, ip)
+		}
+	}
+	return unused
+}
+
+// AllocateLoadBalancer claims the IPs of an internal load balancer. Every IP that specifies an IP family
+// but no IP is allocated from the prefixes of the network. If the network does not exist or has no prefixes
+// of the IP family, the IP is left unset. IPs that are specified must not be claimed by another internal load balancer or
+// network interface of the network.
+// The old load balancer is nil on create.
+func (a *Allocator) AllocateLoadBalancer(ctx context.Context, loadBalancer, oldLoadBalancer *core.LoadBalancer, dryRun bool) (*Transaction, error) {
+	ipsPath := field.NewPath("spec", "ips")
+	namespace := loadBalancer.Namespace
+	networkName := loadBalancer.Spec.NetworkRef.Name
+
+	log := klog.FromContext(ctx).WithValues(
+		"LoadBalancer", klog.KObj(loadBalancer),
+		"Network", klog.KRef(namespace, networkName),
+	)
+
+	var oldIPs []networkIP
+	if oldLoadBalancer != nil {
+		oldIPs = loadBalancerNetworkIPs(oldLoadBalancer)
+	}
+	oldIPSet := sets.New(oldIPs...)
+
+	txn := a.newTransaction(ctx, namespace, claimRefFor("loadbalancers", loadBalancer), dryRun)
+
+	var (
+		allErrs field.ErrorList
+		reqIdxs []int
+	)
+	for i, lbIP := range loadBalancer.Spec.IPs {
+		if !lbIP.IP.IsValid() {
+			reqIdxs = append(reqIdxs, i)
+			continue
+		}
+
+		ip := networkIP{networkName: networkName, addr: lbIP.IP.Addr}
+		if oldIPSet.Has(ip) {
+			continue
+		}
+		if err := txn.claim(ip); err != nil {
+			var conflictErr *ConflictError
+			if !errors.As(err, &conflictErr) {
+				txn.Revert()
+				return nil, err
+			}
+			allErrs = append(allErrs, field.Invalid(ipsPath.Index(i).Child("ip"), lbIP.IP, conflictErr.Error()))
+		}
+	}
+	if len(allErrs) > 0 {
+		txn.Revert()
+		return nil, apierrors.NewInvalid(core.Kind("LoadBalancer"), loadBalancer.Name, allErrs)
+	}
+
+	if len(reqIdxs) > 0 {
+		if err := a.allocateLoadBalancerIPs(ctx, txn, loadBalancer, reqIdxs); err != nil {
+			txn.Revert()
+			return nil, err
+		}
+		for _, idx := range reqIdxs {
+			lbIP := loadBalancer.Spec.IPs[idx]
+			if lbIP.IP.IsValid() {
+				log.V(2).Info("Allocated network IP", "Name", lbIP.Name, "Address", lbIP.IP)
+			}
+		}
+	}
+
+	txn.unused = unusedIPs(loadBalancerNetworkIPs(loadBalancer), oldIPs)
+	return txn, nil
+}
+
+func (a *Allocator) allocateLoadBalancerIPs(ctx context.Context, txn *Transaction, loadBalancer *core.LoadBalancer, reqIdxs []int) error {
+	namespace := loadBalancer.Namespace
+	networkName := loadBalancer.Spec.NetworkRef.Name
+
+	network, err := a.client.Networks(namespace).Get(ctx, networkName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting network %s: %w", networkName, err)
+		}
+		// Internal load balancers may be created before their network, their IPs are left unset.
+		return nil
+	}
+
+	// The claimed IPs are only a hint to skip IPs that are known to be in use,
+	// creating the network IP address decides whether an IP is free.
+	used, err := a.claimedIPs(ctx, namespace, networkName)
+	if err != nil {
+		return err
+	}
+	for _, ip := range loadBalancer.Spec.IPs {
+		if ip.IP.IsValid() {
+			used.Insert(ip.IP.Addr)
+		}
+	}
+
+	for _, idx := range reqIdxs {
+		lbIP := &loadBalancer.Spec.IPs[idx]
+		available := networkIPSet(network.Spec.Prefixes, lbIP.IPFamily)
+		if len(available.Ranges()) == 0 {
+			// Networks without an address space of the IP family leave the IP unset.
+			continue
+		}
+
+		addr, err := txn.claimNext(networkName, available, used)
+		if err != nil {
+			return fmt.Errorf("error allocating IP %s: %w", lbIP.Name, err)
+		}
+		lbIP.IP = net.IP{Addr: addr}
+	}
+	return nil
+}
+
+// AllocateNetworkInterface claims the IPs of the network interface. The IPs must not be claimed
+// by an internal load balancer of the network.
+// The old network interface is nil on create.
+func (a *Allocator) AllocateNetworkInterface(ctx context.Context, nic, oldNIC *core.NetworkInterface, dryRun bool) (*Transaction, error) {
+	var oldIPs []networkIP
+	if oldNIC != nil {
+		oldIPs = networkInterfaceNetworkIPs(oldNIC)
+	}
+	oldIPSet := sets.New(oldIPs...)
+
+	txn := a.newTransaction(ctx, nic.Namespace, claimRefFor("networkinterfaces", nic), dryRun)
+
+	var allErrs field.ErrorList
+	for i, ip := range nic.Spec.IPs {
+		nip := networkIP{networkName: nic.Spec.NetworkRef.Name, addr: ip.Addr}
+		if oldIPSet.Has(nip) {
+			continue
+		}
+		if err := txn.claim(nip); err != nil {
+			var conflictErr *ConflictError
+			if !errors.As(err, &conflictErr) {
+				txn.Revert()
+				return nil, err
+			}
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "ips").Index(i), ip, conflictErr.Error()))
+		}
+	}
+	if len(allErrs) > 0 {
+		txn.Revert()
+		return nil, apierrors.NewInvalid(core.Kind("NetworkInterface"), nic.Name, allErrs)
+	}
+
+	txn.unused = unusedIPs(networkInterfaceNetworkIPs(nic), oldIPs)
+	return txn, nil
+}
+
+// ReleaseLoadBalancer releases the IPs claimed by the internal load balancer.
+func (a *Allocator) ReleaseLoadBalancer(ctx context.Context, loadBalancer *core.LoadBalancer, dryRun bool) {
+	a.release(ctx, loadBalancer.Namespace, claimRefFor("loadbalancers", loadBalancer), loadBalancerNetworkIPs(loadBalancer), dryRun)
+}
+
+// ReleaseNetworkInterface releases the IPs claimed by the network interface.
+func (a *Allocator) ReleaseNetworkInterface(ctx context.Context, nic *core.NetworkInterface, dryRun bool) {
+	a.release(ctx, nic.Namespace, claimRefFor("networkinterfaces", nic), networkInterfaceNetworkIPs(nic), dryRun)
+}
+
+// claimedIPs returns the IPs of the network that are claimed by a network IP address.
+func (a *Allocator) claimedIPs(ctx context.Context, namespace, networkName string) (sets.Set[netip.Addr], error) {
+	networkIPAddressList, err := a.client.NetworkIPAddresses(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{v1alpha1.NetworkNameLabel: networkName}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing network IP addresses: %w", err)
+	}
+
+	used := sets.New[netip.Addr]()
+	for _, networkIPAddress := range networkIPAddressList.Items {
+		used.Insert(networkIPAddress.Spec.IP.Addr)
+	}
+	return used, nil
+}
+
+// SupportsIPFamily reports whether the network has prefixes of the given IP family.
+// If the network cannot be retrieved, the IP family is reported as unsupported.
+func (a *Allocator) SupportsIPFamily(ctx context.Context, namespace, networkName string, ipFamily corev1.IPFamily) bool {
+	network, err := a.client.Networks(namespace).Get(ctx, networkName, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(network.Spec.Prefixes, func(prefix net.IPPrefix) bool {
+		return utilcore.IPFamilyForAddr(prefix.Addr()) == ipFamily
+	})
+}
+
+// RunRepair periodically creates the missing network IP addresses of internal load balancers and
+// network interfaces until ctx is done, e.g. of objects created before their IPs were claimed.
+func (a *Allocator) RunRepair(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, a.repair, interval)
+}
+
+func (a *Allocator) repair(ctx context.Context) {
+	log := klog.FromContext(ctx)
+
+	networkIPAddressList, err := a.client.NetworkIPAddresses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "Error listing network IP addresses for repair")
+		return
+	}
+	existing := sets.New[types.NamespacedName]()
+	for _, networkIPAddress := range networkIPAddressList.Items {
+		existing.Insert(types.NamespacedName{Namespace: networkIPAddress.Namespace, Name: networkIPAddress.Name})
+	}
+
+	repairIPs := func(claimRef v1alpha1.IPAddressClaimRef, networkName string, addrs []netip.Addr) {
+		for _, addr := range addrs {
+			name := v1alpha1.NetworkIPAddressName(networkName, addr)
+			if existing.Has(types.NamespacedName{Namespace: claimRef.Namespace, Name: name}) {
+				continue
+			}
+
+			if _, err := a.claim(ctx, claimRef.Namespace, networkIP{networkName: networkName, addr: addr}, claimRef, false); err != nil {
+				log.Error(err, "Error repairing network IP", "ClaimRef", claimRef, "Network", networkName, "IP", addr)
+				continue
+			}
+			log.Info("Repaired network IP", "ClaimRef", claimRef, "Network", networkName, "IP", addr)
+		}
+	}
+
+	nicList, err := a.client.NetworkInterfaces(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "Error listing network interfaces for repair")
+		return
+	}
+	for i := range nicList.Items {
+		nic := &nicList.Items[i]
+		addrs := make([]netip.Addr, 0, len(nic.Spec.IPs))
+		for _, ip := range nic.Spec.IPs {
+			addrs = append(addrs, ip.Addr)
+		}
+		repairIPs(claimRefFor("networkinterfaces", nic), nic.Spec.NetworkRef.Name, addrs)
+	}
+
+	loadBalancerList, err := a.client.LoadBalancers(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "Error listing load balancers for repair")
+		return
+	}
+	for i := range loadBalancerList.Items {
+		loadBalancer := &loadBalancerList.Items[i]
+		if loadBalancer.Spec.Type != v1alpha1.LoadBalancerTypeInternal {
+			continue
+		}
+
+		var addrs []netip.Addr
+		for _, ip := range loadBalancer.Spec.IPs {
+			if ip.IP.IsValid() {
+				addrs = append(addrs, ip.IP.Addr)
+			}
+		}
+		repairIPs(claimRefFor("loadbalancers", loadBalancer), loadBalancer.Spec.NetworkRef.Name, addrs)
+	}
+}
+
+// networkIPSet returns the set of IPs of the given IP family that can be allocated from the prefixes.
+// The network address of a prefix is never allocated, neither is the broadcast address of an IPv4 prefix.
+// Prefixes too small to hold any other address (IPv4 /31 and /32, IPv6 /127 and /128) are used as a whole.
+func networkIPSet(prefixes []net.IPPrefix, ipFamily corev1.IPFamily) *netipx.IPSet {
+	var bldr netipx.IPSetBuilder
+	for _, prefix := range prefixes {
+		if utilcore.IPFamilyForAddr(prefix.Addr()) != ipFamily {
+			continue
+		}
+
+		bldr.AddPrefix(prefix.Prefix)
+		if prefix.Addr().BitLen()-prefix.Bits() < 2 {
+			continue
+		}
+
+		bldr.Remove(prefix.Masked().Addr())
+		if ipFamily == corev1.IPv4Protocol {
+			bldr.Remove(netipx.PrefixLastIP(prefix.Prefix))
+		}
+	}
+
+	// The builder only fails for invalid prefixes, which validation rejects.
+	set, _ := bldr.IPSet()
+	return set
+}
+
+func nextFreeIP(available *netipx.IPSet, used sets.Set[netip.Addr]) (netip.Addr, error) {
+	var bldr netipx.IPSetBuilder
+	bldr.AddSet(available)
+	for addr := range used {
+		bldr.Remove(addr)
+	}
+
+	free, err := bldr.IPSet()
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	ranges := free.Ranges()
+	if len(ranges) == 0 {
+		return netip.Addr{}, ErrFull
+	}
+	return ranges[0].From(), nil
+}
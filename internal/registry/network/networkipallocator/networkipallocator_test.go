@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkipallocator_test
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/fake"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/network/networkipallocator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("NetworkIPAllocator", func() {
+	var (
+		ctx    context.Context
+		client *fake.Clientset
+		alloc  *networkipallocator.Allocator
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		client = fake.NewSimpleClientset(&v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-network"},
+			Spec: v1alpha1.NetworkSpec{
+				Prefixes: []net.IPPrefix{net.MustParseIPPrefix("10.0.0.0/24")},
+			},
+		})
+		alloc = networkipallocator.New(client.CoreV1alpha1())
+	})
+
+	newNetworkInterface := func(name string, uid types.UID, ip string) *core.NetworkInterface {
+		return &core.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: uid},
+			Spec: core.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IPs:        []net.IP{net.MustParseIP(ip)},
+			},
+		}
+	}
+
+	newInternalLoadBalancer := func(name string, uid types.UID, ip net.IP) *core.LoadBalancer {
+		return &core.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: uid},
+			Spec: core.LoadBalancerSpec{
+				Type:       core.LoadBalancerTypeInternal,
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IPs: []core.LoadBalancerIP{
+					{Name: "ipv4", IPFamily: corev1.IPv4Protocol, IP: ip},
+				},
+			},
+		}
+	}
+
+	getClaimer := func(addr string) (types.UID, error) {
+		name := v1alpha1.NetworkIPAddressName("my-network", netip.MustParseAddr(addr))
+		networkIPAddress, err := client.CoreV1alpha1().NetworkIPAddresses("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return networkIPAddress.Spec.ClaimRef.UID, nil
+	}
+
+	It("should allocate IPs not claimed by other objects of the network", func() {
+		By("claiming the IP of a network interface")
+		nic := newNetworkInterface("nic", "nic-uid", "10.0.0.1")
+		txn, err := alloc.AllocateNetworkInterface(ctx, nic, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+		Expect(getClaimer("10.0.0.1")).To(Equal(types.UID("nic-uid")))
+
+		By("allocating an IP for an internal load balancer")
+		loadBalancer := newInternalLoadBalancer("lb", "lb-uid", net.IP{})
+		txn, err = alloc.AllocateLoadBalancer(ctx, loadBalancer, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+		Expect(loadBalancer.Spec.IPs).To(ConsistOf(HaveField("IP", net.MustParseIP("10.0.0.2"))))
+		Expect(getClaimer("10.0.0.2")).To(Equal(types.UID("lb-uid")))
+
+		By("allocating an IP for another internal load balancer that is not persisted")
+		otherLoadBalancer := newInternalLoadBalancer("other-lb", "other-lb-uid", net.IP{})
+		txn, err = alloc.AllocateLoadBalancer(ctx, otherLoadBalancer, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(otherLoadBalancer.Spec.IPs).To(ConsistOf(HaveField("IP", net.MustParseIP("10.0.0.3"))))
+		txn.Revert()
+		_, err = getClaimer("10.0.0.3")
+		Expect(err).To(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should reject IPs of internal load balancers used by other objects", func() {
+		By("claiming the IP of an internal load balancer")
+		loadBalancer := newInternalLoadBalancer("lb", "lb-uid", net.MustParseIP("10.0.0.10"))
+		txn, err := alloc.AllocateLoadBalancer(ctx, loadBalancer, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+
+		By("claiming the IP for a network interface")
+		_, err = alloc.AllocateNetworkInterface(ctx, newNetworkInterface("nic", "nic-uid", "10.0.0.10"), nil, false)
+		Expect(err).To(Satisfy(apierrors.IsInvalid))
+
+		By("claiming the IP for another internal load balancer")
+		_, err = alloc.AllocateLoadBalancer(ctx, newInternalLoadBalancer("other-lb", "other-lb-uid", net.MustParseIP("10.0.0.10")), nil, false)
+		Expect(err).To(Satisfy(apierrors.IsInvalid))
+
+		By("releasing the IP of the internal load balancer")
+		alloc.ReleaseLoadBalancer(ctx, loadBalancer, false)
+		_, err = getClaimer("10.0.0.10")
+		Expect(err).To(Satisfy(apierrors.IsNotFound))
+
+		By("claiming the released IP for a network interface")
+		txn, err = alloc.AllocateNetworkInterface(ctx, newNetworkInterface("nic", "nic-uid", "10.0.0.10"), nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+		Expect(getClaimer("10.0.0.10")).To(Equal(types.UID("nic-uid")))
+	})
+
+	It("should let network interfaces share IPs", func() {
+		By("claiming the IP of a network interface")
+		nic := newNetworkInterface("nic", "nic-uid", "10.0.0.1")
+		txn, err := alloc.AllocateNetworkInterface(ctx, nic, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+
+		By("claiming the same IP for another network interface")
+		otherNIC := newNetworkInterface("other-nic", "other-nic-uid", "10.0.0.1")
+		txn, err = alloc.AllocateNetworkInterface(ctx, otherNIC, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+
+		By("releasing the other network interface keeping the claim of the first one")
+		alloc.ReleaseNetworkInterface(ctx, otherNIC, false)
+		Expect(getClaimer("10.0.0.1")).To(Equal(types.UID("nic-uid")))
+	})
+
+	It("should release IPs not used anymore once an update is committed", func() {
+		nic := newNetworkInterface("nic", "nic-uid", "10.0.0.1")
+		txn, err := alloc.AllocateNetworkInterface(ctx, nic, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+
+		By("updating the IP of the network interface")
+		newNIC := newNetworkInterface("nic", "nic-uid", "10.0.0.2")
+		txn, err = alloc.AllocateNetworkInterface(ctx, newNIC, nic, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getClaimer("10.0.0.1")).To(Equal(types.UID("nic-uid")))
+		Expect(getClaimer("10.0.0.2")).To(Equal(types.UID("nic-uid")))
+
+		By("committing the update")
+		txn.Commit()
+		_, err = getClaimer("10.0.0.1")
+		Expect(err).To(Satisfy(apierrors.IsNotFound))
+		Expect(getClaimer("10.0.0.2")).To(Equal(types.UID("nic-uid")))
+	})
+
+	It("should not record claims on dry run", func() {
+		loadBalancer := newInternalLoadBalancer("lb", "lb-uid", net.IP{})
+		txn, err := alloc.AllocateLoadBalancer(ctx, loadBalancer, nil, true)
+		Expect(err).NotTo(HaveOccurred())
+		txn.Commit()
+		Expect(loadBalancer.Spec.IPs).To(ConsistOf(HaveField("IP", net.MustParseIP("10.0.0.1"))))
+		_, err = getClaimer("10.0.0.1")
+		Expect(err).To(Satisfy(apierrors.IsNotFound))
+	})
+})
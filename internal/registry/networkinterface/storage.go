@@ -12,6 +12,7 @@ import (
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
 	"github.com/ironcore-dev/ironcore-net/internal/registry/ipallocator"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/network/networkipallocator"
 	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +59,8 @@ type NetworkInterfaceStorage struct {
 
 type REST struct {
 	*genericregistry.Store
-	allocators *ipallocator.Allocators
+	allocators         *ipallocator.Allocators
+	networkIPAllocator *networkipallocator.Allocator
 }
 
 func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1.CreateOptions) (genericregistry.FinishFunc, error) {
@@ -66,15 +68,23 @@ func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1
 
 	dryRun := dryrun.IsDryRun(opts.DryRun)
 
+	networkIPTxn, err := r.networkIPAllocator.AllocateNetworkInterface(ctx, networkInterface, nil, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
 	txn, err := r.allocators.AllocateCreate(ctx, networkInterface, dryRun)
 	if err != nil {
+		networkIPTxn.Revert()
 		return nil, err
 	}
 
 	return func(ctx context.Context, success bool) {
 		if success {
+			networkIPTxn.Commit()
 			txn.Commit()
 		} else {
+			networkIPTxn.Revert()
 			txn.Revert()
 		}
 	}, nil
@@ -85,15 +95,24 @@ func (r *REST) beginUpdate(ctx context.Context, obj, oldObj runtime.Object, opts
 	oldNetworkInterface := oldObj.(*core.NetworkInterface)
 
 	dryRun := dryrun.IsDryRun(opts.DryRun)
+
+	networkIPTxn, err := r.networkIPAllocator.AllocateNetworkInterface(ctx, newNetworkInterface, oldNetworkInterface, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
 	txn, err := r.allocators.AllocateUpdate(ctx, newNetworkInterface, oldNetworkInterface, dryRun)
 	if err != nil {
+		networkIPTxn.Revert()
 		return nil, err
 	}
 
 	return func(ctx context.Context, success bool) {
 		if success {
+			networkIPTxn.Commit()
 			txn.Commit()
 		} else {
+			networkIPTxn.Revert()
 			txn.Revert()
 		}
 	}, nil
@@ -104,6 +123,7 @@ func (r *REST) afterDelete(obj runtime.Object, opts *metav1.DeleteOptions) {
 	networkInterface := obj.(*core.NetworkInterface)
 
 	dryRun := dryrun.IsDryRun(opts.DryRun)
+	r.networkIPAllocator.ReleaseNetworkInterface(ctx, networkInterface, dryRun)
 	r.allocators.Release(ctx, networkInterface, dryRun)
 }
 
@@ -111,6 +131,7 @@ func NewStorage(
 	scheme *runtime.Scheme,
 	optsGetter generic.RESTOptionsGetter,
 	allocatorByFamily map[corev1.IPFamily]ipallocator.Interface,
+	networkIPAllocator *networkipallocator.Allocator,
 ) (NetworkInterfaceStorage, error) {
 	strategy := NewStrategy(scheme)
 	statusStrategy := NewStatusStrategy(scheme)
@@ -147,6 +168,7 @@ func NewStorage(
 			"networkinterfaces",
 			GetNetworkInterfaceIPRequester,
 		),
+		networkIPAllocator: networkIPAllocator,
 	}
 	store.BeginCreate = genericStore.beginCreate
 	store.BeginUpdate = genericStore.beginUpdate
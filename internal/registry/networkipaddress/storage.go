@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkipaddress
+
+import (
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+)
+
+type NetworkIPAddressStorage struct {
+	NetworkIPAddress *REST
+}
+
+type REST struct {
+	*genericregistry.Store
+}
+
+func NewStorage(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter) (NetworkIPAddressStorage, error) {
+	strategy := NewStrategy(scheme)
+
+	store := &genericregistry.Store{
+		NewFunc: func() runtime.Object {
+			return &core.NetworkIPAddress{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &core.NetworkIPAddressList{}
+		},
+		PredicateFunc:             MatchNetworkIPAddress,
+		DefaultQualifiedResource:  core.Resource("networkipaddresses"),
+		SingularQualifiedResource: core.Resource("networkipaddress"),
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return NetworkIPAddressStorage{}, err
+	}
+
+	return NetworkIPAddressStorage{
+		NetworkIPAddress: &REST{store},
+	}, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkipaddress
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	networkIPAddress, ok := obj.(*core.NetworkIPAddress)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a NetworkIPAddress")
+	}
+	return networkIPAddress.Labels, SelectableFields(networkIPAddress), nil
+}
+
+func MatchNetworkIPAddress(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(networkIPAddress *core.NetworkIPAddress) fields.Set {
+	return generic.ObjectMetaFieldsSet(&networkIPAddress.ObjectMeta, true)
+}
+
+type networkIPAddressStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+func NewStrategy(typer runtime.ObjectTyper) networkIPAddressStrategy {
+	return networkIPAddressStrategy{typer, names.SimpleNameGenerator}
+}
+
+func (networkIPAddressStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (networkIPAddressStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (networkIPAddressStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+func (networkIPAddressStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	networkIPAddress := obj.(*core.NetworkIPAddress)
+	return validation.ValidateNetworkIPAddress(networkIPAddress)
+}
+
+func (networkIPAddressStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (networkIPAddressStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (networkIPAddressStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (networkIPAddressStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (networkIPAddressStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newNetworkIPAddress := obj.(*core.NetworkIPAddress)
+	oldNetworkIPAddress := old.(*core.NetworkIPAddress)
+	return validation.ValidateNetworkIPAddressUpdate(newNetworkIPAddress, oldNetworkIPAddress)
+}
+
+func (networkIPAddressStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkquota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	networkQuota, ok := obj.(*core.NetworkQuota)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a NetworkQuota")
+	}
+	return networkQuota.Labels, SelectableFields(networkQuota), nil
+}
+
+func MatchNetworkQuota(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(networkQuota *core.NetworkQuota) fields.Set {
+	return generic.ObjectMetaFieldsSet(&networkQuota.ObjectMeta, true)
+}
+
+type networkQuotaStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+func NewStrategy(typer runtime.ObjectTyper) networkQuotaStrategy {
+	return networkQuotaStrategy{typer, names.SimpleNameGenerator}
+}
+
+func (networkQuotaStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (networkQuotaStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	networkQuota := obj.(*core.NetworkQuota)
+	networkQuota.Status = core.NetworkQuotaStatus{}
+}
+
+func (networkQuotaStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newNetworkQuota := obj.(*core.NetworkQuota)
+	oldNetworkQuota := old.(*core.NetworkQuota)
+	newNetworkQuota.Status = oldNetworkQuota.Status
+}
+
+func (networkQuotaStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	networkQuota := obj.(*core.NetworkQuota)
+	return validation.ValidateNetworkQuota(networkQuota)
+}
+
+func (networkQuotaStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (networkQuotaStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (networkQuotaStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (networkQuotaStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (networkQuotaStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newNetworkQuota := obj.(*core.NetworkQuota)
+	oldNetworkQuota := old.(*core.NetworkQuota)
+	return validation.ValidateNetworkQuotaUpdate(newNetworkQuota, oldNetworkQuota)
+}
+
+func (networkQuotaStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type networkQuotaStatusStrategy struct {
+	networkQuotaStrategy
+}
+
+func NewStatusStrategy(typer runtime.ObjectTyper) networkQuotaStatusStrategy {
+	return networkQuotaStatusStrategy{NewStrategy(typer)}
+}
+
+func (networkQuotaStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"apinet.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (networkQuotaStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newNetworkQuota := obj.(*core.NetworkQuota)
+	oldNetworkQuota := old.(*core.NetworkQuota)
+	newNetworkQuota.Spec = oldNetworkQuota.Spec
+}
+
+func (networkQuotaStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newNetworkQuota := obj.(*core.NetworkQuota)
+	oldNetworkQuota := old.(*core.NetworkQuota)
+	return validation.ValidateNetworkQuotaStatusUpdate(newNetworkQuota, oldNetworkQuota)
+}
+
+func (networkQuotaStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
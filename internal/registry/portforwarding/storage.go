@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforwarding
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	v1alpha1client "github.com/ironcore-dev/ironcore-net/client-go/ironcorenet/versioned/typed/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/registry/ipallocator"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/util/dryrun"
+)
+
+type portForwardingIPRequester struct {
+	*core.PortForwarding
+}
+
+func (acc *portForwardingIPRequester) GetRequests() []ipallocator.Request {
+	return []ipallocator.Request{
+		{
+			IPFamily: acc.Spec.IPFamily,
+			Addr:     acc.Spec.IP.Addr,
+		},
+	}
+}
+
+func (acc *portForwardingIPRequester) SetIP(idx int, addr netip.Addr) {
+	acc.Spec.IP = net.IP{Addr: addr}
+}
+
+func GetPortForwardingIPAllocatorAccessor(obj runtime.Object) (ipallocator.Requester, error) {
+	portForwarding, ok := obj.(*core.PortForwarding)
+	if !ok {
+		return nil, fmt.Errorf("object %T is not a PortForwarding", obj)
+	}
+
+	return &portForwardingIPRequester{
+		portForwarding,
+	}, nil
+}
+
+type PortForwardingStorage struct {
+	PortForwarding *REST
+}
+
+type REST struct {
+	*genericregistry.Store
+	allocators *ipallocator.Allocators
+	client     v1alpha1client.CoreV1alpha1Interface
+}
+
+// checkIPNotShared rejects port forwardings using the IP of a load balancer or a NAT gateway.
+// Their data plane programs all ports of the IP, so a port forwarding has to own its IP.
+func (r *REST) checkIPNotShared(ctx context.Context, portForwarding *core.PortForwarding) error {
+	if !portForwarding.Spec.IP.IsValid() {
+		return nil
+	}
+
+	ipList, err := r.client.IPs(portForwarding.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.ip", portForwarding.Spec.IP.String()).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing IPs: %w", err)
+	}
+
+	for _, ip := range ipList.Items {
+		claimRef := ip.Spec.ClaimRef
+		if claimRef == nil || claimRef.Group != v1alpha1.SchemeGroupVersion.Group {
+			continue
+		}
+
+		switch claimRef.Resource {
+		case "loadbalancers", "natgateways":
+			return apierrors.NewInvalid(core.Kind("PortForwarding"), portForwarding.Name, field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "ip"),
+					fmt.Sprintf("IP %s is used by %s %s, port forwardings cannot share IPs", portForwarding.Spec.IP, claimRef.Resource, claimRef.Name)),
+			})
+		}
+	}
+	return nil
+}
+
+func (r *REST) beginCreate(ctx context.Context, obj runtime.Object, opts *metav1.CreateOptions) (genericregistry.FinishFunc, error) {
+	portForwarding := obj.(*core.PortForwarding)
+
+	dryRun := dryrun.IsDryRun(opts.DryRun)
+
+	if err := r.checkIPNotShared(ctx, portForwarding); err != nil {
+		return nil, err
+	}
+
+	txn, err := r.allocators.AllocateCreate(ctx, portForwarding, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, success bool) {
+		if success {
+			txn.Commit()
+		} else {
+			txn.Revert()
+		}
+	}, nil
+}
+
+func (r *REST) beginUpdate(ctx context.Context, obj, oldObj runtime.Object, opts *metav1.UpdateOptions) (genericregistry.FinishFunc, error) {
+	newPortForwarding := obj.(*core.PortForwarding)
+	oldPortForwarding := oldObj.(*core.PortForwarding)
+
+	dryRun := dryrun.IsDryRun(opts.DryRun)
+
+	txn, err := r.allocators.AllocateUpdate(ctx, newPortForwarding, oldPortForwarding, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, success bool) {
+		if success {
+			txn.Commit()
+		} else {
+			txn.Revert()
+		}
+	}, nil
+}
+
+func (r *REST) afterDelete(obj runtime.Object, opts *metav1.DeleteOptions) {
+	ctx := context.TODO()
+	portForwarding := obj.(*core.PortForwarding)
+
+	dryRun := dryrun.IsDryRun(opts.DryRun)
+	r.allocators.Release(ctx, portForwarding, dryRun)
+}
+
+func NewStorage(
+	scheme *runtime.Scheme,
+	optsGetter generic.RESTOptionsGetter,
+	allocByIPFamily map[corev1.IPFamily]ipallocator.Interface,
+	client v1alpha1client.CoreV1alpha1Interface,
+) (PortForwardingStorage, error) {
+	strategy := NewStrategy(scheme)
+
+	store := &genericregistry.Store{
+		NewFunc: func() runtime.Object {
+			return &core.PortForwarding{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &core.PortForwardingList{}
+		},
+		PredicateFunc:             MatchPortForwarding,
+		DefaultQualifiedResource:  core.Resource("portforwardings"),
+		SingularQualifiedResource: core.Resource("portforwarding"),
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return PortForwardingStorage{}, err
+	}
+
+	genericStore := &REST{
+		Store: store,
+		allocators: ipallocator.NewAllocators(
+			allocByIPFamily,
+			v1alpha1.SchemeGroupVersion,
+			"PortForwarding",
+			"portforwardings",
+			GetPortForwardingIPAllocatorAccessor,
+		),
+		client: client,
+	}
+	store.BeginCreate = genericStore.beginCreate
+	store.BeginUpdate = genericStore.beginUpdate
+	store.AfterDelete = genericStore.afterDelete
+
+	return PortForwardingStorage{
+		PortForwarding: genericStore,
+	}, nil
+}
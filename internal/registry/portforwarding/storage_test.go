@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforwarding
+
+import (
+	"net/netip"
+
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("IP allocator accessor", func() {
+	It("should set the allocated IP on the port forwarding", func() {
+		portForwarding := &core.PortForwarding{
+			Spec: core.PortForwardingSpec{IPFamily: corev1.IPv4Protocol},
+		}
+
+		requester, err := GetPortForwardingIPAllocatorAccessor(portForwarding)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requester.GetRequests()).To(ConsistOf(HaveField("Addr", netip.Addr{})))
+
+		requester.SetIP(0, netip.MustParseAddr("10.0.0.1"))
+		Expect(portForwarding.Spec.IP).To(Equal(net.MustParseIP("10.0.0.1")))
+	})
+})
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforwarding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	portForwarding, ok := obj.(*core.PortForwarding)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a PortForwarding")
+	}
+	return portForwarding.Labels, SelectableFields(portForwarding), nil
+}
+
+func MatchPortForwarding(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(portForwarding *core.PortForwarding) fields.Set {
+	return generic.ObjectMetaFieldsSet(&portForwarding.ObjectMeta, true)
+}
+
+type portForwardingStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+func NewStrategy(typer runtime.ObjectTyper) portForwardingStrategy {
+	return portForwardingStrategy{typer, names.SimpleNameGenerator}
+}
+
+func (portForwardingStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (portForwardingStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (portForwardingStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+func (portForwardingStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	portForwarding := obj.(*core.PortForwarding)
+	return validation.ValidatePortForwarding(portForwarding)
+}
+
+func (portForwardingStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (portForwardingStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (portForwardingStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (portForwardingStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (portForwardingStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newPortForwarding := obj.(*core.PortForwarding)
+	oldPortForwarding := old.(*core.PortForwarding)
+	return validation.ValidatePortForwardingUpdate(newPortForwarding, oldPortForwarding)
+}
+
+func (portForwardingStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforwarding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore-net/internal/apis/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "IP", Type: "string", Description: "The public IP of the port forwarding"},
+		{Name: "Ports", Type: "string", Description: "The public ports of the port forwarding"},
+		{Name: "Network Interface", Type: "string", Description: "The network interface the port forwarding forwards to"},
+		{Name: "Target", Type: "string", Description: "The IP and ports the port forwarding forwards to"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func formatPorts(protocol *corev1.Protocol, port, endPort int32) string {
+	p := corev1.ProtocolTCP
+	if protocol != nil {
+		p = *protocol
+	}
+	if port == endPort {
+		return fmt.Sprintf("%d/%s", port, p)
+	}
+	return fmt.Sprintf("%d-%d/%s", port, endPort, p)
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		portForwarding := obj.(*core.PortForwarding)
+
+		endPort := core.GetPortForwardingEndPort(portForwarding)
+		targetPort := core.GetPortForwardingTargetPort(portForwarding)
+		targetEndPort := targetPort + endPort - portForwarding.Spec.Port
+
+		cells = append(cells, name)
+		cells = append(cells, portForwarding.Spec.IP.String())
+		cells = append(cells, formatPorts(portForwarding.Spec.Protocol, portForwarding.Spec.Port, endPort))
+		cells = append(cells, portForwarding.Spec.NetworkInterfaceRef.Name)
+		cells = append(cells, fmt.Sprintf("%s:%s", portForwarding.Spec.TargetIP, formatPorts(portForwarding.Spec.Protocol, targetPort, targetEndPort)))
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
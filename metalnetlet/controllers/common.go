@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	metalnetv1alpha1 "github.com/ironcore-dev/metalnet/api/v1alpha1"
@@ -26,6 +27,14 @@ const (
 	PartitionFieldOwnerPrefix = "partition.metalnetlet.apinet.ironcore.dev/"
 
 	PartitionFinalizerPrefix = "partition.metalnetlet.apinet.ironcore.dev/"
+
+	// NATPortPressurePercent is the percentage of its NAT ports a network interface has to use
+	// to report NAT port pressure.
+	NATPortPressurePercent = 80
+
+	// DefaultNATPortUsageResyncInterval is the default interval the NAT port usage of a NATed
+	// network interface is re-checked in.
+	DefaultNATPortUsageResyncInterval = 30 * time.Second
 )
 
 func PartitionFieldOwner(partitionName string) client.FieldOwner {
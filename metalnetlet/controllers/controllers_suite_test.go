@@ -9,12 +9,15 @@ import (
 	"net/netip"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ironcore-dev/controller-utils/buildutils"
 	"github.com/ironcore-dev/controller-utils/modutils"
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	apinetcontrollers "github.com/ironcore-dev/ironcore-net/internal/controllers"
+	"github.com/ironcore-dev/ironcore-net/metalnetlet/natportusage"
 	utilsenvtest "github.com/ironcore-dev/ironcore/utils/envtest"
 	"github.com/ironcore-dev/ironcore/utils/envtest/apiserver"
 	. "github.com/ironcore-dev/ironcore/utils/testing"
@@ -25,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -165,6 +169,7 @@ func SetupTest(metalnetNs *corev1.Namespace) {
 
 		Expect((&NetworkInterfaceReconciler{
 			Client:            k8sManager.GetClient(),
+			APIReader:         k8sManager.GetAPIReader(),
 			MetalnetClient:    k8sManager.GetClient(),
 			PartitionName:     partitionName,
 			MetalnetNamespace: metalnetNs.Name,
@@ -178,6 +183,14 @@ func SetupTest(metalnetNs *corev1.Namespace) {
 			MetalnetNamespace: metalnetNs.Name,
 		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
 
+		Expect((&PortForwardingReconciler{
+			Client:            k8sManager.GetClient(),
+			MetalnetClient:    k8sManager.GetClient(),
+			MetalnetAPIReader: k8sManager.GetAPIReader(),
+			PartitionName:     partitionName,
+			MetalnetNamespace: metalnetNs.Name,
+		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
+
 		mgrCtx, cancel := context.WithCancel(context.Background())
 		DeferCleanup(cancel)
 		go func() {
@@ -215,6 +228,7 @@ func SetupTestWithNetworkPeeringDisabled(metalnetNs *corev1.Namespace) {
 
 		Expect((&NetworkInterfaceReconciler{
 			Client:            k8sManager.GetClient(),
+			APIReader:         k8sManager.GetAPIReader(),
 			MetalnetClient:    k8sManager.GetClient(),
 			PartitionName:     partitionName,
 			MetalnetNamespace: metalnetNs.Name,
@@ -228,6 +242,90 @@ func SetupTestWithNetworkPeeringDisabled(metalnetNs *corev1.Namespace) {
 			MetalnetNamespace: metalnetNs.Name,
 		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
 
+		Expect((&PortForwardingReconciler{
+			Client:            k8sManager.GetClient(),
+			MetalnetClient:    k8sManager.GetClient(),
+			MetalnetAPIReader: k8sManager.GetAPIReader(),
+			PartitionName:     partitionName,
+			MetalnetNamespace: metalnetNs.Name,
+		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
+
+		mgrCtx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		go func() {
+			defer GinkgoRecover()
+			Expect(k8sManager.Start(mgrCtx)).To(Succeed(), "failed to start manager")
+		}()
+	})
+}
+
+// fakeNATPortUsageGetter reports the NAT port usage set by the tests.
+type fakeNATPortUsageGetter struct {
+	mu        sync.Mutex
+	usedPorts map[string]int32
+}
+
+var _ natportusage.Getter = (*fakeNATPortUsageGetter)(nil)
+
+func (g *fakeNATPortUsageGetter) SetNATPortUsage(interfaceID string, usedPorts int32) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.usedPorts == nil {
+		g.usedPorts = make(map[string]int32)
+	}
+	g.usedPorts[interfaceID] = usedPorts
+}
+
+func (g *fakeNATPortUsageGetter) GetNATPortUsage(_ context.Context, _, interfaceID string) (int32, bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	usedPorts, ok := g.usedPorts[interfaceID]
+	return usedPorts, ok, nil
+}
+
+// SetupTestWithNATGateways runs the network interface reconciler reporting the NAT port usage of the
+// given getter together with the NAT gateway reconciler of the controller manager.
+func SetupTestWithNATGateways(metalnetNs *corev1.Namespace, natPortUsageGetter natportusage.Getter) {
+	BeforeEach(func(ctx SpecContext) {
+		k8sManager, err := ctrl.NewManager(cfg, ctrl.Options{
+			Scheme: scheme.Scheme,
+			Metrics: metricsserver.Options{
+				BindAddress: "0",
+			},
+			Controller: ctrlconfig.Controller{SkipNameValidation: ptr.To(true)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// register reconciler here
+		Expect((&NetworkReconciler{
+			Client:            k8sManager.GetClient(),
+			MetalnetClient:    k8sManager.GetClient(),
+			PartitionName:     partitionName,
+			MetalnetNamespace: metalnetNs.Name,
+		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
+
+		Expect((&MetalnetNodeReconciler{
+			Client:         k8sManager.GetClient(),
+			MetalnetClient: k8sManager.GetClient(),
+			PartitionName:  partitionName,
+			NodeLabels:     nodeLabels,
+		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
+
+		Expect((&NetworkInterfaceReconciler{
+			Client:                     k8sManager.GetClient(),
+			APIReader:                  k8sManager.GetAPIReader(),
+			MetalnetClient:             k8sManager.GetClient(),
+			PartitionName:              partitionName,
+			MetalnetNamespace:          metalnetNs.Name,
+			NATPortUsageGetter:         natPortUsageGetter,
+			NATPortUsageResyncInterval: 100 * time.Millisecond,
+		}).SetupWithManager(k8sManager, k8sManager.GetCache())).To(Succeed())
+
+		Expect((&apinetcontrollers.NATGatewayReconciler{
+			Client:        k8sManager.GetClient(),
+			EventRecorder: &events.FakeRecorder{},
+		}).SetupWithManager(k8sManager)).To(Succeed())
+
 		mgrCtx, cancel := context.WithCancel(context.Background())
 		DeferCleanup(cancel)
 		go func() {
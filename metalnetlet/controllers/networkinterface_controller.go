@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"github.com/ironcore-dev/ironcore-net/metalnetlet/natportusage"
 	netclientutils "github.com/ironcore-dev/ironcore-net/utils/client"
 	utilhandlers "github.com/ironcore-dev/ironcore-net/utils/handler"
 	"github.com/ironcore-dev/ironcore-net/utils/origin"
@@ -26,10 +28,12 @@ import (
 	metalnetv1alpha1ac "github.com/ironcore-dev/metalnet/api/v1alpha1/applyconfiguration/api/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -50,11 +54,21 @@ var (
 
 type NetworkInterfaceReconciler struct {
 	client.Client
+	// APIReader reads NAT tables created before slicing, which are not labeled with a partition
+	// and thus not part of the cache.
+	APIReader      client.Reader
 	MetalnetClient client.Client
 
 	PartitionName string
 
 	MetalnetNamespace string
+
+	// NATPortUsageGetter gets the NAT ports used by the metalnet network interfaces to report NAT port pressure.
+	// If unset, no network interface reports NAT port pressure.
+	NATPortUsageGetter natportusage.Getter
+	// NATPortUsageResyncInterval is the interval the NAT port usage of a NATed network interface is re-checked in.
+	// If zero, DefaultNATPortUsageResyncInterval is used.
+	NATPortUsageResyncInterval time.Duration
 }
 
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -67,6 +81,7 @@ type NetworkInterfaceReconciler struct {
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=natgateways,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkpolicies,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkpolicyrules,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=portforwardings,verbs=get;list;watch
 
 //+cluster=metalnet:kubebuilder:rbac:groups=networking.metalnet.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+cluster=metalnet:kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
@@ -152,34 +167,106 @@ func (r *NetworkInterfaceReconciler) getMetalnetNetworkNameForNetworkInterface(c
 	return string(network.UID), nil
 }
 
-func (r *NetworkInterfaceReconciler) getLoadBalancerTargetsForNetworkInterface(ctx context.Context, nic *v1alpha1.NetworkInterface) ([]net.IP, error) {
+// getLoadBalancerTargetsForNetworkInterface returns the load balancer and port forwarding IPs the network
+// interface is a target of and the time until the next draining load balancer destination expires.
+func (r *NetworkInterfaceReconciler) getLoadBalancerTargetsForNetworkInterface(ctx context.Context, nic *v1alpha1.NetworkInterface) ([]net.IP, time.Duration, error) {
 	lbList := &v1alpha1.LoadBalancerList{}
 	if err := r.List(ctx, lbList,
 		client.InNamespace(nic.Namespace),
 	); err != nil {
-		return nil, fmt.Errorf("error listing load balancers: %w", err)
+		return nil, 0, fmt.Errorf("error listing load balancers: %w", err)
+	}
+
+	lbRoutingList := &v1alpha1.LoadBalancerRoutingList{}
+	if err := r.List(ctx, lbRoutingList,
+		client.InNamespace(nic.Namespace),
+	); err != nil {
+		return nil, 0, fmt.Errorf("error listing load balancer routings: %w", err)
+	}
+
+	portForwardingList := &v1alpha1.PortForwardingList{}
+	if err := r.List(ctx, portForwardingList,
+		client.InNamespace(nic.Namespace),
+	); err != nil {
+		return nil, 0, fmt.Errorf("error listing port forwardings: %w", err)
 	}
-	relevantLoadBalancers := make([]*v1alpha1.LoadBalancer, 0)
-	for i := range lbList.Items {
-		if nic.Spec.NetworkRef.Name != lbList.Items[i].Spec.NetworkRef.Name {
+
+	lbIPs, requeueAfter := loadBalancerTargetIPs(nic, lbList.Items, lbRoutingList.Items, time.Now())
+	ipSet := sets.New(lbIPs...)
+	ipSet.Insert(portForwardingTargetIPs(nic, portForwardingList.Items)...)
+
+	ips := ipSet.UnsortedList()
+	slices.SortFunc(ips, func(ip1, ip2 net.IP) int { return ip1.Compare(ip2.Addr) })
+	return ips, requeueAfter, nil
+}
+
+// portForwardingTargetIPs returns the public IPs of all port forwardings to the network interface.
+// Port forwardings translating ports are left out, as metalnet cannot program them.
+func portForwardingTargetIPs(nic *v1alpha1.NetworkInterface, portForwardings []v1alpha1.PortForwarding) []net.IP {
+	var ips []net.IP
+	for i := range portForwardings {
+		portForwarding := &portForwardings[i]
+		if portForwarding.Spec.NetworkInterfaceRef.Name != nic.Name ||
+			!portForwarding.Spec.IP.IsValid() ||
+			!slices.Contains(nic.Spec.IPs, portForwarding.Spec.TargetIP) ||
+			v1alpha1.GetPortForwardingTargetPort(portForwarding) != portForwarding.Spec.Port {
 			continue
 		}
 
-		relevantLoadBalancers = append(relevantLoadBalancers, &lbList.Items[i])
+		ips = append(ips, portForwarding.Spec.IP)
 	}
+	return ips
+}
 
-	ipSet := sets.New[net.IP]()
-	for _, lb := range relevantLoadBalancers {
-		lbRouting := &v1alpha1.LoadBalancerRouting{}
-		lbRoutingKey := client.ObjectKeyFromObject(lb)
-		if err := r.Get(ctx, lbRoutingKey, lbRouting); client.IgnoreNotFound(err) != nil {
-			return nil, err
+// loadBalancerTargetIPs returns the IPs of all load balancers of the network of the network interface
+// that have a destination of the network interface in any of their routings.
+//
+// Draining destinations stay targets until their drain deadline has passed, so their established
+// connections are kept. metalnet has no notion of draining targets, so a draining destination may
+// still be selected for new flows until then. The returned duration is the time until the next
+// draining destination expires, zero if there is none.
+func loadBalancerTargetIPs(
+	nic *v1alpha1.NetworkInterface,
+	loadBalancers []v1alpha1.LoadBalancer,
+	loadBalancerRoutings []v1alpha1.LoadBalancerRouting,
+	now time.Time,
+) ([]net.IP, time.Duration) {
+	lbRoutingsByLBName := make(map[string][]*v1alpha1.LoadBalancerRouting)
+	for i := range loadBalancerRoutings {
+		lbRouting := &loadBalancerRoutings[i]
+		lbName := v1alpha1.GetLoadBalancerRoutingLoadBalancerName(lbRouting)
+		lbRoutingsByLBName[lbName] = append(lbRoutingsByLBName[lbName], lbRouting)
+	}
+
+	var (
+		requeueAfter time.Duration
+		ipSet        = sets.New[net.IP]()
+	)
+	for i := range loadBalancers {
+		lb := &loadBalancers[i]
+		if nic.Spec.NetworkRef.Name != lb.Spec.NetworkRef.Name {
+			continue
+		}
+
+		var hasDst bool
+		for _, lbRouting := range lbRoutingsByLBName[lb.Name] {
+			for _, dst := range lbRouting.Destinations {
+				if !slices.Contains(nic.Spec.IPs, dst.IP) {
+					continue
+				}
+
+				if v1alpha1.IsLoadBalancerDestinationDraining(&dst) {
+					remaining := v1alpha1.LoadBalancerDestinationDrainDeadline(&dst).Sub(now)
+					if remaining <= 0 {
+						continue
+					}
+					if requeueAfter == 0 || remaining < requeueAfter {
+						requeueAfter = remaining
+					}
+				}
+				hasDst = true
+			}
 		}
-		hasDst := slices.ContainsFunc(lbRouting.Destinations,
-			func(dst v1alpha1.LoadBalancerDestination) bool {
-				return slices.Contains(nic.Spec.IPs, dst.IP)
-			},
-		)
 		if hasDst {
 			ipSet.Insert(v1alpha1.GetLoadBalancerIPs(lb)...)
 		}
@@ -187,7 +274,7 @@ func (r *NetworkInterfaceReconciler) getLoadBalancerTargetsForNetworkInterface(c
 
 	ips := ipSet.UnsortedList()
 	slices.SortFunc(ips, func(ip1, ip2 net.IP) int { return ip1.Compare(ip2.Addr) })
-	return ips, nil
+	return ips, requeueAfter
 }
 
 func (r *NetworkInterfaceReconciler) getNetworkPolicyRulesForNetworkInterface(ctx context.Context, nic *v1alpha1.NetworkInterface) ([]metalnetv1alpha1.FirewallRule, error) {
@@ -385,6 +472,57 @@ func (r *NetworkInterfaceReconciler) getNATDetailsForNetworkInterface(
 	return res, nil
 }
 
+// getLegacyNATTable returns the NAT table of the NAT gateway created before slicing, if any.
+// It is not labeled with a partition and thus not part of the cache, so it is read from the API server.
+func (r *NetworkInterfaceReconciler) getLegacyNATTable(ctx context.Context, natGateway *v1alpha1.NATGateway) (*v1alpha1.NATTable, error) {
+	natTable := &v1alpha1.NATTable{}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(natGateway), natTable); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting NAT table: %w", err)
+		}
+		return nil, nil
+	}
+	if _, ok := natTable.Labels[v1alpha1.NATGatewayNameLabel]; ok {
+		return nil, nil
+	}
+	return natTable, nil
+}
+
+// networkInterfaceNATIPSections returns the NAT IPs of the given family in the given NAT tables and
+// the sections of the network interface on them.
+func networkInterfaceNATIPSections(
+	nic *v1alpha1.NetworkInterface,
+	ipFamily corev1.IPFamily,
+	natTables []v1alpha1.NATTable,
+) ([]net.IP, map[net.IP][]v1alpha1.NATIPSection) {
+	var (
+		natIPs        []net.IP
+		natIPSections = make(map[net.IP][]v1alpha1.NATIPSection)
+	)
+	for _, natTable := range natTables {
+		for _, natIP := range natTable.IPs {
+			if natIP.IP.Family() != ipFamily {
+				// A dual-stack NAT gateway has NAT IPs of both IP families in its NAT table.
+				continue
+			}
+
+			for _, target := range natIP.Sections {
+				// TODO: Do matching based on IP in the future.
+				ref := target.TargetRef
+				if ref == nil || ref.UID != nic.UID {
+					continue
+				}
+
+				if _, ok := natIPSections[natIP.IP]; !ok {
+					natIPs = append(natIPs, natIP.IP)
+				}
+				natIPSections[natIP.IP] = append(natIPSections[natIP.IP], target)
+			}
+		}
+	}
+	return natIPs, natIPSections
+}
+
 func (r *NetworkInterfaceReconciler) getNATIPsForNetworkInterfaceNAT(
 	ctx context.Context,
 	nic *v1alpha1.NetworkInterface,
@@ -402,34 +540,136 @@ func (r *NetworkInterfaceReconciler) getNATIPsForNetworkInterfaceNAT(
 		return nil, nil
 	}
 
-	natTable := &v1alpha1.NATTable{}
-	if err := r.Get(ctx, natGatewayKey, natTable); err != nil {
-		if !apierrors.IsNotFound(err) {
+	// Only the NAT table slices of the nodes of this partition are watched.
+	natTableList := &v1alpha1.NATTableList{}
+	if err := r.List(ctx, natTableList,
+		client.InNamespace(nic.Namespace),
+		client.MatchingLabels{v1alpha1.NATGatewayNameLabel: natGateway.Name},
+	); err != nil {
+		return nil, err
+	}
+
+	natIPs, natIPSections := networkInterfaceNATIPSections(nic, nat.IPFamily, natTableList.Items)
+	if len(natIPs) == 0 {
+		// The NAT gateway controller migrates the NAT table created before slicing on its next reconciliation.
+		// Until then, keep reading it so that the NAT of the network interface is not removed.
+		legacyNATTable, err := r.getLegacyNATTable(ctx, natGateway)
+		if err != nil {
 			return nil, err
 		}
-		return nil, nil
+		if legacyNATTable != nil {
+			natIPs, natIPSections = networkInterfaceNATIPSections(nic, nat.IPFamily, []v1alpha1.NATTable{*legacyNATTable})
+		}
 	}
 
-	for _, natIP := range natTable.IPs {
-		for _, target := range natIP.Sections {
-			// TODO: Do matching based on IP in the future.
-			ref := target.TargetRef
-			if ref == nil || ref.UID != nic.UID {
-				continue
+	// A network interface migrated off a draining IP keeps its sections on the draining IP until the IP
+	// is drained. metalnet only supports a single NAT IP per network interface, so prefer the new IP.
+	drainingIPs := sets.New[net.IP]()
+	for _, ip := range natGateway.Spec.IPs {
+		if ip.Draining {
+			drainingIPs.Insert(ip.IP)
+		}
+	}
+	slices.SortFunc(natIPs, func(a, b net.IP) int {
+		if aDraining, bDraining := drainingIPs.Has(a), drainingIPs.Has(b); aDraining != bDraining {
+			if aDraining {
+				return 1
 			}
+			return -1
+		}
+		return a.Compare(b.Addr)
+	})
 
-			return &metalnetv1alpha1.NATDetails{
-				IP:      &metalnetv1alpha1.IP{Addr: natIP.IP.Addr},
-				Port:    target.Port,
-				EndPort: target.EndPort,
-			}, nil
+	for _, natIP := range natIPs {
+		sections := natIPSections[natIP]
+
+		// Additional port blocks of a network interface are adjacent to its port range.
+		// Merge them as metalnet only supports a single port range per network interface.
+		slices.SortFunc(sections, func(a, b v1alpha1.NATIPSection) int {
+			return int(a.Port) - int(b.Port)
+		})
+		port, endPort := sections[0].Port, sections[0].EndPort
+		for _, section := range sections[1:] {
+			if section.Port != endPort+1 {
+				break
+			}
+			endPort = section.EndPort
 		}
+
+		return &metalnetv1alpha1.NATDetails{
+			IP:      &metalnetv1alpha1.IP{Addr: natIP.Addr},
+			Port:    port,
+			EndPort: endPort,
+		}, nil
 	}
 	return nil, nil
 }
 
+// hasNATPortPressure reports whether a network interface using usedPorts of its NAT ports runs short of them.
+func hasNATPortPressure(ports, usedPorts int32) bool {
+	return ports > 0 && int64(usedPorts)*100 >= int64(ports)*NATPortPressurePercent
+}
+
+// getNATPortUsage returns the number of NAT ports the metalnet network interface uses and whether it is known.
+// Failing to get the usage is not fatal, the usage is unknown then.
+func (r *NetworkInterfaceReconciler) getNATPortUsage(ctx context.Context, log logr.Logger, metalnetNic *metalnetv1alpha1.NetworkInterface) (int32, bool) {
+	if r.NATPortUsageGetter == nil || metalnetNic.Spec.NAT == nil || metalnetNic.Spec.NodeName == nil {
+		return 0, false
+	}
+
+	usedPorts, ok, err := r.NATPortUsageGetter.GetNATPortUsage(ctx, *metalnetNic.Spec.NodeName, string(metalnetNic.UID))
+	if err != nil {
+		log.Error(err, "Error getting NAT port usage")
+		return 0, false
+	}
+	return usedPorts, ok
+}
+
+// natPortUsageResyncInterval returns the interval the NAT port usage of the metalnet network interface is
+// re-checked in, zero if it is not checked.
+func (r *NetworkInterfaceReconciler) natPortUsageResyncInterval(metalnetNic *metalnetv1alpha1.NetworkInterface) time.Duration {
+	if r.NATPortUsageGetter == nil || metalnetNic.Spec.NAT == nil {
+		return 0
+	}
+	if r.NATPortUsageResyncInterval == 0 {
+		return DefaultNATPortUsageResyncInterval
+	}
+	return r.NATPortUsageResyncInterval
+}
+
+// metalnetNATDetailsToNetworkInterfaceNATStatuses computes the NAT statuses of a network interface
+// from the NAT details of its metalnet network interface and the number of NAT ports it uses, if known.
+// The last transition time of a status is only updated if its ports or its port pressure changed.
+func metalnetNATDetailsToNetworkInterfaceNATStatuses(
+	nic *v1alpha1.NetworkInterface,
+	metalnetNic *metalnetv1alpha1.NetworkInterface,
+	usedPorts int32,
+	usedPortsKnown bool,
+) []v1alpha1.NetworkInterfaceNATStatus {
+	natDetails := metalnetNic.Spec.NAT
+	if natDetails == nil || natDetails.IP == nil {
+		return nil
+	}
+
+	natStatus := v1alpha1.NetworkInterfaceNATStatus{
+		IPFamily: metalnetIPToIP(*natDetails.IP).Family(),
+		Ports:    natDetails.EndPort + 1 - natDetails.Port,
+	}
+	natStatus.PortPressure = usedPortsKnown && hasNATPortPressure(natStatus.Ports, usedPorts)
+	if existing := v1alpha1.GetNetworkInterfaceNATStatus(nic, natStatus.IPFamily); existing != nil &&
+		existing.Ports == natStatus.Ports &&
+		existing.PortPressure == natStatus.PortPressure {
+		natStatus.LastTransitionTime = existing.LastTransitionTime
+	} else {
+		now := metav1.Now()
+		natStatus.LastTransitionTime = &now
+	}
+	return []v1alpha1.NetworkInterfaceNATStatus{natStatus}
+}
+
 func (r *NetworkInterfaceReconciler) updateStatus(
 	ctx context.Context,
+	log logr.Logger,
 	nic *v1alpha1.NetworkInterface,
 	metalnetNic *metalnetv1alpha1.NetworkInterface,
 ) error {
@@ -454,6 +694,8 @@ func (r *NetworkInterfaceReconciler) updateStatus(
 	}
 	nic.Status.PublicIPs = metalnetIPsToIPs(workaroundMetalnetNoIPv6IPToIPs(metalnetNic.Status.VirtualIP))
 	nic.Status.NATIPs = metalnetIPsToIPs(workaroundMetalnetNoIPv6NATIPToIPs(metalnetNic.Status.NatIP))
+	usedNATPorts, usedNATPortsKnown := r.getNATPortUsage(ctx, log, metalnetNic)
+	nic.Status.NATs = metalnetNATDetailsToNetworkInterfaceNATStatuses(nic, metalnetNic, usedNATPorts, usedNATPortsKnown)
 	nic.Status.Prefixes = metalnetIPPrefixesToIPPrefixes(metalnetNic.Spec.Prefixes)
 	if err := r.Status().Patch(ctx, nic, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("error patching network interface status: %w", err)
@@ -520,7 +762,7 @@ func (r *NetworkInterfaceReconciler) reconcile(ctx context.Context, log logr.Log
 	log.V(1).Info("Finalizer is present")
 
 	log.V(1).Info("Managing metalnet network interface")
-	metalnetNic, ready, err := r.applyMetalnetNic(ctx, log, nic, metalnetNode.Name)
+	metalnetNic, requeueAfter, ready, err := r.applyMetalnetNic(ctx, log, nic, metalnetNode.Name)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error managing metalnet network interface: %w", err)
 	}
@@ -530,43 +772,48 @@ func (r *NetworkInterfaceReconciler) reconcile(ctx context.Context, log logr.Log
 	}
 
 	log.V(1).Info("Updating status")
-	if err := r.updateStatus(ctx, nic, metalnetNic); err != nil {
+	if err := r.updateStatus(ctx, log, nic, metalnetNic); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
 	}
 
+	if resyncInterval := r.natPortUsageResyncInterval(metalnetNic); resyncInterval > 0 &&
+		(requeueAfter == 0 || resyncInterval < requeueAfter) {
+		requeueAfter = resyncInterval
+	}
+
 	log.V(1).Info("Reconciled")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func (r *NetworkInterfaceReconciler) applyMetalnetNic(ctx context.Context, log logr.Logger, nic *v1alpha1.NetworkInterface, metalnetNodeName string) (*metalnetv1alpha1.NetworkInterface, bool, error) {
+func (r *NetworkInterfaceReconciler) applyMetalnetNic(ctx context.Context, log logr.Logger, nic *v1alpha1.NetworkInterface, metalnetNodeName string) (*metalnetv1alpha1.NetworkInterface, time.Duration, bool, error) {
 	log.V(1).Info("Getting network vni")
 	metalnetNetworkName, err := r.getMetalnetNetworkNameForNetworkInterface(ctx, nic)
 	if err != nil {
-		return nil, false, err
+		return nil, 0, false, err
 	}
 	if metalnetNetworkName == "" {
 		log.V(1).Info("Network is not yet ready")
-		return nil, false, nil
+		return nil, 0, false, nil
 	}
 
 	publicIPs := v1alpha1.GetNetworkInterfacePublicIPs(nic)
 
 	log.V(1).Info("Getting load balancer targets")
-	targets, err := r.getLoadBalancerTargetsForNetworkInterface(ctx, nic)
+	targets, requeueAfter, err := r.getLoadBalancerTargetsForNetworkInterface(ctx, nic)
 	if err != nil {
-		return nil, false, fmt.Errorf("error getting load balancer targets: %w", err)
+		return nil, 0, false, fmt.Errorf("error getting load balancer targets: %w", err)
 	}
 
 	log.V(1).Info("Getting network policy rules")
 	npRules, err := r.getNetworkPolicyRulesForNetworkInterface(ctx, nic)
 	if err != nil {
-		return nil, false, fmt.Errorf("error getting network policy rules: %w", err)
+		return nil, 0, false, fmt.Errorf("error getting network policy rules: %w", err)
 	}
 
 	log.V(1).Info("Getting NAT IPs")
 	natIPs, err := r.getNATDetailsForNetworkInterface(ctx, nic)
 	if err != nil {
-		return nil, false, fmt.Errorf("error getting NAT IPs: %w", err)
+		return nil, 0, false, fmt.Errorf("error getting NAT IPs: %w", err)
 	}
 
 	metalnetNicName := string(nic.UID)
@@ -603,17 +850,17 @@ func (r *NetworkInterfaceReconciler) applyMetalnetNic(ctx context.Context, log l
 
 	log.V(1).Info("Applying metalnet network interface")
 	if err := r.MetalnetClient.Apply(ctx, metalnetNicApplyCfg, MetalnetFieldOwner, client.ForceOwnership); err != nil {
-		return nil, false, fmt.Errorf("error applying metalnet network interface: %w", err)
+		return nil, 0, false, fmt.Errorf("error applying metalnet network interface: %w", err)
 	}
 
 	// Fetch the applied object
 	metalnetNic := &metalnetv1alpha1.NetworkInterface{}
 	key := client.ObjectKey{Namespace: r.MetalnetNamespace, Name: metalnetNicName}
 	if err := r.MetalnetClient.Get(ctx, key, metalnetNic); err != nil {
-		return nil, false, fmt.Errorf("error getting applied metalnet network interface: %w", err)
+		return nil, 0, false, fmt.Errorf("error getting applied metalnet network interface: %w", err)
 	}
 
-	return metalnetNic, true, nil
+	return metalnetNic, requeueAfter, true, nil
 }
 
 func (r *NetworkInterfaceReconciler) isPartitionNetworkInterface() predicate.Predicate {
@@ -707,23 +954,24 @@ func (r *NetworkInterfaceReconciler) enqueueByNATTable() handler.EventHandler {
 	})
 }
 
-func (r *NetworkInterfaceReconciler) reconcileRequestsByLoadBalancerRouting(
+func (r *NetworkInterfaceReconciler) reconcileRequestsByLoadBalancerDestinationIPs(
 	ctx context.Context,
 	log logr.Logger,
-	loadBalancerRouting *v1alpha1.LoadBalancerRouting,
+	namespace string,
+	dstIPs sets.Set[net.IP],
 ) []ctrl.Request {
+	if dstIPs.Len() == 0 {
+		return nil
+	}
+
 	nicList := &v1alpha1.NetworkInterfaceList{}
 	if err := r.List(ctx, nicList,
-		client.InNamespace(loadBalancerRouting.Namespace),
+		client.InNamespace(namespace),
 	); err != nil {
 		log.Error(err, "Error listing network interfaces")
 		return nil
 	}
 
-	dstIPs := utilslices.ToSetFunc(loadBalancerRouting.Destinations,
-		func(dst v1alpha1.LoadBalancerDestination) net.IP { return dst.IP },
-	)
-
 	var reqs []ctrl.Request
 	for _, nic := range nicList.Items {
 		if _, err := ParseNodeName(r.PartitionName, nic.Spec.NodeRef.Name); err != nil {
@@ -737,13 +985,64 @@ func (r *NetworkInterfaceReconciler) reconcileRequestsByLoadBalancerRouting(
 	return reqs
 }
 
+func loadBalancerRoutingDestinationIPs(loadBalancerRouting *v1alpha1.LoadBalancerRouting) sets.Set[net.IP] {
+	return utilslices.ToSetFunc(loadBalancerRouting.Destinations,
+		func(dst v1alpha1.LoadBalancerDestination) net.IP { return dst.IP },
+	)
+}
+
+// changedLoadBalancerRoutingDestinationIPs returns the IPs of all destinations that were added, removed
+// or modified between the old and the new load balancer routing.
+func changedLoadBalancerRoutingDestinationIPs(oldLoadBalancerRouting, newLoadBalancerRouting *v1alpha1.LoadBalancerRouting) sets.Set[net.IP] {
+	oldDsts := make(map[net.IP]v1alpha1.LoadBalancerDestination, len(oldLoadBalancerRouting.Destinations))
+	for _, dst := range oldLoadBalancerRouting.Destinations {
+		oldDsts[dst.IP] = dst
+	}
+
+	changed := sets.New[net.IP]()
+	for _, dst := range newLoadBalancerRouting.Destinations {
+		oldDst, ok := oldDsts[dst.IP]
+		delete(oldDsts, dst.IP)
+		if !ok || !equality.Semantic.DeepEqual(oldDst, dst) {
+			changed.Insert(dst.IP)
+		}
+	}
+	for ip := range oldDsts {
+		changed.Insert(ip)
+	}
+	return changed
+}
+
+// enqueueByLoadBalancerRouting enqueues the network interfaces targeted by a load balancer routing slice.
+// On update, only the network interfaces of changed destinations are enqueued, so that a change to one
+// slice of a load balancer does not cause the targets of all other slices to be reconciled.
 func (r *NetworkInterfaceReconciler) enqueueByLoadBalancerRouting() handler.EventHandler {
-	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
-		loadBalancerRouting := obj.(*v1alpha1.LoadBalancerRouting)
+	enqueue := func(ctx context.Context, q workqueue.TypedRateLimitingInterface[ctrl.Request], namespace string, dstIPs sets.Set[net.IP]) {
 		log := ctrl.LoggerFrom(ctx)
+		for _, req := range r.reconcileRequestsByLoadBalancerDestinationIPs(ctx, log, namespace, dstIPs) {
+			q.Add(req)
+		}
+	}
 
-		return r.reconcileRequestsByLoadBalancerRouting(ctx, log, loadBalancerRouting)
-	})
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+			loadBalancerRouting := evt.Object.(*v1alpha1.LoadBalancerRouting)
+			enqueue(ctx, q, loadBalancerRouting.Namespace, loadBalancerRoutingDestinationIPs(loadBalancerRouting))
+		},
+		UpdateFunc: func(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+			oldLoadBalancerRouting := evt.ObjectOld.(*v1alpha1.LoadBalancerRouting)
+			newLoadBalancerRouting := evt.ObjectNew.(*v1alpha1.LoadBalancerRouting)
+			enqueue(ctx, q, newLoadBalancerRouting.Namespace, changedLoadBalancerRoutingDestinationIPs(oldLoadBalancerRouting, newLoadBalancerRouting))
+		},
+		DeleteFunc: func(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+			loadBalancerRouting := evt.Object.(*v1alpha1.LoadBalancerRouting)
+			enqueue(ctx, q, loadBalancerRouting.Namespace, loadBalancerRoutingDestinationIPs(loadBalancerRouting))
+		},
+		GenericFunc: func(ctx context.Context, evt event.GenericEvent, q workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+			loadBalancerRouting := evt.Object.(*v1alpha1.LoadBalancerRouting)
+			enqueue(ctx, q, loadBalancerRouting.Namespace, loadBalancerRoutingDestinationIPs(loadBalancerRouting))
+		},
+	}
 }
 
 func (r *NetworkInterfaceReconciler) enqueueByLoadBalancer() handler.EventHandler {
@@ -751,16 +1050,34 @@ func (r *NetworkInterfaceReconciler) enqueueByLoadBalancer() handler.EventHandle
 		loadBalancer := obj.(*v1alpha1.LoadBalancer)
 		log := ctrl.LoggerFrom(ctx)
 
-		loadBalancerKey := client.ObjectKeyFromObject(loadBalancer)
-		loadBalancerRouting := &v1alpha1.LoadBalancerRouting{}
-		if err := r.Get(ctx, loadBalancerKey, loadBalancerRouting); err != nil {
-			if !apierrors.IsNotFound(err) {
-				log.Error(err, "Error getting load balancer routing")
-			}
+		loadBalancerRoutingList := &v1alpha1.LoadBalancerRoutingList{}
+		if err := r.List(ctx, loadBalancerRoutingList,
+			client.InNamespace(loadBalancer.Namespace),
+		); err != nil {
+			log.Error(err, "Error listing load balancer routings")
 			return nil
 		}
 
-		return r.reconcileRequestsByLoadBalancerRouting(ctx, log, loadBalancerRouting)
+		dstIPs := sets.New[net.IP]()
+		for i := range loadBalancerRoutingList.Items {
+			loadBalancerRouting := &loadBalancerRoutingList.Items[i]
+			if v1alpha1.GetLoadBalancerRoutingLoadBalancerName(loadBalancerRouting) != loadBalancer.Name {
+				continue
+			}
+			dstIPs.Insert(loadBalancerRoutingDestinationIPs(loadBalancerRouting).UnsortedList()...)
+		}
+
+		return r.reconcileRequestsByLoadBalancerDestinationIPs(ctx, log, loadBalancer.Namespace, dstIPs)
+	})
+}
+
+func (r *NetworkInterfaceReconciler) enqueueByPortForwarding() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		portForwarding := obj.(*v1alpha1.PortForwarding)
+		return []ctrl.Request{{NamespacedName: client.ObjectKey{
+			Namespace: portForwarding.Namespace,
+			Name:      portForwarding.Spec.NetworkInterfaceRef.Name,
+		}}}
 	})
 }
 
@@ -865,6 +1182,10 @@ func (r *NetworkInterfaceReconciler) SetupWithManager(mgr ctrl.Manager, metalnet
 			&v1alpha1.LoadBalancerRouting{},
 			r.enqueueByLoadBalancerRouting(),
 		).
+		Watches(
+			&v1alpha1.PortForwarding{},
+			r.enqueueByPortForwarding(),
+		).
 		Watches(
 			&v1alpha1.NetworkPolicy{},
 			r.enqueueByNetworkPolicy(),
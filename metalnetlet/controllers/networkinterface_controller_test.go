@@ -5,15 +5,21 @@ package controllers
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
 	"github.com/ironcore-dev/ironcore/utils/generic"
+	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
 	metalnetv1alpha1 "github.com/ironcore-dev/metalnet/api/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	. "github.com/ironcore-dev/ironcore/utils/testing"
 	. "github.com/onsi/ginkgo/v2"
@@ -650,4 +656,395 @@ var _ = Describe("NetworkInterfaceController", func() {
 		By("waiting for the metalnet network interface to be gone")
 		Eventually(Get(metalnetNic)).Should(Satisfy(apierrors.IsNotFound))
 	})
+
+	It("should NAT a network interface using the NAT table created before slicing", func(ctx SpecContext) {
+		By("creating a NAT gateway")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: network.Name},
+				PortsPerNetworkInterface: 64,
+				IPs:                      []v1alpha1.NATGatewayIP{{Name: "ip-1"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+		natIP := natGateway.Spec.IPs[0].IP
+
+		By("creating a network interface claimed by the NAT gateway")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef: corev1.LocalObjectReference{
+					Name: PartitionNodeName(partitionName, metalnetNode.Name),
+				},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.2")},
+				NATs: []v1alpha1.NetworkInterfaceNAT{
+					{
+						IPFamily: corev1.IPv4Protocol,
+						ClaimRef: v1alpha1.NetworkInterfaceNATClaimRef{
+							Name: natGateway.Name,
+							UID:  natGateway.UID,
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("creating an unlabeled NAT table named after the NAT gateway")
+		natTable := &v1alpha1.NATTable{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      natGateway.Name,
+			},
+			IPs: []v1alpha1.NATIP{
+				{
+					IP: natIP,
+					Sections: []v1alpha1.NATIPSection{
+						{
+							IP:      net.MustParseIP("10.0.0.2"),
+							Port:    1024,
+							EndPort: 1087,
+							TargetRef: &v1alpha1.NATTableIPTargetRef{
+								UID:     nic.UID,
+								Name:    nic.Name,
+								NodeRef: nic.Spec.NodeRef,
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natTable)).To(Succeed())
+
+		By("triggering a reconciliation of the network interface")
+		Eventually(Update(nic, func() {
+			metav1.SetMetaDataLabel(&nic.ObjectMeta, "foo", "bar")
+		})).Should(Succeed())
+
+		By("waiting for the metalnet network interface to NAT using the NAT table")
+		metalnetNic := &metalnetv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metalnetNs.Name,
+				Name:      string(nic.UID),
+			},
+		}
+		Eventually(Object(metalnetNic)).Should(HaveField("Spec.NAT", PointTo(MatchFields(IgnoreExtras, Fields{
+			"IP":      PointTo(Equal(metalnetv1alpha1.IP{Addr: natIP.Addr})),
+			"Port":    Equal(int32(1024)),
+			"EndPort": Equal(int32(1087)),
+		}))))
+	})
+})
+
+var _ = Describe("NetworkInterfaceController NAT port pressure", func() {
+	ns := SetupNamespace(&k8sClient)
+	metalnetNs := SetupNamespace(&k8sClient)
+	natPortUsageGetter := &fakeNATPortUsageGetter{}
+	SetupTestWithNATGateways(metalnetNs, natPortUsageGetter)
+
+	metalnetNode := SetupMetalnetNode()
+	network := SetupNetwork(ns)
+
+	It("should grant an additional port block to a network interface using up its NAT ports", func(ctx SpecContext) {
+		By("creating a NAT gateway with port block expansion")
+		natGateway := &v1alpha1.NATGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nat-gateway-",
+			},
+			Spec: v1alpha1.NATGatewaySpec{
+				IPFamily:                 corev1.IPv4Protocol,
+				NetworkRef:               corev1.LocalObjectReference{Name: network.Name},
+				PortsPerNetworkInterface: 64,
+				PortBlockExpansion: &v1alpha1.NATGatewayPortBlockExpansion{
+					MaxExtraPortBlocks: 1,
+				},
+				IPs: []v1alpha1.NATGatewayIP{{Name: "ip-1"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, natGateway)).To(Succeed())
+
+		By("creating a network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef: corev1.LocalObjectReference{
+					Name: PartitionNodeName(partitionName, metalnetNode.Name),
+				},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the metalnet network interface to NAT using a single port block")
+		metalnetNic := &metalnetv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metalnetNs.Name,
+				Name:      string(nic.UID),
+			},
+		}
+		natPorts := func(metalnetNic *metalnetv1alpha1.NetworkInterface) int32 {
+			if metalnetNic.Spec.NAT == nil {
+				return 0
+			}
+			return metalnetNic.Spec.NAT.EndPort + 1 - metalnetNic.Spec.NAT.Port
+		}
+		Eventually(Object(metalnetNic)).Should(WithTransform(natPorts, Equal(int32(64))))
+
+		By("asserting the network interface reports no NAT port pressure")
+		Eventually(Object(nic)).Should(HaveField("Status.NATs", ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Ports":        Equal(int32(64)),
+			"PortPressure": BeFalse(),
+		}))))
+
+		By("reporting the network interface to use most of its NAT ports")
+		natPortUsageGetter.SetNATPortUsage(string(metalnetNic.UID), 60)
+
+		By("waiting for the metalnet network interface to NAT using an additional port block")
+		Eventually(Object(metalnetNic)).Should(WithTransform(natPorts, Equal(int32(128))))
+
+		By("asserting the network interface reports no NAT port pressure for its new ports")
+		Eventually(Object(nic)).Should(HaveField("Status.NATs", ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Ports":        Equal(int32(128)),
+			"PortPressure": BeFalse(),
+		}))))
+	})
+})
+
+var _ = Describe("metalnetNATDetailsToNetworkInterfaceNATStatuses", func() {
+	metalnetNic := &metalnetv1alpha1.NetworkInterface{
+		Spec: metalnetv1alpha1.NetworkInterfaceSpec{
+			NAT: &metalnetv1alpha1.NATDetails{
+				IP:      &metalnetv1alpha1.IP{Addr: netip.MustParseAddr("10.0.1.1")},
+				Port:    1024,
+				EndPort: 1087,
+			},
+		},
+	}
+
+	DescribeTable("port pressure",
+		func(usedPorts int32, usedPortsKnown, expectedPortPressure bool) {
+			Expect(metalnetNATDetailsToNetworkInterfaceNATStatuses(&v1alpha1.NetworkInterface{}, metalnetNic, usedPorts, usedPortsKnown)).
+				To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"IPFamily":     Equal(corev1.IPv4Protocol),
+					"Ports":        Equal(int32(64)),
+					"PortPressure": Equal(expectedPortPressure),
+				})))
+		},
+		Entry("unknown usage", int32(0), false, false),
+		Entry("usage below the threshold", int32(51), true, false),
+		Entry("usage at the threshold", int32(52), true, true),
+		Entry("all ports used", int32(64), true, true),
+	)
+})
+
+var _ = Describe("loadBalancerTargetIPs", func() {
+	nic := &v1alpha1.NetworkInterface{
+		Spec: v1alpha1.NetworkInterfaceSpec{
+			NetworkRef: corev1.LocalObjectReference{Name: "network"},
+			IPs:        []net.IP{net.MustParseIP("10.0.0.1")},
+		},
+	}
+	loadBalancer := func(name, ip string) v1alpha1.LoadBalancer {
+		return v1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.LoadBalancerSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "network"},
+				IPs:        []v1alpha1.LoadBalancerIP{{IP: net.MustParseIP(ip)}},
+			},
+		}
+	}
+
+	It("should keep targeting load balancers of draining destinations until their drain deadline", func() {
+		now := time.Now()
+		drainingDst := func(drainingTimestamp time.Time) v1alpha1.LoadBalancerDestination {
+			return v1alpha1.LoadBalancerDestination{
+				IP:                net.MustParseIP("10.0.0.1"),
+				State:             v1alpha1.LoadBalancerDestinationStateDraining,
+				DrainingTimestamp: &metav1.Time{Time: drainingTimestamp},
+				DrainTimeout:      &metav1.Duration{Duration: time.Minute},
+			}
+		}
+
+		ips, requeueAfter := loadBalancerTargetIPs(nic,
+			[]v1alpha1.LoadBalancer{
+				loadBalancer("active", "10.0.1.1"),
+				loadBalancer("draining", "10.0.1.2"),
+				loadBalancer("drained", "10.0.1.3"),
+			},
+			[]v1alpha1.LoadBalancerRouting{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "active"},
+					Destinations: []v1alpha1.LoadBalancerDestination{
+						{IP: net.MustParseIP("10.0.0.1"), State: v1alpha1.LoadBalancerDestinationStateActive},
+					},
+				},
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: "draining"},
+					Destinations: []v1alpha1.LoadBalancerDestination{drainingDst(now.Add(-20 * time.Second))},
+				},
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: "drained"},
+					Destinations: []v1alpha1.LoadBalancerDestination{drainingDst(now.Add(-2 * time.Minute))},
+				},
+			},
+			now,
+		)
+		Expect(ips).To(Equal([]net.IP{net.MustParseIP("10.0.1.1"), net.MustParseIP("10.0.1.2")}))
+		Expect(requeueAfter).To(Equal(40 * time.Second))
+	})
+
+	It("should target load balancers the network interface is a destination of in any routing slice", func() {
+		ips, requeueAfter := loadBalancerTargetIPs(nic,
+			[]v1alpha1.LoadBalancer{
+				loadBalancer("lb", "10.0.1.1"),
+				loadBalancer("other", "10.0.1.2"),
+			},
+			[]v1alpha1.LoadBalancerRouting{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "lb",
+						Labels: map[string]string{v1alpha1.LoadBalancerNameLabel: "lb"},
+					},
+					Destinations: []v1alpha1.LoadBalancerDestination{{IP: net.MustParseIP("10.0.0.2")}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "lb-1",
+						Labels: map[string]string{v1alpha1.LoadBalancerNameLabel: "lb"},
+					},
+					Destinations: []v1alpha1.LoadBalancerDestination{{IP: net.MustParseIP("10.0.0.1")}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "other-1",
+						Labels: map[string]string{v1alpha1.LoadBalancerNameLabel: "other"},
+					},
+					Destinations: []v1alpha1.LoadBalancerDestination{{IP: net.MustParseIP("10.0.0.2")}},
+				},
+			},
+			time.Now(),
+		)
+		Expect(ips).To(Equal([]net.IP{net.MustParseIP("10.0.1.1")}))
+		Expect(requeueAfter).To(BeZero())
+	})
+})
+
+var _ = Describe("changedLoadBalancerRoutingDestinationIPs", func() {
+	routing := func(dsts ...v1alpha1.LoadBalancerDestination) *v1alpha1.LoadBalancerRouting {
+		return &v1alpha1.LoadBalancerRouting{Destinations: dsts}
+	}
+	dst := func(ip string) v1alpha1.LoadBalancerDestination {
+		return v1alpha1.LoadBalancerDestination{IP: net.MustParseIP(ip)}
+	}
+	drainingDst := func(ip string) v1alpha1.LoadBalancerDestination {
+		return v1alpha1.LoadBalancerDestination{
+			IP:    net.MustParseIP(ip),
+			State: v1alpha1.LoadBalancerDestinationStateDraining,
+		}
+	}
+
+	DescribeTable("changedLoadBalancerRoutingDestinationIPs",
+		func(oldLoadBalancerRouting, newLoadBalancerRouting *v1alpha1.LoadBalancerRouting, expected []string) {
+			changed := changedLoadBalancerRoutingDestinationIPs(oldLoadBalancerRouting, newLoadBalancerRouting)
+			Expect(changed.UnsortedList()).To(ConsistOf(utilslices.Map(expected, net.MustParseIP)))
+		},
+		Entry("unchanged destinations",
+			routing(dst("10.0.0.1"), dst("10.0.0.2")),
+			routing(dst("10.0.0.1"), dst("10.0.0.2")),
+			[]string{},
+		),
+		Entry("added destination",
+			routing(dst("10.0.0.1")),
+			routing(dst("10.0.0.1"), dst("10.0.0.2")),
+			[]string{"10.0.0.2"},
+		),
+		Entry("removed destination",
+			routing(dst("10.0.0.1"), dst("10.0.0.2")),
+			routing(dst("10.0.0.1")),
+			[]string{"10.0.0.2"},
+		),
+		Entry("modified destination",
+			routing(dst("10.0.0.1"), dst("10.0.0.2")),
+			routing(dst("10.0.0.1"), drainingDst("10.0.0.2")),
+			[]string{"10.0.0.2"},
+		),
+	)
+})
+
+var _ = Describe("enqueueByLoadBalancerRouting", func() {
+	ns := SetupNamespace(&k8sClient)
+	metalnetNode := SetupMetalnetNode()
+	network := SetupNetwork(ns)
+
+	It("should only enqueue the network interfaces of changed destinations", func(ctx SpecContext) {
+		By("creating network interfaces")
+		nics := make([]*v1alpha1.NetworkInterface, 2)
+		for i, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+			nic := &v1alpha1.NetworkInterface{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "nic-",
+				},
+				Spec: v1alpha1.NetworkInterfaceSpec{
+					NodeRef:    corev1.LocalObjectReference{Name: PartitionNodeName(partitionName, metalnetNode.Name)},
+					NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+					IPs:        []net.IP{net.MustParseIP(ip)},
+				},
+			}
+			Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+			nics[i] = nic
+		}
+
+		r := &NetworkInterfaceReconciler{
+			Client:        k8sClient,
+			PartitionName: partitionName,
+		}
+		q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[ctrl.Request]())
+		DeferCleanup(q.ShutDown)
+
+		oldLoadBalancerRouting := &v1alpha1.LoadBalancerRouting{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "lb"},
+			Destinations: []v1alpha1.LoadBalancerDestination{
+				{IP: net.MustParseIP("10.0.0.1")},
+				{IP: net.MustParseIP("10.0.0.2")},
+			},
+		}
+		newLoadBalancerRouting := oldLoadBalancerRouting.DeepCopy()
+		newLoadBalancerRouting.Destinations[1].State = v1alpha1.LoadBalancerDestinationStateDraining
+
+		By("updating a destination of the load balancer routing")
+		r.enqueueByLoadBalancerRouting().Update(ctx, event.UpdateEvent{
+			ObjectOld: oldLoadBalancerRouting,
+			ObjectNew: newLoadBalancerRouting,
+		}, q)
+
+		By("asserting only the network interface of the updated destination is enqueued")
+		Expect(q.Len()).To(Equal(1))
+		req, _ := q.Get()
+		Expect(req).To(Equal(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(nics[1])}))
+		q.Done(req)
+
+		By("updating the load balancer routing without changing its destinations")
+		r.enqueueByLoadBalancerRouting().Update(ctx, event.UpdateEvent{
+			ObjectOld: newLoadBalancerRouting,
+			ObjectNew: newLoadBalancerRouting.DeepCopy(),
+		}, q)
+
+		By("asserting no network interface is enqueued")
+		Expect(q.Len()).To(BeZero())
+	})
 })
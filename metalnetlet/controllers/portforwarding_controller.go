@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/clientutils"
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	netclientutils "github.com/ironcore-dev/ironcore-net/utils/client"
+	utilhandler "github.com/ironcore-dev/ironcore-net/utils/handler"
+	"github.com/ironcore-dev/ironcore-net/utils/origin"
+	"github.com/ironcore-dev/ironcore/utils/generic"
+	metalnetv1alpha1 "github.com/ironcore-dev/metalnet/api/v1alpha1"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	PortForwardingOrigin = &origin.Origin{
+		Name:       "metalnetlet.ironcore.dev/portforwarding",
+		Namespaced: true,
+	}
+)
+
+// PortForwardingReconciler programs port forwardings to network interfaces of the partition as
+// public metalnet load balancers having the network interface as their only target.
+type PortForwardingReconciler struct {
+	client.Client
+	MetalnetClient    client.Client
+	MetalnetAPIReader client.Reader
+
+	PartitionName string
+
+	MetalnetNamespace string
+}
+
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=portforwardings,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=portforwardings/finalizers,verbs=update;patch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.apinet.ironcore.dev,resources=networks,verbs=get;list;watch
+
+//+cluster=metalnet:kubebuilder:rbac:groups=networking.metalnet.ironcore.dev,resources=loadbalancers,verbs=get;list;watch;create;update;patch;delete;deletecollection
+
+func (r *PortForwardingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	portForwarding := &v1alpha1.PortForwarding{}
+	if err := r.Get(ctx, req.NamespacedName, portForwarding); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		log.V(1).Info("Deleting any leftover metalnet load balancer")
+		stemmingFromKey := &netclientutils.StemmingFromKey{Origin: PortForwardingOrigin, SourceKey: req.NamespacedName}
+		n, err := netclientutils.ListAnd(r.MetalnetClient,
+			&metalnetv1alpha1.LoadBalancerList{},
+			client.InNamespace(r.MetalnetNamespace),
+			stemmingFromKey.UIDExistsSelector(),
+		).DeletePredicate(ctx, stemmingFromKey)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if n > 0 {
+			log.V(1).Info("Metalnet load balancer still exists, requeueing")
+			return ctrl.Result{Requeue: true}, nil
+		}
+		log.V(1).Info("Any potential leftover metalnet load balancer is gone")
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcileExists(ctx, log, portForwarding)
+}
+
+func (r *PortForwardingReconciler) reconcileExists(ctx context.Context, log logr.Logger, portForwarding *v1alpha1.PortForwarding) (ctrl.Result, error) {
+	if !portForwarding.DeletionTimestamp.IsZero() {
+		return r.delete(ctx, log, portForwarding)
+	}
+	return r.reconcile(ctx, log, portForwarding)
+}
+
+func (r *PortForwardingReconciler) deleteMetalnetLoadBalancersAndAnyExists(ctx context.Context, portForwarding *v1alpha1.PortForwarding) (bool, error) {
+	if err := r.MetalnetClient.DeleteAllOf(ctx, &metalnetv1alpha1.LoadBalancer{},
+		client.InNamespace(r.MetalnetNamespace),
+		&netclientutils.StemmingFrom{Origin: PortForwardingOrigin, Source: portForwarding},
+	); err != nil {
+		return false, err
+	}
+
+	return netclientutils.AnyExists(ctx, r.MetalnetClient.Scheme(), r.MetalnetAPIReader, &metalnetv1alpha1.LoadBalancer{},
+		client.InNamespace(r.MetalnetNamespace),
+		&netclientutils.StemmingFrom{Origin: PortForwardingOrigin, Source: portForwarding},
+	)
+}
+
+// releasePortForwarding removes any metalnet load balancer of the port forwarding and removes the finalizer
+// once all of them are gone.
+func (r *PortForwardingReconciler) releasePortForwarding(ctx context.Context, log logr.Logger, portForwarding *v1alpha1.PortForwarding) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(portForwarding, PartitionFinalizer(r.PartitionName)) {
+		log.V(1).Info("Finalizer not present, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	anyExists, err := r.deleteMetalnetLoadBalancersAndAnyExists(ctx, portForwarding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if anyExists {
+		log.V(1).Info("Metalnet load balancer is still present, requeueing")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	log.V(1).Info("Metalnet load balancer gone, removing finalizer")
+	if err := clientutils.PatchRemoveFinalizer(ctx, r.Client, portForwarding, PartitionFinalizer(r.PartitionName)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error removing finalizer: %w", err)
+	}
+	log.V(1).Info("Removed finalizer")
+	return ctrl.Result{}, nil
+}
+
+func (r *PortForwardingReconciler) delete(ctx context.Context, log logr.Logger, portForwarding *v1alpha1.PortForwarding) (ctrl.Result, error) {
+	log.V(1).Info("Delete")
+	res, err := r.releasePortForwarding(ctx, log, portForwarding)
+	if err != nil || !res.IsZero() {
+		return res, err
+	}
+	log.V(1).Info("Deleted")
+	return ctrl.Result{}, nil
+}
+
+// portForwardingPortsToMetalnetLoadBalancerPorts returns a metalnet load balancer port for every
+// port of the port range of the port forwarding, as metalnet does not support port ranges.
+func portForwardingPortsToMetalnetLoadBalancerPorts(portForwarding *v1alpha1.PortForwarding) []metalnetv1alpha1.LBPort {
+	protocol := generic.Deref(portForwarding.Spec.Protocol, corev1.ProtocolTCP)
+	endPort := v1alpha1.GetPortForwardingEndPort(portForwarding)
+
+	ports := make([]metalnetv1alpha1.LBPort, 0, endPort-portForwarding.Spec.Port+1)
+	for port := portForwarding.Spec.Port; port <= endPort; port++ {
+		ports = append(ports, metalnetv1alpha1.LBPort{
+			Protocol: string(protocol),
+			Port:     port,
+		})
+	}
+	return ports
+}
+
+func (r *PortForwardingReconciler) metalnetLoadBalancerSpec(
+	portForwarding *v1alpha1.PortForwarding,
+	metalnetNetworkName string,
+	metalnetNodeName string,
+) *metalnetv1alpha1.LoadBalancerSpec {
+	return &metalnetv1alpha1.LoadBalancerSpec{
+		NetworkRef: corev1.LocalObjectReference{Name: metalnetNetworkName},
+		LBtype:     metalnetv1alpha1.LoadBalancerTypePublic,
+		IPFamily:   portForwarding.Spec.IP.Family(),
+		IP:         ipToMetalnetIP(portForwarding.Spec.IP),
+		Ports:      portForwardingPortsToMetalnetLoadBalancerPorts(portForwarding),
+		NodeName:   &metalnetNodeName,
+	}
+}
+
+func (r *PortForwardingReconciler) applyMetalnetLoadBalancer(
+	ctx context.Context,
+	log logr.Logger,
+	portForwarding *v1alpha1.PortForwarding,
+	metalnetNetworkName string,
+	metalnetNodeName string,
+) error {
+	desiredSpec := r.metalnetLoadBalancerSpec(portForwarding, metalnetNetworkName, metalnetNodeName)
+	metalnetLoadBalancerKey := client.ObjectKey{Namespace: r.MetalnetNamespace, Name: string(portForwarding.UID)}
+
+	metalnetLoadBalancer := &metalnetv1alpha1.LoadBalancer{}
+	if err := r.MetalnetClient.Get(ctx, metalnetLoadBalancerKey, metalnetLoadBalancer); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting metalnet load balancer: %w", err)
+		}
+
+		metalnetLoadBalancer = &metalnetv1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metalnetLoadBalancerKey.Namespace,
+				Name:      metalnetLoadBalancerKey.Name,
+			},
+			Spec: *desiredSpec,
+		}
+		PortForwardingOrigin.SetOrigin(portForwarding, metalnetLoadBalancer)
+		log.V(1).Info("Creating metalnet load balancer")
+		if err := r.MetalnetClient.Create(ctx, metalnetLoadBalancer); err != nil {
+			return fmt.Errorf("error creating metalnet load balancer: %w", err)
+		}
+		return nil
+	}
+
+	if !metalnetLoadBalancer.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("metalnet load balancer is still deleting")
+	}
+	if MetalnetEqualities.DeepEqual(desiredSpec, &metalnetLoadBalancer.Spec) {
+		return nil
+	}
+
+	if metalnetLoadBalancer.Spec.IP != desiredSpec.IP ||
+		metalnetLoadBalancer.Spec.NetworkRef != desiredSpec.NetworkRef ||
+		generic.DerefZero(metalnetLoadBalancer.Spec.NodeName) != metalnetNodeName {
+		// The network interface moved, recreate the metalnet load balancer on its new node.
+		log.V(1).Info("Deleting outdated metalnet load balancer")
+		if err := r.MetalnetClient.Delete(ctx, metalnetLoadBalancer); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting outdated metalnet load balancer: %w", err)
+		}
+		return fmt.Errorf("metalnet load balancer is outdated, waiting for it to be deleted")
+	}
+
+	log.V(1).Info("Updating metalnet load balancer ports")
+	base := metalnetLoadBalancer.DeepCopy()
+	metalnetLoadBalancer.Spec = *desiredSpec
+	if err := r.MetalnetClient.Patch(ctx, metalnetLoadBalancer, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error updating metalnet load balancer: %w", err)
+	}
+	return nil
+}
+
+func (r *PortForwardingReconciler) reconcile(ctx context.Context, log logr.Logger, portForwarding *v1alpha1.PortForwarding) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	nic := &v1alpha1.NetworkInterface{}
+	nicKey := client.ObjectKey{Namespace: portForwarding.Namespace, Name: portForwarding.Spec.NetworkInterfaceRef.Name}
+	if err := r.Get(ctx, nicKey, nic); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting network interface %s: %w", nicKey.Name, err)
+		}
+		nic = nil
+	}
+
+	var metalnetNode *corev1.Node
+	if nic != nil && nic.DeletionTimestamp.IsZero() && slices.Contains(nic.Spec.IPs, portForwarding.Spec.TargetIP) {
+		var err error
+		metalnetNode, err = GetMetalnetNode(ctx, r.PartitionName, r.MetalnetClient, nic.Spec.NodeRef.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if metalnetNode == nil || !metalnetNode.DeletionTimestamp.IsZero() {
+		log.V(1).Info("Target network interface is not on a metalnet node of the partition, releasing port forwarding")
+		return r.releasePortForwarding(ctx, log, portForwarding)
+	}
+
+	if v1alpha1.GetPortForwardingTargetPort(portForwarding) != portForwarding.Spec.Port {
+		// metalnet load balancers forward ports unchanged, there is no way to program a port translation.
+		log.V(1).Info("Port translation is not supported by metalnet, releasing port forwarding")
+		return r.releasePortForwarding(ctx, log, portForwarding)
+	}
+
+	network := &v1alpha1.Network{}
+	networkKey := client.ObjectKey{Namespace: nic.Namespace, Name: nic.Spec.NetworkRef.Name}
+	if err := r.Get(ctx, networkKey, network); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting network %s: %w", networkKey.Name, err)
+		}
+		log.V(1).Info("Network not found")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Metalnet node present and not deleting, ensuring finalizer")
+	modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, portForwarding, PartitionFinalizer(r.PartitionName))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if modified {
+		log.V(1).Info("Added finalizer, requeueing")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	log.V(1).Info("Finalizer present")
+
+	if err := r.applyMetalnetLoadBalancer(ctx, log, portForwarding, string(network.UID), metalnetNode.Name); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error applying metalnet load balancer: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *PortForwardingReconciler) enqueueByNetworkInterface() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		nic := obj.(*v1alpha1.NetworkInterface)
+		log := ctrl.LoggerFrom(ctx)
+
+		portForwardingList := &v1alpha1.PortForwardingList{}
+		if err := r.List(ctx, portForwardingList,
+			client.InNamespace(nic.Namespace),
+		); err != nil {
+			log.Error(err, "Error listing port forwardings")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, portForwarding := range portForwardingList.Items {
+			if portForwarding.Spec.NetworkInterfaceRef.Name != nic.Name {
+				continue
+			}
+
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&portForwarding)})
+		}
+		return reqs
+	})
+}
+
+func (r *PortForwardingReconciler) SetupWithManager(mgr ctrl.Manager, metalnetCache cache.Cache) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PortForwarding{}).
+		Watches(
+			&v1alpha1.NetworkInterface{},
+			r.enqueueByNetworkInterface(),
+		).
+		WatchesRawSource(
+			source.Kind[client.Object](
+				metalnetCache,
+				&metalnetv1alpha1.LoadBalancer{},
+				utilhandler.EnqueueRequestByOrigin(PortForwardingOrigin),
+			),
+		).
+		Complete(r)
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/ironcore-dev/ironcore-net/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore-net/apimachinery/api/net"
+	"github.com/ironcore-dev/ironcore/utils/generic"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	metalnetv1alpha1 "github.com/ironcore-dev/metalnet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("PortForwardingController", func() {
+	ns := SetupNamespace(&k8sClient)
+	metalnetNs := SetupNamespace(&k8sClient)
+	SetupTest(metalnetNs)
+
+	metalnetNode := SetupMetalnetNode()
+	network := SetupNetwork(ns)
+
+	It("should program a port forwarding as metalnet load balancer targeting the network interface", func(ctx SpecContext) {
+		By("creating a network interface")
+		nic := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NodeRef: corev1.LocalObjectReference{
+					Name: PartitionNodeName(partitionName, metalnetNode.Name),
+				},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs:        []net.IP{net.MustParseIP("192.168.0.1")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("creating a port forwarding to the network interface")
+		portForwarding := &v1alpha1.PortForwarding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "pf-",
+			},
+			Spec: v1alpha1.PortForwardingSpec{
+				IPFamily:            corev1.IPv4Protocol,
+				NetworkInterfaceRef: corev1.LocalObjectReference{Name: nic.Name},
+				TargetIP:            net.MustParseIP("192.168.0.1"),
+				Port:                8080,
+				EndPort:             generic.Pointer(int32(8081)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, portForwarding)).To(Succeed())
+		Expect(portForwarding.Spec.IP.IsValid()).To(BeTrue())
+
+		By("waiting for the metalnet load balancer to be created")
+		metalnetLoadBalancer := &metalnetv1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metalnetNs.Name,
+				Name:      string(portForwarding.UID),
+			},
+		}
+		Eventually(Object(metalnetLoadBalancer)).Should(SatisfyAll(
+			HaveField("Spec.LBtype", metalnetv1alpha1.LoadBalancerTypePublic),
+			HaveField("Spec.IP", metalnetv1alpha1.MustParseIP(portForwarding.Spec.IP.String())),
+			HaveField("Spec.NetworkRef", corev1.LocalObjectReference{Name: string(network.UID)}),
+			HaveField("Spec.NodeName", HaveValue(Equal(metalnetNode.Name))),
+			HaveField("Spec.Ports", ConsistOf(
+				metalnetv1alpha1.LBPort{Protocol: string(corev1.ProtocolTCP), Port: 8080},
+				metalnetv1alpha1.LBPort{Protocol: string(corev1.ProtocolTCP), Port: 8081},
+			)),
+		))
+
+		By("waiting for the metalnet network interface to target the port forwarding IP")
+		metalnetNic := &metalnetv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metalnetNs.Name,
+				Name:      string(nic.UID),
+			},
+		}
+		Eventually(Object(metalnetNic)).Should(HaveField("Spec.LoadBalancerTargets", ConsistOf(
+			metalnetv1alpha1.MustParseIPPrefix(portForwarding.Spec.IP.String()+"/32"),
+		)))
+
+		By("deleting the port forwarding")
+		Expect(k8sClient.Delete(ctx, portForwarding)).To(Succeed())
+
+		By("waiting for the metalnet load balancer to be gone")
+		Eventually(Get(metalnetLoadBalancer)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("waiting for the port forwarding to be gone")
+		Eventually(Get(portForwarding)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("waiting for the metalnet network interface to not target the port forwarding IP anymore")
+		Eventually(Object(metalnetNic)).Should(HaveField("Spec.LoadBalancerTargets", BeEmpty()))
+	})
+
+	It("should reject port forwardings overlapping with the ports of a load balancer of the same IP", func(ctx SpecContext) {
+		By("creating a public load balancer")
+		loadBalancer := &v1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "lb-",
+			},
+			Spec: v1alpha1.LoadBalancerSpec{
+				Type:       v1alpha1.LoadBalancerTypePublic,
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs:        []v1alpha1.LoadBalancerIP{{IPFamily: corev1.IPv4Protocol, Name: "ip-1"}},
+				Ports:      []v1alpha1.LoadBalancerPort{{Port: 80}},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"foo": "bar"},
+				},
+				Template: v1alpha1.InstanceTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"foo": "bar"},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+		lbIP := loadBalancer.Spec.IPs[0].IP
+
+		newPortForwarding := func(port int32) *v1alpha1.PortForwarding {
+			return &v1alpha1.PortForwarding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "pf-",
+				},
+				Spec: v1alpha1.PortForwardingSpec{
+					IPFamily:            corev1.IPv4Protocol,
+					IP:                  lbIP,
+					NetworkInterfaceRef: corev1.LocalObjectReference{Name: "nic"},
+					TargetIP:            net.MustParseIP("192.168.0.2"),
+					Port:                port,
+				},
+			}
+		}
+
+		By("creating a port forwarding overlapping with the load balancer ports")
+		Expect(k8sClient.Create(ctx, newPortForwarding(80))).To(Satisfy(apierrors.IsInvalid))
+
+		By("creating a port forwarding not overlapping with the load balancer ports")
+		portForwarding := newPortForwarding(443)
+		Expect(k8sClient.Create(ctx, portForwarding)).To(Succeed())
+		Expect(portForwarding.Spec.IP).To(Equal(lbIP))
+
+		By("asserting the load balancer still claims the IP")
+		ipList := &v1alpha1.IPList{}
+		Expect(k8sClient.List(ctx, ipList, client.InNamespace(ns.Name))).To(Succeed())
+		Expect(ipList.Items).To(ContainElement(SatisfyAll(
+			HaveField("Spec.IP", lbIP),
+			HaveField("Spec.ClaimRef.UID", loadBalancer.UID),
+		)))
+	})
+})
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package natportusage
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// InterfaceStatMetricName is the name of the dpservice-exporter metric reporting the statistics of
+	// the interfaces of a metalnet node.
+	InterfaceStatMetricName = "dpservice_interface_stat"
+	// InterfaceIDLabel is the label of InterfaceStatMetricName holding the ID of the interface.
+	// metalnet uses the UID of its network interface as interface ID.
+	InterfaceIDLabel = "interface_id"
+	// StatNameLabel is the label of InterfaceStatMetricName holding the name of the statistic.
+	StatNameLabel = "stat_name"
+	// NATUsedPortCountStatName is the statistic of the number of NAT ports an interface uses.
+	NATUsedPortCountStatName = "nat_used_port_count"
+
+	// DefaultMaxAge is the default duration the metrics scraped from a node are reused for.
+	DefaultMaxAge = 10 * time.Second
+)
+
+// Getter gets the number of NAT ports used by the interfaces of metalnet nodes.
+type Getter interface {
+	// GetNATPortUsage returns the number of NAT ports the interface with the given ID uses on the metalnet node
+	// and whether the usage is known.
+	GetNATPortUsage(ctx context.Context, nodeName, interfaceID string) (int32, bool, error)
+}
+
+// ExporterGetter gets the NAT port usage from the dpservice-exporter running on each metalnet node.
+type ExporterGetter struct {
+	// NodeReader reads the metalnet nodes to determine the address of their dpservice-exporter.
+	NodeReader client.Reader
+	// Port is the port the dpservice-exporter serves its metrics on.
+	Port int32
+	// HTTPClient scrapes the metrics. If unset, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// MaxAge is the duration the metrics scraped from a node are reused for. If zero, DefaultMaxAge is used.
+	MaxAge time.Duration
+
+	mu sync.Mutex
+	// scrapes are the last scrapes by node name.
+	scrapes map[string]scrape
+}
+
+type scrape struct {
+	time time.Time
+	// natUsedPortCounts are the numbers of used NAT ports by interface ID.
+	natUsedPortCounts map[string]int32
+}
+
+var _ Getter = (*ExporterGetter)(nil)
+
+func (g *ExporterGetter) GetNATPortUsage(ctx context.Context, nodeName, interfaceID string) (int32, bool, error) {
+	maxAge := g.MaxAge
+	if maxAge == 0 {
+		maxAge = DefaultMaxAge
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	s, ok := g.scrapes[nodeName]
+	if !ok || time.Since(s.time) > maxAge {
+		natUsedPortCounts, err := g.scrape(ctx, nodeName)
+		if err != nil {
+			return 0, false, err
+		}
+
+		s = scrape{time: time.Now(), natUsedPortCounts: natUsedPortCounts}
+		if g.scrapes == nil {
+			g.scrapes = make(map[string]scrape)
+		}
+		g.scrapes[nodeName] = s
+	}
+
+	used, ok := s.natUsedPortCounts[interfaceID]
+	return used, ok, nil
+}
+
+func (g *ExporterGetter) scrape(ctx context.Context, nodeName string) (map[string]int32, error) {
+	node := &corev1.Node{}
+	if err := g.NodeReader.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return nil, fmt.Errorf("error getting node %s: %w", nodeName, err)
+	}
+
+	addr := nodeInternalIP(node)
+	if addr == "" {
+		return nil, fmt.Errorf("node %s has no internal IP", nodeName)
+	}
+
+	url := fmt.Sprintf("http://%s/metrics", net.JoinHostPort(addr, strconv.Itoa(int(g.Port))))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	httpClient := g.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error scraping metrics of node %s: %w", nodeName, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error scraping metrics of node %s: unexpected status %s", nodeName, res.Status)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	metricFamilies, err := parser.TextToMetricFamilies(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing metrics of node %s: %w", nodeName, err)
+	}
+	return natUsedPortCounts(metricFamilies[InterfaceStatMetricName]), nil
+}
+
+func nodeInternalIP(node *corev1.Node) string {
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			return addr.Address
+		}
+	}
+	return ""
+}
+
+// natUsedPortCounts returns the numbers of used NAT ports by interface ID of the interface stat metric family.
+func natUsedPortCounts(metricFamily *dto.MetricFamily) map[string]int32 {
+	res := make(map[string]int32)
+	for _, metric := range metricFamily.GetMetric() {
+		var interfaceID, statName string
+		for _, label := range metric.GetLabel() {
+			switch label.GetName() {
+			case InterfaceIDLabel:
+				interfaceID = label.GetValue()
+			case StatNameLabel:
+				statName = label.GetValue()
+			}
+		}
+		if interfaceID == "" || statName != NATUsedPortCountStatName {
+			continue
+		}
+
+		var value float64
+		switch {
+		case metric.GetGauge() != nil:
+			value = metric.GetGauge().GetValue()
+		case metric.GetUntyped() != nil:
+			value = metric.GetUntyped().GetValue()
+		default:
+			continue
+		}
+		res[interfaceID] = int32(value)
+	}
+	return res
+}
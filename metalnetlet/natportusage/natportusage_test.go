@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package natportusage
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ExporterGetter", func() {
+	var (
+		scrapes atomic.Int32
+		getter  *ExporterGetter
+	)
+
+	BeforeEach(func() {
+		scrapes.Store(0)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scrapes.Add(1)
+			_, _ = fmt.Fprint(w, `# HELP dpservice_interface_stat Dp-Service interface statistic
+# TYPE dpservice_interface_stat gauge
+dpservice_interface_stat{interface_id="nic-1",stat_name="nat_used_port_count"} 60
+dpservice_interface_stat{interface_id="nic-1",stat_name="firewall_rule_count"} 3
+dpservice_interface_stat{interface_id="nic-2",stat_name="nat_used_port_count"} 0
+`)
+		}))
+		DeferCleanup(srv.Close)
+
+		host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		portNum, err := strconv.Atoi(port)
+		Expect(err).NotTo(HaveOccurred())
+
+		getter = &ExporterGetter{
+			NodeReader: fake.NewClientBuilder().WithObjects(&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node"},
+				Status: corev1.NodeStatus{
+					Addresses: []corev1.NodeAddress{
+						{Type: corev1.NodeHostName, Address: "node"},
+						{Type: corev1.NodeInternalIP, Address: host},
+					},
+				},
+			}).Build(),
+			Port:   int32(portNum),
+			MaxAge: time.Hour,
+		}
+	})
+
+	It("should get the NAT port usage of the interfaces of a node", func(ctx SpecContext) {
+		used, ok, err := getter.GetNATPortUsage(ctx, "node", "nic-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(used).To(Equal(int32(60)))
+
+		used, ok, err = getter.GetNATPortUsage(ctx, "node", "nic-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(used).To(BeZero())
+
+		By("asserting the metrics of the node were only scraped once")
+		Expect(scrapes.Load()).To(Equal(int32(1)))
+	})
+
+	It("should report an unknown usage for interfaces not reported by the exporter", func(ctx SpecContext) {
+		_, ok, err := getter.GetNATPortUsage(ctx, "node", "nic-3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should scrape the metrics again once they are too old", func(ctx SpecContext) {
+		getter.MaxAge = time.Nanosecond
+
+		_, _, err := getter.GetNATPortUsage(ctx, "node", "nic-1")
+		Expect(err).NotTo(HaveOccurred())
+		time.Sleep(time.Millisecond)
+		_, _, err = getter.GetNATPortUsage(ctx, "node", "nic-1")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scrapes.Load()).To(Equal(int32(2)))
+	})
+
+	It("should error if the node does not exist", func(ctx SpecContext) {
+		_, _, err := getter.GetNATPortUsage(ctx, "other-node", "nic-1")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -14,6 +14,7 @@ type KeySlots[K comparable] struct {
 	used        uint
 	slotsByKey  map[K]*bitset.BitSet
 	freeKeys    sets.Set[K]
+	cordoned    sets.Set[K]
 }
 
 func NewKeySlots[K comparable](slotsPerKey uint, keys []K) *KeySlots[K] {
@@ -36,6 +37,7 @@ func NewKeySlots[K comparable](slotsPerKey uint, keys []K) *KeySlots[K] {
 		slotsPerKey: slotsPerKey,
 		slotsByKey:  slotsByKey,
 		freeKeys:    freeKeys,
+		cordoned:    sets.New[K](),
 	}
 }
 
@@ -44,6 +46,22 @@ func (s *KeySlots[K]) HasKey(key K) bool {
 	return ok
 }
 
+// Cordon excludes the key from UseNextFree and UseNextFreeRange.
+// Slots of a cordoned key can still be used explicitly.
+func (s *KeySlots[K]) Cordon(key K) {
+	if !s.HasKey(key) {
+		return
+	}
+
+	s.cordoned.Insert(key)
+	s.freeKeys.Delete(key)
+}
+
+// IsCordoned reports whether the key is cordoned.
+func (s *KeySlots[K]) IsCordoned(key K) bool {
+	return s.cordoned.Has(key)
+}
+
 func (s *KeySlots[K]) Keys() []K {
 	return maps.Keys(s.slotsByKey)
 }
@@ -64,30 +82,93 @@ func (s *KeySlots[K]) Used() uint {
 	return s.used
 }
 
-func (s *KeySlots[K]) Use(key K, slot uint) bool {
+// UsedByKey returns the used number of slots of the key.
+func (s *KeySlots[K]) UsedByKey(key K) uint {
 	if s == nil {
+		return 0
+	}
+	slots, ok := s.slotsByKey[key]
+	if !ok {
+		return 0
+	}
+	return slots.Count()
+}
+
+func (s *KeySlots[K]) Use(key K, slot uint) bool {
+	return s.UseRange(key, slot, 1)
+}
+
+// UseRange uses the n consecutive slots of the key starting at slot.
+// It reports false and uses no slot if any of the slots is invalid or already used.
+func (s *KeySlots[K]) UseRange(key K, slot, n uint) bool {
+	if s == nil || n == 0 {
 		return false
 	}
-	// Test whether the slot is valid at all.
-	if slot >= s.slotsPerKey {
+	// Test whether the slots are valid at all.
+	if slot+n > s.slotsPerKey {
 		return false
 	}
 
 	slots, ok := s.slotsByKey[key]
-	if !ok || slots.Test(slot) {
+	if !ok {
+		return false
+	}
+	if next, ok := slots.NextSet(slot); ok && next < slot+n {
 		return false
 	}
 
-	slots.Set(slot)
-	s.used++
+	for i := slot; i < slot+n; i++ {
+		slots.Set(i)
+	}
+	s.used += n
 	if slots.All() {
 		s.freeKeys.Delete(key)
 	}
 	return true
 }
 
+// Release releases the n consecutive slots of the key starting at slot.
+func (s *KeySlots[K]) Release(key K, slot, n uint) {
+	if s == nil || slot+n > s.slotsPerKey {
+		return
+	}
+
+	slots, ok := s.slotsByKey[key]
+	if !ok {
+		return
+	}
+
+	for i := slot; i < slot+n; i++ {
+		if slots.Test(i) {
+			slots.Clear(i)
+			s.used--
+		}
+	}
+	if !slots.All() && !s.cordoned.Has(key) {
+		s.freeKeys.Insert(key)
+	}
+}
+
 func (s *KeySlots[K]) UseNextFree() (K, uint, bool) {
-	if s == nil {
+	return s.UseNextFreeRange(1)
+}
+
+// nextFreeRange returns the first slot of the first n consecutive free slots.
+func (s *KeySlots[K]) nextFreeRange(slots *bitset.BitSet, n uint) (uint, bool) {
+	start, ok := slots.NextClear(0)
+	for ok && start+n <= s.slotsPerKey {
+		next, found := slots.NextSet(start)
+		if !found || next >= start+n {
+			return start, true
+		}
+		start, ok = slots.NextClear(next)
+	}
+	return 0, false
+}
+
+// UseNextFreeRange uses the next n consecutive free slots of any key.
+func (s *KeySlots[K]) UseNextFreeRange(n uint) (K, uint, bool) {
+	if s == nil || n == 0 {
 		var zero K
 		return zero, 0, false
 	}
@@ -99,9 +180,9 @@ func (s *KeySlots[K]) UseNextFree() (K, uint, bool) {
 	}
 
 	for key := range s.freeKeys {
-		slot, ok := s.slotsByKey[key].NextClear(0)
+		slot, ok := s.nextFreeRange(s.slotsByKey[key], n)
 		if ok {
-			s.Use(key, slot)
+			s.UseRange(key, slot, n)
 			return key, slot, true
 		}
 	}
@@ -19,3 +19,15 @@ func IPFamilyForAddr(addr netip.Addr) corev1.IPFamily {
 		return ""
 	}
 }
+
+// OtherIPFamily returns the IP family of a dual-stack pair that is not the given one.
+func OtherIPFamily(ipFamily corev1.IPFamily) corev1.IPFamily {
+	switch ipFamily {
+	case corev1.IPv4Protocol:
+		return corev1.IPv6Protocol
+	case corev1.IPv6Protocol:
+		return corev1.IPv4Protocol
+	default:
+		return ""
+	}
+}
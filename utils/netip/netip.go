@@ -35,6 +35,13 @@ func AddOffsetAddress(address netip.Addr, offset uint64) (netip.Addr, error) {
 	return addr, nil
 }
 
+// AddressOffset returns the offset of address from first. address must not be less than first.
+func AddressOffset(first, address netip.Addr) uint64 {
+	firstBig := big.NewInt(0).SetBytes(first.AsSlice())
+	addressBig := big.NewInt(0).SetBytes(address.AsSlice())
+	return big.NewInt(0).Sub(addressBig, firstBig).Uint64()
+}
+
 func GetIPFamilyFromPrefix(ipPrefix net.IPPrefix) corev1.IPFamily {
 	if ipPrefix.Addr().Is6() {
 		return corev1.IPv6Protocol